@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"google.golang.org/grpc"
@@ -13,31 +15,89 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
 	"github.com/Meat-Hook/back-template/libs/log"
+	"github.com/Meat-Hook/back-template/libs/rpc"
 	pb "github.com/Meat-Hook/back-template/proto/gen/go/file/v1"
 )
 
 // Client to file microservice.
 type Client struct {
-	conn pb.ServiceClient
+	conn    pb.ServiceClient
+	service string
+	key     string
 }
 
 // New build and returns new client to microservice session.
-func New(conn grpc.ClientConnInterface) *Client {
-	return &Client{conn: pb.NewServiceClient(conn)}
+// Service is name of calling service, it owns all uploaded files.
+// Key authenticates calling service, it's set for service in file service config.
+func New(conn grpc.ClientConnInterface, service, key string) *Client {
+	return &Client{conn: pb.NewServiceClient(conn), service: service, key: key}
+}
+
+type (
+	// Policy contains restrictions for uploaded file.
+	Policy = app.Policy
+	// ListParams contains params for search files.
+	ListParams = app.ListParams
+	// File contains file info.
+	File = app.File
+	// Access contains who can read file.
+	Access = app.Access
+	// Visibility of file.
+	Visibility = app.Visibility
+	// Principal is identity of file owner or reader.
+	Principal = app.Principal
+	// Quota contains storage limits and usage of principal.
+	Quota = app.Quota
+)
+
+// Visibility kinds.
+const (
+	VisibilityPrivate = app.VisibilityPrivate
+	VisibilityPublic  = app.VisibilityPublic
+	VisibilityShared  = app.VisibilityShared
+)
+
+// Sort fields.
+const (
+	SortByCreatedAt = app.SortByCreatedAt
+	SortBySize      = app.SortBySize
+)
+
+// AnyOwner lists files of all owners.
+const AnyOwner = app.AnyOwner
+
+// UserPrincipal returns principal of user, e.g. for charging user quota
+// by files uploaded on behalf of user.
+func UserPrincipal(userID uuid.UUID) Principal {
+	return app.UserPrincipal(userID)
 }
 
 // Errors.
 var (
-	ErrNotFound = app.ErrNotFound
+	ErrNotFound       = app.ErrNotFound
+	ErrNotAllowedType = app.ErrNotAllowedType
+	ErrTooLarge       = app.ErrTooLarge
+	ErrNotValidAccess = app.ErrNotValidAccess
+	ErrNotValidRef    = app.ErrNotValidRef
+	ErrNotValidQuota  = app.ErrNotValidQuota
+	ErrQuotaExceeded  = app.ErrQuotaExceeded
+	ErrQuarantined    = app.ErrQuarantined
+	ErrInfected       = app.ErrInfected
+	ErrAccessDenied   = app.ErrAccessDenied
+	ErrNotValidParams = errors.New("not valid params")
 )
 
 // Upload file to database.
-func (c *Client) Upload(ctx context.Context, r io.Reader) (uuid.UUID, error) {
+// File is rejected if its content does not satisfy the policy.
+func (c *Client) Upload(ctx context.Context, r io.Reader, policy Policy, access Access) (uuid.UUID, error) {
 	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
-		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
 	})
 
 	stream, err := c.conn.Upload(ctx)
@@ -45,6 +105,17 @@ func (c *Client) Upload(ctx context.Context, r io.Reader) (uuid.UUID, error) {
 		return uuid.Nil, fmt.Errorf("c.conn.Upload: %w", err)
 	}
 
+	// Policy and access are sent with the first chunk.
+	in := &pb.UploadRequest{
+		Chunk: &pb.Chunk{},
+		Policy: &pb.UploadPolicy{
+			AllowedTypes: policy.AllowedTypes,
+			MaxSize:      policy.MaxSize,
+			Account:      string(policy.Account),
+		},
+		Access: apiAccess(access),
+	}
+
 	buf := make([]byte, app.MaxChunkSize)
 
 	for {
@@ -57,20 +128,41 @@ func (c *Client) Upload(ctx context.Context, r io.Reader) (uuid.UUID, error) {
 			break
 		}
 
-		in := &pb.UploadRequest{
-			Chunk: &pb.Chunk{
-				Content: buf[:n],
-			},
+		in.Chunk.Content = buf[:n]
+		err = stream.Send(in)
+		in = &pb.UploadRequest{Chunk: &pb.Chunk{}}
+		// Server can reject file before receiving all chunks, error is returned by CloseAndRecv.
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("stream.Send: %w", err)
 		}
+	}
 
+	// Empty file, send policy and access only.
+	if in.Policy != nil {
 		err = stream.Send(in)
-		if err != nil {
+		if err != nil && !errors.Is(err, io.EOF) {
 			return uuid.Nil, fmt.Errorf("stream.Send: %w", err)
 		}
 	}
 
 	res, err := stream.CloseAndRecv()
-	if err != nil {
+	switch {
+	case status.Code(err) == codes.InvalidArgument && status.Convert(err).Message() == ErrNotValidAccess.Error():
+		return uuid.Nil, ErrNotValidAccess
+	case status.Code(err) == codes.InvalidArgument:
+		return uuid.Nil, ErrNotAllowedType
+	case status.Code(err) == codes.PermissionDenied:
+		return uuid.Nil, ErrAccessDenied
+	case status.Code(err) == codes.OutOfRange:
+		return uuid.Nil, ErrTooLarge
+	case status.Code(err) == codes.ResourceExhausted:
+		return uuid.Nil, ErrQuotaExceeded
+	case status.Code(err) == codes.FailedPrecondition && status.Convert(err).Message() == ErrInfected.Error():
+		return uuid.Nil, ErrInfected
+	case err != nil:
 		return uuid.Nil, fmt.Errorf("stream.CloseAndRecv: %w", err)
 	}
 
@@ -82,10 +174,64 @@ func (c *Client) Upload(ctx context.Context, r io.Reader) (uuid.UUID, error) {
 	return id, nil
 }
 
+// Download file from database.
+// Returned reader must be closed after use.
+func (c *Client) Download(ctx context.Context, fileID uuid.UUID) (io.ReadCloser, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
+	})
+	ctx, cancel := context.WithCancel(ctx)
+
+	in := &pb.DownloadRequest{
+		FileId: &pb.UUID{
+			Value: fileID.String(),
+		},
+	}
+
+	stream, err := c.conn.Download(ctx, in)
+	if err != nil {
+		cancel()
+
+		return nil, fmt.Errorf("c.conn.Download: %w", err)
+	}
+
+	// Receive first message for getting error if file not found.
+	r := &reader{stream: stream, cancel: cancel}
+	r.error = r.recv()
+	switch {
+	case status.Code(r.error) == codes.NotFound:
+		cancel()
+
+		return nil, ErrNotFound
+	case status.Code(r.error) == codes.PermissionDenied:
+		cancel()
+
+		return nil, ErrAccessDenied
+	case status.Code(r.error) == codes.FailedPrecondition && status.Convert(r.error).Message() == ErrQuarantined.Error():
+		cancel()
+
+		return nil, ErrQuarantined
+	case status.Code(r.error) == codes.FailedPrecondition && status.Convert(r.error).Message() == ErrInfected.Error():
+		cancel()
+
+		return nil, ErrInfected
+	case r.error != nil && !errors.Is(r.error, io.EOF):
+		cancel()
+
+		return nil, fmt.Errorf("stream.Recv: %w", r.error)
+	}
+
+	return r, nil
+}
+
 // SetMetadata set file metadata.
 func (c *Client) SetMetadata(ctx context.Context, fileID uuid.UUID, fileMD map[string]interface{}) error {
 	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
-		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
 	})
 
 	details, err := structpb.NewStruct(fileMD)
@@ -102,6 +248,8 @@ func (c *Client) SetMetadata(ctx context.Context, fileID uuid.UUID, fileMD map[s
 	switch {
 	case status.Code(err) == codes.NotFound:
 		return ErrNotFound
+	case status.Code(err) == codes.PermissionDenied:
+		return ErrAccessDenied
 	case err != nil:
 		return fmt.Errorf("c.conn.SetMetadata: %w", err)
 	}
@@ -112,7 +260,9 @@ func (c *Client) SetMetadata(ctx context.Context, fileID uuid.UUID, fileMD map[s
 // Delete file from database.
 func (c *Client) Delete(ctx context.Context, fileID uuid.UUID) error {
 	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
-		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
 	})
 
 	in := &pb.DeleteRequest{
@@ -122,9 +272,306 @@ func (c *Client) Delete(ctx context.Context, fileID uuid.UUID) error {
 	}
 
 	_, err := c.conn.Delete(ctx, in)
-	if err != nil {
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return ErrNotFound
+	case status.Code(err) == codes.PermissionDenied:
+		return ErrAccessDenied
+	case err != nil:
 		return fmt.Errorf("c.conn.Delete: %w", err)
 	}
 
 	return nil
 }
+
+// Claim adds reference to file, e.g. "user:<id>:avatar".
+// Files uploaded by service are removed when they have no references.
+func (c *Client) Claim(ctx context.Context, fileID uuid.UUID, ref string) error {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
+	})
+
+	in := &pb.ClaimRequest{
+		FileId: &pb.UUID{Value: fileID.String()},
+		Ref:    ref,
+	}
+
+	_, err := c.conn.Claim(ctx, in)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return ErrNotFound
+	case status.Code(err) == codes.PermissionDenied:
+		return ErrAccessDenied
+	case status.Code(err) == codes.InvalidArgument && status.Convert(err).Message() == ErrNotValidRef.Error():
+		return ErrNotValidRef
+	case err != nil:
+		return fmt.Errorf("c.conn.Claim: %w", err)
+	}
+
+	return nil
+}
+
+// Release removes reference to file added by Claim.
+func (c *Client) Release(ctx context.Context, fileID uuid.UUID, ref string) error {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
+	})
+
+	in := &pb.ReleaseRequest{
+		FileId: &pb.UUID{Value: fileID.String()},
+		Ref:    ref,
+	}
+
+	_, err := c.conn.Release(ctx, in)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return ErrNotFound
+	case status.Code(err) == codes.PermissionDenied:
+		return ErrAccessDenied
+	case status.Code(err) == codes.InvalidArgument && status.Convert(err).Message() == ErrNotValidRef.Error():
+		return ErrNotValidRef
+	case err != nil:
+		return fmt.Errorf("c.conn.Release: %w", err)
+	}
+
+	return nil
+}
+
+// GetQuota returns storage limits and usage of principal charged for files.
+func (c *Client) GetQuota(ctx context.Context, owner Principal) (*Quota, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
+	})
+
+	res, err := c.conn.GetQuota(ctx, &pb.GetQuotaRequest{Owner: string(owner)})
+	switch {
+	case status.Code(err) == codes.InvalidArgument:
+		return nil, ErrNotValidQuota
+	case status.Code(err) == codes.PermissionDenied:
+		return nil, ErrAccessDenied
+	case err != nil:
+		return nil, fmt.Errorf("c.conn.GetQuota: %w", err)
+	}
+
+	return appQuota(res.Quota), nil
+}
+
+// SetQuota replaces storage limits of principal charged for files, 0 is unlimited.
+// Usage isn't changed, files above new limits are kept.
+func (c *Client) SetQuota(ctx context.Context, owner Principal, maxBytes, maxFiles int64) (*Quota, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
+	})
+
+	in := &pb.SetQuotaRequest{
+		Owner:    string(owner),
+		MaxBytes: maxBytes,
+		MaxFiles: maxFiles,
+	}
+
+	res, err := c.conn.SetQuota(ctx, in)
+	switch {
+	case status.Code(err) == codes.InvalidArgument:
+		return nil, ErrNotValidQuota
+	case status.Code(err) == codes.PermissionDenied:
+		return nil, ErrAccessDenied
+	case err != nil:
+		return nil, fmt.Errorf("c.conn.SetQuota: %w", err)
+	}
+
+	return appQuota(res.Quota), nil
+}
+
+// SetAccess changes who can read file.
+// Only file owner can change it.
+func (c *Client) SetAccess(ctx context.Context, fileID uuid.UUID, access Access) error {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
+	})
+
+	in := &pb.SetAccessRequest{
+		FileId: &pb.UUID{Value: fileID.String()},
+		Access: apiAccess(access),
+	}
+
+	_, err := c.conn.SetAccess(ctx, in)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return ErrNotFound
+	case status.Code(err) == codes.PermissionDenied:
+		return ErrAccessDenied
+	case status.Code(err) == codes.InvalidArgument:
+		return ErrNotValidAccess
+	case err != nil:
+		return fmt.Errorf("c.conn.SetAccess: %w", err)
+	}
+
+	return nil
+}
+
+// SignURL returns download URL of file which is valid until expiresAt.
+// Disposition sets Content-Disposition header of response if not empty,
+// URL is valid only for ip if it isn't nil.
+func (c *Client) SignURL(ctx context.Context, fileID uuid.UUID, expiresAt time.Time, disposition string, ip net.IP) (string, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
+	})
+
+	in := &pb.SignURLRequest{
+		FileId:      &pb.UUID{Value: fileID.String()},
+		ExpiresAt:   timestamppb.New(expiresAt),
+		Disposition: disposition,
+	}
+	if ip != nil {
+		in.Ip = ip.String()
+	}
+
+	res, err := c.conn.SignURL(ctx, in)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return "", ErrNotFound
+	case status.Code(err) == codes.PermissionDenied:
+		return "", ErrAccessDenied
+	case status.Code(err) == codes.InvalidArgument:
+		return "", fmt.Errorf("%w: %s", ErrNotValidParams, status.Convert(err).Message())
+	case err != nil:
+		return "", fmt.Errorf("c.conn.SignURL: %w", err)
+	}
+
+	return res.Url, nil
+}
+
+// List returns page of files matched by params and cursor of the next page.
+// The next page cursor is empty for the last page.
+func (c *Client) List(ctx context.Context, params ListParams) ([]File, string, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID:      []string{log.ReqIDFromCtx(ctx)},
+		log.Service:    []string{c.service},
+		rpc.ServiceKey: []string{c.key},
+	})
+
+	in := &pb.ListRequest{
+		Owner:       string(params.Owner),
+		Cursor:      params.Cursor,
+		Limit:       int32(params.Limit),
+		MinSize:     params.MinSize,
+		MaxSize:     params.MaxSize,
+		ContentType: params.ContentType,
+		Desc:        params.Desc,
+	}
+
+	if params.SortBy == SortBySize {
+		in.SortBy = pb.SortField_SORT_FIELD_SIZE
+	}
+	if !params.CreatedAfter.IsZero() {
+		in.CreatedAfter = timestamppb.New(params.CreatedAfter)
+	}
+	if !params.CreatedBefore.IsZero() {
+		in.CreatedBefore = timestamppb.New(params.CreatedBefore)
+	}
+	if len(params.Metadata) > 0 {
+		in.Metadata = &structpb.Struct{}
+		err := in.Metadata.UnmarshalJSON(params.Metadata)
+		if err != nil {
+			return nil, "", fmt.Errorf("in.Metadata.UnmarshalJSON: %w", err)
+		}
+	}
+
+	res, err := c.conn.List(ctx, in)
+	switch {
+	case status.Code(err) == codes.InvalidArgument:
+		return nil, "", fmt.Errorf("%w: %s", ErrNotValidParams, status.Convert(err).Message())
+	case err != nil:
+		return nil, "", fmt.Errorf("c.conn.List: %w", err)
+	}
+
+	files := make([]File, len(res.Files))
+	for i, file := range res.Files {
+		id, err := uuid.FromString(file.Id.GetValue())
+		if err != nil {
+			return nil, "", fmt.Errorf("uuid.FromString: %w", err)
+		}
+
+		files[i] = File{
+			ID:          id,
+			Owner:       Principal(file.Owner),
+			Access:      appAccess(file.Access),
+			Size:        file.Size,
+			Digest:      file.Digest,
+			ContentType: file.ContentType,
+			CreatedAt:   file.CreatedAt.AsTime(),
+			UpdatedAt:   file.UpdatedAt.AsTime(),
+		}
+
+		if file.Metadata != nil {
+			files[i].Metadata, err = file.Metadata.MarshalJSON()
+			if err != nil {
+				return nil, "", fmt.Errorf("file.Metadata.MarshalJSON: %w", err)
+			}
+		}
+	}
+
+	return files, res.NextCursor, nil
+}
+
+func apiAccess(access Access) *pb.Access {
+	visibility := pb.Visibility_VISIBILITY_UNSPECIFIED
+	switch access.Visibility {
+	case VisibilityPrivate:
+		visibility = pb.Visibility_VISIBILITY_PRIVATE
+	case VisibilityPublic:
+		visibility = pb.Visibility_VISIBILITY_PUBLIC
+	case VisibilityShared:
+		visibility = pb.Visibility_VISIBILITY_SHARED
+	}
+
+	sharedWith := make([]string, len(access.SharedWith))
+	for i := range access.SharedWith {
+		sharedWith[i] = string(access.SharedWith[i])
+	}
+
+	return &pb.Access{
+		Visibility: visibility,
+		SharedWith: sharedWith,
+	}
+}
+
+func appQuota(quota *pb.Quota) *Quota {
+	return &Quota{
+		Owner:     Principal(quota.GetOwner()),
+		MaxBytes:  quota.GetMaxBytes(),
+		MaxFiles:  quota.GetMaxFiles(),
+		UsedBytes: quota.GetUsedBytes(),
+		UsedFiles: quota.GetUsedFiles(),
+	}
+}
+
+func appAccess(access *pb.Access) Access {
+	var res Access
+	switch access.GetVisibility() {
+	case pb.Visibility_VISIBILITY_PRIVATE:
+		res.Visibility = VisibilityPrivate
+	case pb.Visibility_VISIBILITY_PUBLIC:
+		res.Visibility = VisibilityPublic
+	case pb.Visibility_VISIBILITY_SHARED:
+		res.Visibility = VisibilityShared
+	}
+
+	for _, p := range access.GetSharedWith() {
+		res.SharedWith = append(res.SharedWith, Principal(p))
+	}
+
+	return res
+}
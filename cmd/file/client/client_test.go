@@ -1,12 +1,17 @@
 package client_test
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"github.com/stretchr/testify/require"
 
 	"github.com/Meat-Hook/back-template/cmd/file/client"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
@@ -24,7 +29,8 @@ func TestClient_Delete(t *testing.T) {
 		want   error
 	}{
 		{"success", fileID, nil},
-		{"err_not_found", uuid.Must(uuid.NewV4()), status.Error(codes.NotFound, app.ErrNotFound.Error())},
+		{"err_not_found", uuid.Must(uuid.NewV4()), client.ErrNotFound},
+		{"err_access_denied", foreignFileID, client.ErrAccessDenied},
 	}
 
 	for _, tc := range testCases {
@@ -36,6 +42,60 @@ func TestClient_Delete(t *testing.T) {
 	}
 }
 
+func TestClient_Claim(t *testing.T) {
+	t.Parallel()
+
+	fileID := uuid.Must(uuid.NewV4())
+	conn, _, assert := start(t, fileID, nil, nil)
+
+	testCases := []struct {
+		name   string
+		fileID uuid.UUID
+		ref    string
+		want   error
+	}{
+		{"success", fileID, "user:1:avatar", nil},
+		{"err_not_valid_ref", fileID, "", client.ErrNotValidRef},
+		{"err_not_found", uuid.Must(uuid.NewV4()), "user:1:avatar", client.ErrNotFound},
+		{"err_access_denied", foreignFileID, "user:1:avatar", client.ErrAccessDenied},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := conn.Claim(ctx, tc.fileID, tc.ref)
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestClient_Release(t *testing.T) {
+	t.Parallel()
+
+	fileID := uuid.Must(uuid.NewV4())
+	conn, _, assert := start(t, fileID, nil, nil)
+
+	testCases := []struct {
+		name   string
+		fileID uuid.UUID
+		ref    string
+		want   error
+	}{
+		{"success", fileID, "user:1:avatar", nil},
+		{"err_not_valid_ref", fileID, "", client.ErrNotValidRef},
+		{"err_not_found", uuid.Must(uuid.NewV4()), "user:1:avatar", client.ErrNotFound},
+		{"err_access_denied", foreignFileID, "user:1:avatar", client.ErrAccessDenied},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := conn.Release(ctx, tc.fileID, tc.ref)
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
 func TestClient_SetMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -51,6 +111,7 @@ func TestClient_SetMetadata(t *testing.T) {
 	}{
 		{"success", fileID, map[string]interface{}{"field": "value"}, nil},
 		{"err_not_found", uuid.Must(uuid.NewV4()), nil, client.ErrNotFound},
+		{"err_access_denied", foreignFileID, nil, client.ErrAccessDenied},
 	}
 
 	for _, tc := range testCases {
@@ -61,3 +122,245 @@ func TestClient_SetMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_SetAccess(t *testing.T) {
+	t.Parallel()
+
+	fileID := uuid.Must(uuid.NewV4())
+	conn, _, assert := start(t, fileID, nil, nil)
+
+	shared := client.Access{
+		Visibility: client.VisibilityShared,
+		SharedWith: []client.Principal{app.UserPrincipal(uuid.Must(uuid.NewV4()))},
+	}
+
+	testCases := []struct {
+		name   string
+		fileID uuid.UUID
+		access client.Access
+		want   error
+	}{
+		{"success", fileID, shared, nil},
+		{"err_not_found", uuid.Must(uuid.NewV4()), shared, client.ErrNotFound},
+		{"err_access_denied", foreignFileID, shared, client.ErrAccessDenied},
+		{"err_not_valid_access", fileID, client.Access{Visibility: client.VisibilityShared}, client.ErrNotValidAccess},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := conn.SetAccess(ctx, tc.fileID, tc.access)
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestClient_SignURL(t *testing.T) {
+	t.Parallel()
+
+	fileID := uuid.Must(uuid.NewV4())
+	conn, _, assert := start(t, fileID, nil, nil)
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		fileID    uuid.UUID
+		expiresAt time.Time
+		ip        net.IP
+		want      string
+		wantErr   error
+	}{
+		{"success", fileID, expiresAt, nil, fmt.Sprintf("/file?id=%s&ip=&sig=sig", fileID), nil},
+		{"success_bound", fileID, expiresAt, net.IPv4(10, 0, 0, 1), fmt.Sprintf("/file?id=%s&ip=10.0.0.1&sig=sig", fileID), nil},
+		{"err_not_valid_params", fileID, time.Now().Add(-time.Hour), nil, "", client.ErrNotValidParams},
+		{"err_not_found", uuid.Must(uuid.NewV4()), expiresAt, nil, "", client.ErrNotFound},
+		{"err_access_denied", foreignFileID, expiresAt, nil, "", client.ErrAccessDenied},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := conn.SignURL(ctx, tc.fileID, tc.expiresAt, "", tc.ip)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestClient_Upload(t *testing.T) {
+	t.Parallel()
+
+	file, err := os.ReadFile(testFile)
+	require.NoError(t, err)
+
+	fileID := uuid.Must(uuid.NewV4())
+	conn, _, assert := start(t, fileID, nil, file)
+
+	var (
+		public  = client.Access{Visibility: client.VisibilityPublic}
+		private = client.Access{Visibility: client.VisibilityPrivate}
+	)
+
+	testCases := []struct {
+		name    string
+		content []byte
+		policy  client.Policy
+		access  client.Access
+		want    uuid.UUID
+		wantErr error
+	}{
+		{"success", file, client.Policy{}, public, fileID, nil},
+		{"success_policy", file, client.Policy{AllowedTypes: []string{"image/jpeg"}, MaxSize: int64(len(file))}, private, fileID, nil},
+		{"err_not_allowed_type", file, client.Policy{AllowedTypes: []string{"image/png"}}, public, uuid.Nil, client.ErrNotAllowedType},
+		{"err_too_large", file, client.Policy{MaxSize: 1}, public, uuid.Nil, client.ErrTooLarge},
+		{"err_quota_exceeded", file, client.Policy{Account: exhaustedAccount}, public, uuid.Nil, client.ErrQuotaExceeded},
+		{"err_infected", infectedFile, client.Policy{}, public, uuid.Nil, client.ErrInfected},
+		{"err_not_valid_access", file, client.Policy{}, client.Access{Visibility: client.VisibilityShared}, uuid.Nil, client.ErrNotValidAccess},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := conn.Upload(ctx, bytes.NewReader(tc.content), tc.policy, tc.access)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestClient_GetQuota(t *testing.T) {
+	t.Parallel()
+
+	file := []byte("content")
+	conn, _, assert := start(t, uuid.Must(uuid.NewV4()), nil, file)
+
+	testCases := []struct {
+		name    string
+		owner   client.Principal
+		want    *client.Quota
+		wantErr error
+	}{
+		{"success", "user:1", &client.Quota{Owner: "user:1", MaxBytes: 100, MaxFiles: 10, UsedBytes: int64(len(file)), UsedFiles: 1}, nil},
+		{"err_not_valid_quota", "", nil, client.ErrNotValidQuota},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := conn.GetQuota(ctx, tc.owner)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestClient_SetQuota(t *testing.T) {
+	t.Parallel()
+
+	file := []byte("content")
+	conn, _, assert := start(t, uuid.Must(uuid.NewV4()), nil, file)
+
+	testCases := []struct {
+		name     string
+		owner    client.Principal
+		maxBytes int64
+		maxFiles int64
+		want     *client.Quota
+		wantErr  error
+	}{
+		{"success", "user:1", 1 << 20, 5, &client.Quota{Owner: "user:1", MaxBytes: 1 << 20, MaxFiles: 5, UsedBytes: int64(len(file)), UsedFiles: 1}, nil},
+		{"err_not_valid_quota", "user:1", -1, 5, nil, client.ErrNotValidQuota},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := conn.SetQuota(ctx, tc.owner, tc.maxBytes, tc.maxFiles)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestClient_List(t *testing.T) {
+	t.Parallel()
+
+	file, err := os.ReadFile(testFile)
+	require.NoError(t, err)
+
+	fileID := uuid.Must(uuid.NewV4())
+	conn, _, assert := start(t, fileID, nil, file)
+
+	createdAt := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	metadata := json.RawMessage(`{"key":"value"}`)
+
+	testCases := []struct {
+		name     string
+		params   client.ListParams
+		want     []client.File
+		wantNext string
+		wantErr  error
+	}{
+		{"success", client.ListParams{CreatedAfter: createdAt, Metadata: metadata, SortBy: client.SortBySize}, []client.File{{
+			ID:          fileID,
+			Owner:       app.ServicePrincipal(service),
+			Access:      client.Access{Visibility: client.VisibilityPublic},
+			Size:        int64(len(file)),
+			ContentType: "image/jpeg",
+			Metadata:    metadata,
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+		}}, "next", nil},
+		{"err_not_valid_params", client.ListParams{Cursor: "cursor"}, nil, "", client.ErrNotValidParams},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, next, err := conn.List(ctx, tc.params)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+			assert.Equal(tc.wantNext, next)
+		})
+	}
+}
+
+func TestClient_Download(t *testing.T) {
+	t.Parallel()
+
+	file, err := os.ReadFile(testFile)
+	require.NoError(t, err)
+
+	fileID := uuid.Must(uuid.NewV4())
+	conn, _, assert := start(t, fileID, nil, file)
+
+	testCases := []struct {
+		name    string
+		fileID  uuid.UUID
+		want    []byte
+		wantErr error
+	}{
+		{"success", fileID, file, nil},
+		{"err_not_found", uuid.Must(uuid.NewV4()), nil, client.ErrNotFound},
+		{"err_access_denied", foreignFileID, nil, client.ErrAccessDenied},
+		{"err_quarantined", quarantinedFileID, nil, client.ErrQuarantined},
+		{"err_infected", infectedFileID, nil, client.ErrInfected},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := conn.Download(ctx, tc.fileID)
+			assert.ErrorIs(err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+
+			res, err := io.ReadAll(r)
+			assert.NoError(err)
+			assert.NoError(r.Close())
+			assert.Equal(tc.want, res)
+		})
+	}
+}
@@ -1,14 +1,17 @@
 package client_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/prometheus/client_golang/prometheus"
@@ -17,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -28,12 +32,27 @@ import (
 	pb "github.com/Meat-Hook/back-template/proto/gen/go/file/v1"
 )
 
+const (
+	testFile     = `../testdata/test.jpg`
+	testFileType = `image/jpeg`
+	service      = `test`
+	serviceKey   = `test-key`
+)
+
 var (
 	logger       = zerolog.New(os.Stdout)
 	reqID        = xid.New()
 	ctx          = log.ReqIDWithCtx(context.Background(), reqID.String())
 	reg          = prometheus.NewPedanticRegistry()
 	clientMetric = rpc.NewClientMetrics(reg, "test")
+	// Owned by other service.
+	foreignFileID = uuid.Must(uuid.NewV4())
+	// Has no quota left.
+	exhaustedAccount = client.Principal("user:exhausted")
+	// Not scanned yet and found infected.
+	quarantinedFileID = uuid.Must(uuid.NewV4())
+	infectedFileID    = uuid.Must(uuid.NewV4())
+	infectedFile      = []byte("X5O!P%@AP[4\\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*")
 )
 
 func start(t *testing.T, fileID uuid.UUID, fileMD json.RawMessage, file []byte) (*client.Client, *serverMock, *require.Assertions) {
@@ -57,7 +76,7 @@ func start(t *testing.T, fileID uuid.UUID, fileMD json.RawMessage, file []byte)
 	conn, err := rpc.Dial(ctx, logger, ln.Addr().String(), clientMetric)
 	assert.NoError(err)
 
-	svc := client.New(conn)
+	svc := client.New(conn, service, serviceKey)
 
 	return svc, mock, assert
 }
@@ -65,16 +84,55 @@ func start(t *testing.T, fileID uuid.UUID, fileMD json.RawMessage, file []byte)
 var _ pb.ServiceServer = &serverMock{}
 
 type serverMock struct {
+	pb.UnimplementedServiceServer
 	assert   *require.Assertions
 	fileID   uuid.UUID
 	metadata json.RawMessage
 	file     []byte
 }
 
+// checkCaller returns error if request is not from expected service or file is owned by other service.
+func (s serverMock) checkCaller(ctx context.Context, fileID uuid.UUID) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	caller := md.Get(log.Service)
+	s.assert.Equal([]string{service}, caller)
+	s.assert.Equal([]string{serviceKey}, md.Get(rpc.ServiceKey))
+
+	if fileID == foreignFileID {
+		return status.Error(codes.PermissionDenied, app.ErrAccessDenied.Error())
+	}
+
+	return nil
+}
+
 func (s serverMock) Upload(stream pb.Service_UploadServer) error {
-	res, err := ioutil.ReadAll(server_rpc.NewReader(stream))
+	first, err := stream.Recv()
 	s.assert.NoError(err)
 
+	err = s.checkCaller(stream.Context(), uuid.Nil)
+	if err != nil {
+		return err
+	}
+
+	if first.Access.GetVisibility() == pb.Visibility_VISIBILITY_SHARED && len(first.Access.GetSharedWith()) == 0 {
+		return status.Error(codes.InvalidArgument, app.ErrNotValidAccess.Error())
+	}
+
+	res, err := ioutil.ReadAll(server_rpc.NewReader(first, stream))
+	s.assert.NoError(err)
+
+	policy := first.Policy
+	switch {
+	case len(policy.GetAllowedTypes()) > 0 && policy.AllowedTypes[0] != testFileType:
+		return status.Error(codes.InvalidArgument, app.ErrNotAllowedType.Error())
+	case policy.GetMaxSize() > 0 && int64(len(res)) > policy.MaxSize:
+		return status.Error(codes.OutOfRange, app.ErrTooLarge.Error())
+	case policy.GetAccount() == string(exhaustedAccount):
+		return status.Error(codes.ResourceExhausted, app.ErrQuotaExceeded.Error())
+	case bytes.Equal(res, infectedFile):
+		return status.Error(codes.FailedPrecondition, app.ErrInfected.Error())
+	}
+
 	s.assert.Equal(s.file, res)
 
 	return stream.SendAndClose(&pb.UploadResponse{
@@ -84,12 +142,46 @@ func (s serverMock) Upload(stream pb.Service_UploadServer) error {
 	})
 }
 
-func (s serverMock) SetMetadata(_ context.Context, request *pb.SetMetadataRequest) (*pb.SetMetadataResponse, error) {
+func (s serverMock) Download(request *pb.DownloadRequest, stream pb.Service_DownloadServer) error {
+	fileID, err := uuid.FromString(request.FileId.Value)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, app.ErrNotValidID.Error())
+	}
+
+	err = s.checkCaller(stream.Context(), fileID)
+	if err != nil {
+		return err
+	}
+
+	switch fileID {
+	case s.fileID:
+	case quarantinedFileID:
+		return status.Error(codes.FailedPrecondition, app.ErrQuarantined.Error())
+	case infectedFileID:
+		return status.Error(codes.FailedPrecondition, app.ErrInfected.Error())
+	default:
+		return status.Error(codes.NotFound, app.ErrNotFound.Error())
+	}
+
+	_, err = io.CopyBuffer(server_rpc.NewWriter(stream), bytes.NewReader(s.file), make([]byte, app.MaxChunkSize))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (s serverMock) SetMetadata(ctx context.Context, request *pb.SetMetadataRequest) (*pb.SetMetadataResponse, error) {
 	fileID, err := uuid.FromString(request.FileId.Value)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidID.Error())
 	}
 
+	err = s.checkCaller(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
 	if s.fileID != fileID {
 		return nil, status.Error(codes.NotFound, app.ErrNotFound.Error())
 	}
@@ -108,15 +200,162 @@ func (s serverMock) SetMetadata(_ context.Context, request *pb.SetMetadataReques
 	return &pb.SetMetadataResponse{Empty: &emptypb.Empty{}}, nil
 }
 
-func (s serverMock) Delete(_ context.Context, request *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+func (s serverMock) SetAccess(ctx context.Context, request *pb.SetAccessRequest) (*pb.SetAccessResponse, error) {
+	fileID, err := uuid.FromString(request.FileId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidID.Error())
+	}
+
+	err = s.checkCaller(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.fileID != fileID {
+		return nil, status.Error(codes.NotFound, app.ErrNotFound.Error())
+	}
+
+	if request.Access.GetVisibility() == pb.Visibility_VISIBILITY_SHARED && len(request.Access.GetSharedWith()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidAccess.Error())
+	}
+
+	return &pb.SetAccessResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (s serverMock) SignURL(ctx context.Context, request *pb.SignURLRequest) (*pb.SignURLResponse, error) {
+	fileID, err := uuid.FromString(request.FileId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidID.Error())
+	}
+
+	err = s.checkCaller(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.fileID != fileID {
+		return nil, status.Error(codes.NotFound, app.ErrNotFound.Error())
+	}
+
+	if !request.ExpiresAt.AsTime().After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidExpiry.Error())
+	}
+
+	return &pb.SignURLResponse{
+		Url: fmt.Sprintf("/file?id=%s&ip=%s&sig=sig", fileID, request.Ip),
+	}, nil
+}
+
+func (s serverMock) List(ctx context.Context, request *pb.ListRequest) (*pb.ListResponse, error) {
+	s.assert.NoError(s.checkCaller(ctx, uuid.Nil))
+
+	if request.Cursor != "" {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidCursor.Error())
+	}
+
+	return &pb.ListResponse{
+		Files: []*pb.FileInfo{{
+			Id:          &pb.UUID{Value: s.fileID.String()},
+			Owner:       string(app.ServicePrincipal(service)),
+			Access:      &pb.Access{Visibility: pb.Visibility_VISIBILITY_PUBLIC},
+			Size:        int64(len(s.file)),
+			ContentType: testFileType,
+			Metadata:    request.Metadata,
+			CreatedAt:   request.CreatedAfter,
+			UpdatedAt:   request.CreatedAfter,
+		}},
+		NextCursor: "next",
+	}, nil
+}
+
+func (s serverMock) Delete(ctx context.Context, request *pb.DeleteRequest) (*pb.DeleteResponse, error) {
 	fileID, err := uuid.FromString(request.FileId.Value)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidID.Error())
 	}
 
+	err = s.checkCaller(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
 	if s.fileID != fileID {
 		return nil, status.Error(codes.NotFound, app.ErrNotFound.Error())
 	}
 
 	return &pb.DeleteResponse{Empty: &emptypb.Empty{}}, nil
 }
+
+func (s serverMock) Claim(ctx context.Context, request *pb.ClaimRequest) (*pb.ClaimResponse, error) {
+	fileID, err := uuid.FromString(request.FileId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidID.Error())
+	}
+
+	err = s.checkCaller(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Ref == "" {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidRef.Error())
+	}
+
+	if s.fileID != fileID {
+		return nil, status.Error(codes.NotFound, app.ErrNotFound.Error())
+	}
+
+	return &pb.ClaimResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (s serverMock) Release(ctx context.Context, request *pb.ReleaseRequest) (*pb.ReleaseResponse, error) {
+	fileID, err := uuid.FromString(request.FileId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidID.Error())
+	}
+
+	err = s.checkCaller(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Ref == "" {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidRef.Error())
+	}
+
+	if s.fileID != fileID {
+		return nil, status.Error(codes.NotFound, app.ErrNotFound.Error())
+	}
+
+	return &pb.ReleaseResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (s serverMock) GetQuota(ctx context.Context, request *pb.GetQuotaRequest) (*pb.GetQuotaResponse, error) {
+	s.assert.NoError(s.checkCaller(ctx, uuid.Nil))
+
+	if request.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidQuota.Error())
+	}
+
+	return &pb.GetQuotaResponse{
+		Quota: &pb.Quota{Owner: request.Owner, MaxBytes: 100, MaxFiles: 10, UsedBytes: int64(len(s.file)), UsedFiles: 1},
+	}, nil
+}
+
+func (s serverMock) SetQuota(ctx context.Context, request *pb.SetQuotaRequest) (*pb.SetQuotaResponse, error) {
+	s.assert.NoError(s.checkCaller(ctx, uuid.Nil))
+
+	if request.Owner == "" || request.MaxBytes < 0 || request.MaxFiles < 0 {
+		return nil, status.Error(codes.InvalidArgument, app.ErrNotValidQuota.Error())
+	}
+
+	return &pb.SetQuotaResponse{
+		Quota: &pb.Quota{
+			Owner:     request.Owner,
+			MaxBytes:  request.MaxBytes,
+			MaxFiles:  request.MaxFiles,
+			UsedBytes: int64(len(s.file)),
+			UsedFiles: 1,
+		},
+	}, nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"os"
+
+	pb "github.com/Meat-Hook/back-template/proto/gen/go/file/v1"
+)
+
+// reader implements io.ReadCloser for gRPC download stream.
+type reader struct {
+	stream   pb.Service_DownloadClient
+	cancel   context.CancelFunc
+	cache    []byte
+	isClosed bool
+	error    error
+}
+
+// Read for implemented io.Reader.
+func (r *reader) Read(b []byte) (int, error) {
+	switch {
+	case r.isClosed:
+		return 0, os.ErrClosed
+	case len(b) == 0:
+		return 0, nil
+	}
+
+	for len(r.cache) == 0 {
+		if r.error != nil {
+			return 0, r.error
+		}
+
+		r.error = r.recv()
+	}
+
+	n := copy(b, r.cache)
+	r.cache = r.cache[n:]
+
+	return n, nil
+}
+
+// Close for implemented io.Closer.
+func (r *reader) Close() error {
+	if r.isClosed {
+		return os.ErrClosed
+	}
+
+	r.isClosed = true
+	r.cancel()
+
+	return nil
+}
+
+func (r *reader) recv() error {
+	msg, err := r.stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	r.cache = msg.Chunk.GetContent()
+
+	return nil
+}
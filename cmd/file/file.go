@@ -2,8 +2,11 @@ package file
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	_ "github.com/lib/pq"
 	"github.com/prometheus/client_golang/prometheus"
@@ -13,7 +16,15 @@ import (
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/restapi"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/clamd"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/disk"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/envelope"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/services/repo"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/s3"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/session"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/signer"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/thumbnail"
+	session_client "github.com/Meat-Hook/back-template/cmd/session/client"
 	"github.com/Meat-Hook/back-template/libs/db"
 	"github.com/Meat-Hook/back-template/libs/log"
 	"github.com/Meat-Hook/back-template/libs/reflect"
@@ -36,10 +47,81 @@ type config struct {
 			GRPC   int `json:"grpc"`
 		} `json:"port"`
 	} `json:"server"`
+	Storage struct {
+		Type             string  `json:"type"`
+		Dir              string  `json:"dir"`
+		ChunkSize        int     `json:"chunk_size"`
+		ReadAhead        int     `json:"read_ahead"`
+		CompressionRatio float64 `json:"compression_ratio"`
+		S3               struct {
+			Endpoint  string `json:"endpoint"`
+			AccessKey string `json:"access_key"`
+			SecretKey string `json:"secret_key"`
+			Bucket    string `json:"bucket"`
+			Region    string `json:"region"`
+			UseSSL    bool   `json:"use_ssl"`
+		} `json:"s3"`
+		// Encryption is disabled if KeyID is empty.
+		// Encrypted files are never deduplicated, because every file has own data key.
+		Encryption struct {
+			KeyID          string            `json:"key_id"`
+			Keys           map[string]string `json:"keys"`
+			RewrapInterval string            `json:"rewrap_interval"`
+			// RewrapBatchSize contains amount of files rewrapped by one transaction.
+			RewrapBatchSize int `json:"rewrap_batch_size"`
+		} `json:"encryption"`
+	} `json:"storage"`
+	Upload struct {
+		TTL        string `json:"ttl"`
+		GCInterval string `json:"gc_interval"`
+	} `json:"upload"`
+	Quota struct {
+		MaxBytes int64 `json:"max_bytes"`
+		MaxFiles int64 `json:"max_files"`
+	} `json:"quota"`
+	Reaper struct {
+		Interval  string `json:"interval"`
+		TTL       string `json:"ttl"`
+		BatchSize int    `json:"batch_size"`
+		DryRun    bool   `json:"dry_run"`
+	} `json:"reaper"`
+	Scan struct {
+		ClamdAddr string `json:"clamd_addr"`
+		Timeout   string `json:"timeout"`
+		Interval  string `json:"interval"`
+		BatchSize int    `json:"batch_size"`
+	} `json:"scan"`
+	Services struct {
+		SessionAddr string `json:"session_addr"`
+	} `json:"services"`
+	Sign struct {
+		Key         string `json:"key"`
+		DownloadURL string `json:"download_url"`
+	} `json:"sign"`
+	// ServiceKeys contains keys of services allowed to call grpc api by service name.
+	ServiceKeys map[string]string `json:"service_keys"`
 }
 
+// Storage types.
+const (
+	storageDB   = "db"
+	storageDisk = "disk"
+	storageS3   = "s3"
+)
+
 const version = "v0.1.0"
 
+var (
+	errUnknownStorage    = errors.New("unknown storage type")
+	errEmptySignKey      = errors.New("empty sign key")
+	errNotValidChunkSize = errors.New("not valid chunk size")
+	errNotValidReadAhead = errors.New("not valid read ahead size")
+	errNotValidRatio     = errors.New("not valid compression ratio")
+	errNotValidBatchSize = errors.New("not valid batch size")
+	errNotValidQuota     = errors.New("not valid default quota")
+	errNotValidInterval  = errors.New("not valid interval")
+)
+
 // Service module implementation.
 type Service struct {
 	cfg config
@@ -74,12 +156,79 @@ func (s *Service) RunServe(ctx context.Context, reg *prometheus.Registry, namesp
 		return fmt.Errorf("db.Postgres: %w", err)
 	}
 
+	grpcConnSession, err := librpc.Dial(ctx, logger, s.cfg.Services.SessionAddr, librpc.NewClientMetrics(reg, namespace))
+	if err != nil {
+		return fmt.Errorf("librpc.Dial: %w", err)
+	}
+
+	if s.cfg.Sign.Key == "" {
+		return errEmptySignKey
+	}
+
+	if s.cfg.Quota.MaxBytes < 0 || s.cfg.Quota.MaxFiles < 0 {
+		return fmt.Errorf("%w: %d bytes, %d files", errNotValidQuota, s.cfg.Quota.MaxBytes, s.cfg.Quota.MaxFiles)
+	}
+
 	// Build contracts.
-	r := repo.New(pg)
+	r := repo.New(pg, repo.DefaultQuota(s.cfg.Quota.MaxBytes, s.cfg.Quota.MaxFiles))
+	blob, err := s.blobStore(ctx, pg, reg, namespace)
+	if err != nil {
+		return fmt.Errorf("s.blobStore: %w", err)
+	}
+
+	// Files aren't scanned if daemon address isn't set.
+	var scanner app.Scanner
+	if s.cfg.Scan.ClamdAddr != "" {
+		scanTimeout, err := time.ParseDuration(s.cfg.Scan.Timeout)
+		if err != nil {
+			return fmt.Errorf("time.ParseDuration: %w", err)
+		}
+
+		scanner = clamd.New(clamd.Config{Addr: s.cfg.Scan.ClamdAddr, Timeout: scanTimeout})
+	}
+
+	module := app.New(r, blob, r, thumbnail.New(), session.New(session_client.New(grpcConnSession)),
+		signer.New([]byte(s.cfg.Sign.Key)), scanner)
+
+	uploadTTL, err := time.ParseDuration(s.cfg.Upload.TTL)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	uploadGCInterval, err := time.ParseDuration(s.cfg.Upload.GCInterval)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	reapInterval, err := time.ParseDuration(s.cfg.Reaper.Interval)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	if uploadGCInterval <= 0 || reapInterval <= 0 {
+		return fmt.Errorf("%w: upload gc %s, reaper %s", errNotValidInterval, uploadGCInterval, reapInterval)
+	}
+
+	reapTTL, err := time.ParseDuration(s.cfg.Reaper.TTL)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	if s.cfg.Reaper.BatchSize <= 0 {
+		return fmt.Errorf("%w: %d", errNotValidBatchSize, s.cfg.Reaper.BatchSize)
+	}
 
-	module := app.New(r)
+	reapParams := app.ReapParams{
+		TTL:    reapTTL,
+		Limit:  s.cfg.Reaper.BatchSize,
+		DryRun: s.cfg.Reaper.DryRun,
+	}
+	reapMetric := newReaperMetrics(reg, namespace)
 
-	grpcAPI := rpc.New(ctx, module, librpc.NewServerMetrics(reg, namespace))
+	grpcAPI := rpc.New(ctx, module, librpc.NewServerMetrics(reg, namespace), rpc.Config{
+		DownloadURL: s.cfg.Sign.DownloadURL,
+		ServiceKeys: s.cfg.ServiceKeys,
+	})
 
 	webMetric := libweb.NewMetric(reg, namespace, restapi.FlatSwaggerJSON)
 	webAPI, err := web.New(ctx, module, &webMetric, web.Config{
@@ -90,10 +239,152 @@ func (s *Service) RunServe(ctx context.Context, reg *prometheus.Registry, namesp
 		return fmt.Errorf("web.New: %w", err)
 	}
 
-	return serve.Start(
-		ctx,
+	services := []func(context.Context) error{
 		serve.Metrics(logger.With().Str(log.Subsystem, "metric").Logger(), s.cfg.Server.Host, s.cfg.Server.Port.Metric, reg),
 		serve.HTTP(logger.With().Str(log.Subsystem, "web").Logger(), s.cfg.Server.Host, s.cfg.Server.Port.WEB, webAPI.GetHandler()),
 		serve.GRPC(logger.With().Str(log.Subsystem, "grpc").Logger(), s.cfg.Server.Host, s.cfg.Server.Port.GRPC, grpcAPI),
-	)
+		serve.Periodic(logger.With().Str(log.Subsystem, "upload-gc").Logger(), uploadGCInterval, func(ctx context.Context) error {
+			deleted, err := module.DeleteStaleUploads(ctx, uploadTTL)
+			if err != nil {
+				return fmt.Errorf("module.DeleteStaleUploads: %w", err)
+			}
+
+			zerolog.Ctx(ctx).Info().Int64("deleted", deleted).Msg("stale uploads removed")
+
+			return nil
+		}),
+		serve.Periodic(logger.With().Str(log.Subsystem, "reaper").Logger(), reapInterval, func(ctx context.Context) error {
+			res, err := module.Reap(ctx, reapParams)
+			if res != nil {
+				reapMetric.observe(res, reapParams.DryRun)
+				zerolog.Ctx(ctx).Info().Bool("dry_run", reapParams.DryRun).Int("files", len(res.Files)).
+					Int64("chunks", res.Chunks).Msg("orphans reaped")
+			}
+			if err != nil {
+				return fmt.Errorf("module.Reap: %w", err)
+			}
+
+			return nil
+		}),
+	}
+
+	if scanner != nil {
+		scanInterval, err := time.ParseDuration(s.cfg.Scan.Interval)
+		if err != nil {
+			return fmt.Errorf("time.ParseDuration: %w", err)
+		}
+
+		if scanInterval <= 0 {
+			return fmt.Errorf("%w: scan %s", errNotValidInterval, scanInterval)
+		}
+
+		if s.cfg.Scan.BatchSize <= 0 {
+			return fmt.Errorf("%w: %d", errNotValidBatchSize, s.cfg.Scan.BatchSize)
+		}
+
+		services = append(services, serve.Periodic(logger.With().Str(log.Subsystem, "scan").Logger(), scanInterval, func(ctx context.Context) error {
+			scanned, err := module.ScanQuarantined(ctx, s.cfg.Scan.BatchSize)
+			if err != nil {
+				return fmt.Errorf("module.ScanQuarantined: %w", err)
+			}
+
+			zerolog.Ctx(ctx).Info().Int("scanned", scanned).Msg("quarantined files scanned")
+
+			return nil
+		}))
+	}
+
+	// Data keys are rewrapped only by chunk store, other storages don't encrypt files.
+	chunks, ok := blob.(*repo.ChunkStore)
+	if ok && s.cfg.Storage.Encryption.KeyID != "" {
+		rewrapInterval, err := time.ParseDuration(s.cfg.Storage.Encryption.RewrapInterval)
+		if err != nil {
+			return fmt.Errorf("time.ParseDuration: %w", err)
+		}
+
+		if rewrapInterval <= 0 {
+			return fmt.Errorf("%w: rewrap %s", errNotValidInterval, rewrapInterval)
+		}
+
+		if s.cfg.Storage.Encryption.RewrapBatchSize <= 0 {
+			return fmt.Errorf("%w: %d", errNotValidBatchSize, s.cfg.Storage.Encryption.RewrapBatchSize)
+		}
+
+		services = append(services, serve.Periodic(logger.With().Str(log.Subsystem, "rewrap").Logger(), rewrapInterval, func(ctx context.Context) error {
+			rewrapped, err := chunks.Rewrap(ctx, s.cfg.Storage.Encryption.RewrapBatchSize)
+			if err != nil {
+				return fmt.Errorf("chunks.Rewrap: %w", err)
+			}
+
+			zerolog.Ctx(ctx).Info().Int64("rewrapped", rewrapped).Msg("data keys rewrapped")
+
+			return nil
+		}))
+	}
+
+	return serve.Start(ctx, services...)
+}
+
+func (s *Service) blobStore(ctx context.Context, pg *db.DB, reg *prometheus.Registry, namespace string) (app.BlobStore, error) {
+	switch s.cfg.Storage.Type {
+	case storageDB, "":
+		options := []repo.ChunkStoreOption{repo.Metric(repo.NewMetrics(reg, namespace))}
+		switch {
+		case s.cfg.Storage.ChunkSize < 0:
+			return nil, fmt.Errorf("%w: %d", errNotValidChunkSize, s.cfg.Storage.ChunkSize)
+		case s.cfg.Storage.ChunkSize > 0:
+			options = append(options, repo.ChunkSize(s.cfg.Storage.ChunkSize))
+		}
+
+		switch {
+		case s.cfg.Storage.ReadAhead < 0:
+			return nil, fmt.Errorf("%w: %d", errNotValidReadAhead, s.cfg.Storage.ReadAhead)
+		case s.cfg.Storage.ReadAhead > 0:
+			options = append(options, repo.ReadAhead(s.cfg.Storage.ReadAhead))
+		}
+
+		switch ratio := s.cfg.Storage.CompressionRatio; {
+		case ratio < 0 || ratio > 1:
+			return nil, fmt.Errorf("%w: %g", errNotValidRatio, ratio)
+		case ratio > 0:
+			options = append(options, repo.Compression(ratio))
+		}
+
+		if s.cfg.Storage.Encryption.KeyID != "" {
+			wrapper, err := s.envelope()
+			if err != nil {
+				return nil, fmt.Errorf("s.envelope: %w", err)
+			}
+
+			options = append(options, repo.Encryption(wrapper))
+		}
+
+		return repo.NewChunkStore(pg, options...), nil
+	case storageDisk:
+		return disk.New(s.cfg.Storage.Dir)
+	case storageS3:
+		return s3.New(ctx, s3.Config{
+			Endpoint:  s.cfg.Storage.S3.Endpoint,
+			AccessKey: s.cfg.Storage.S3.AccessKey,
+			SecretKey: s.cfg.Storage.S3.SecretKey,
+			Bucket:    s.cfg.Storage.S3.Bucket,
+			Region:    s.cfg.Storage.S3.Region,
+			UseSSL:    s.cfg.Storage.S3.UseSSL,
+		})
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownStorage, s.cfg.Storage.Type)
+	}
+}
+
+func (s *Service) envelope() (*envelope.Envelope, error) {
+	keys := make(map[string][]byte, len(s.cfg.Storage.Encryption.Keys))
+	for id, key := range s.cfg.Storage.Encryption.Keys {
+		var err error
+		keys[id], err = base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("base64.StdEncoding.DecodeString: %w", err)
+		}
+	}
+
+	return envelope.New(s.cfg.Storage.Encryption.KeyID, keys)
 }
@@ -3,36 +3,81 @@ package rpc
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"io"
+	"strings"
 
 	"github.com/gofrs/uuid"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/libs/log"
 	"github.com/Meat-Hook/back-template/libs/rpc"
 	pb "github.com/Meat-Hook/back-template/proto/gen/go/file/v1"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // For convenient testing.
 // Wrapper for app.Module.
 type files interface {
-	UploadFile(ctx context.Context, file io.Reader) (uuid.UUID, error)
-	SetMetadata(ctx context.Context, fileID uuid.UUID, metadata json.RawMessage) error
-	Delete(ctx context.Context, fileID uuid.UUID) error
+	UploadFile(ctx context.Context, owner app.Principal, file io.Reader, policy app.Policy, access app.Access) (*app.File, error)
+	GetFile(ctx context.Context, principal app.Principal, fileID uuid.UUID) (*app.File, error)
+	SetMetadata(ctx context.Context, principal app.Principal, fileID uuid.UUID, metadata json.RawMessage) error
+	SetAccess(ctx context.Context, principal app.Principal, fileID uuid.UUID, access app.Access) error
+	Delete(ctx context.Context, principal app.Principal, fileID uuid.UUID) error
+	CreateUpload(ctx context.Context, owner app.Principal, size int64, policy app.Policy) (*app.UploadSession, error)
+	GetUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID) (*app.UploadSession, error)
+	UploadPart(ctx context.Context, principal app.Principal, sessionID uuid.UUID, number int, part io.Reader) (*app.Part, error)
+	CompleteUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID, access app.Access) (*app.File, error)
+	AbortUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID) error
+	List(ctx context.Context, principal app.Principal, params app.ListParams) ([]app.File, string, error)
+	SignURL(ctx context.Context, principal app.Principal, u app.SignedURL) (*app.SignedURL, error)
+	ClaimFile(ctx context.Context, principal app.Principal, fileID uuid.UUID, ref string) error
+	ReleaseFile(ctx context.Context, principal app.Principal, fileID uuid.UUID, ref string) error
+	GetQuota(ctx context.Context, principal app.Principal, owner app.Principal) (*app.Quota, error)
+	SetQuota(ctx context.Context, principal app.Principal, quota app.Quota) (*app.Quota, error)
+}
+
+// Config contains settings of grpc api.
+type Config struct {
+	// DownloadURL is public URL of web api getFile method, used for building signed URLs.
+	DownloadURL string
+	// ServiceKeys contains keys of services allowed to call api by service name,
+	// calls without valid service key are anonymous.
+	ServiceKeys map[string]string
 }
 
 type api struct {
 	app files
+	cfg Config
 }
 
 // New register service by grpc.Server and register metrics.
-func New(ctx context.Context, applications files, metric *grpc_prometheus.ServerMetrics) *grpc.Server {
+func New(ctx context.Context, applications files, metric *grpc_prometheus.ServerMetrics, cfg Config) *grpc.Server {
 	logger := zerolog.Ctx(ctx)
 	srv := rpc.Server(*logger, metric)
-	pb.RegisterServiceServer(srv, &api{app: applications})
+	pb.RegisterServiceServer(srv, &api{app: applications, cfg: cfg})
 
 	return srv
 }
+
+// principal returns identity of calling service passed in metadata
+// if it's authenticated by service key.
+func (a *api) principal(ctx context.Context) app.Principal {
+	md, _ := metadata.FromIncomingContext(ctx)
+	name := strings.Join(md.Get(log.Service), "")
+	key := a.cfg.ServiceKeys[name]
+	if name == "" || key == "" {
+		return app.Anonymous
+	}
+
+	if subtle.ConstantTimeCompare([]byte(key), []byte(strings.Join(md.Get(rpc.ServiceKey), ""))) != 1 {
+		return app.Anonymous
+	}
+
+	return app.ServicePrincipal(name)
+}
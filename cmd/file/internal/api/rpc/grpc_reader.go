@@ -12,15 +12,39 @@ var _ io.Reader = &Reader{}
 
 // Reader implements io.Reader for gRPC stream.
 type Reader struct {
-	stream pb.Service_UploadServer
-	cache  []byte
+	recv  func() (*pb.Chunk, error)
+	cache []byte
 }
 
 // NewReader build new instance gRPC reader.
-func NewReader(stream pb.Service_UploadServer) io.Reader {
+// The first message is already received for getting upload policy.
+func NewReader(first *pb.UploadRequest, stream pb.Service_UploadServer) io.Reader {
 	return &Reader{
-		stream: stream,
-		cache:  []byte{},
+		recv: func() (*pb.Chunk, error) {
+			msg, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+
+			return msg.Chunk, nil
+		},
+		cache: first.Chunk.GetContent(),
+	}
+}
+
+// NewPartReader build new instance gRPC reader for upload part stream.
+// The first message is already received for getting session id and part number.
+func NewPartReader(first *pb.UploadPartRequest, stream pb.Service_UploadPartServer) io.Reader {
+	return &Reader{
+		recv: func() (*pb.Chunk, error) {
+			msg, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+
+			return msg.Chunk, nil
+		},
+		cache: first.Chunk.GetContent(),
 	}
 }
 
@@ -29,7 +53,7 @@ func (r *Reader) Read(b []byte) (sent int, err error) {
 	var res []byte
 
 	if r.cacheIsEmpty() {
-		msg, err := r.stream.Recv()
+		chunk, err := r.recv()
 		if err != nil && !errors.Is(err, io.EOF) {
 			return 0, fmt.Errorf("stream recv: %w", err)
 		}
@@ -38,7 +62,7 @@ func (r *Reader) Read(b []byte) (sent int, err error) {
 			return 0, io.EOF
 		}
 
-		res = msg.Chunk.Content
+		res = chunk.GetContent()
 	} else {
 		res = r.pullCache()
 	}
@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"fmt"
+	"io"
+
+	pb "github.com/Meat-Hook/back-template/proto/gen/go/file/v1"
+)
+
+var _ io.Writer = &Writer{}
+
+// Writer implements io.Writer for gRPC stream.
+type Writer struct {
+	stream pb.Service_DownloadServer
+}
+
+// NewWriter build new instance gRPC writer.
+func NewWriter(stream pb.Service_DownloadServer) io.Writer {
+	return &Writer{
+		stream: stream,
+	}
+}
+
+// Write for implemented io.Writer.
+func (w *Writer) Write(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	err := w.stream.Send(&pb.DownloadResponse{
+		Chunk: &pb.Chunk{
+			Content: b,
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("stream send: %w", err)
+	}
+
+	return len(b), nil
+}
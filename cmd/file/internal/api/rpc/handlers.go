@@ -3,26 +3,47 @@ package rpc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
 
 	"github.com/gofrs/uuid"
+	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/libs/log"
 	pb "github.com/Meat-Hook/back-template/proto/gen/go/file/v1"
 )
 
 // Upload file to database.
+// The first message contains upload policy.
 func (a *api) Upload(stream pb.Service_UploadServer) error {
-	reader := NewReader(stream)
+	first, err := stream.Recv()
+	switch {
+	case errors.Is(err, io.EOF):
+		first = &pb.UploadRequest{}
+	case err != nil:
+		return apiError(err)
+	}
 
-	id, err := a.app.UploadFile(stream.Context(), reader)
+	ctx := stream.Context()
+	file, err := a.app.UploadFile(ctx, a.principal(ctx), NewReader(first, stream), appPolicy(first.Policy), appAccess(first.Access))
 	if err != nil {
 		return apiError(err)
 	}
 
-	return stream.SendAndClose(&pb.UploadResponse{FileId: &pb.UUID{Value: id.String()}})
+	return stream.SendAndClose(&pb.UploadResponse{
+		FileId:      &pb.UUID{Value: file.ID.String()},
+		Digest:      file.Digest,
+		ContentType: file.ContentType,
+	})
 }
 
 // SetMetadata for file.
@@ -37,7 +58,7 @@ func (a *api) SetMetadata(ctx context.Context, request *pb.SetMetadataRequest) (
 		return nil, apiError(err)
 	}
 
-	err = a.app.SetMetadata(ctx, id, js)
+	err = a.app.SetMetadata(ctx, a.principal(ctx), id, js)
 	if err != nil {
 		return nil, apiError(err)
 	}
@@ -52,7 +73,7 @@ func (a *api) Delete(ctx context.Context, request *pb.DeleteRequest) (*pb.Delete
 		return nil, apiError(app.ErrNotValidID)
 	}
 
-	err = a.app.Delete(ctx, id)
+	err = a.app.Delete(ctx, a.principal(ctx), id)
 	if err != nil {
 		return nil, apiError(err)
 	}
@@ -60,6 +81,374 @@ func (a *api) Delete(ctx context.Context, request *pb.DeleteRequest) (*pb.Delete
 	return &pb.DeleteResponse{Empty: &emptypb.Empty{}}, nil
 }
 
+// SetAccess for file.
+func (a *api) SetAccess(ctx context.Context, request *pb.SetAccessRequest) (*pb.SetAccessResponse, error) {
+	id, err := uuid.FromString(request.FileId.GetValue())
+	if err != nil {
+		return nil, apiError(app.ErrNotValidID)
+	}
+
+	err = a.app.SetAccess(ctx, a.principal(ctx), id, appAccess(request.Access))
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.SetAccessResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+// Claim add reference to file.
+func (a *api) Claim(ctx context.Context, request *pb.ClaimRequest) (*pb.ClaimResponse, error) {
+	id, err := uuid.FromString(request.FileId.GetValue())
+	if err != nil {
+		return nil, apiError(app.ErrNotValidID)
+	}
+
+	err = a.app.ClaimFile(ctx, a.principal(ctx), id, request.Ref)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.ClaimResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+// Release remove reference to file.
+func (a *api) Release(ctx context.Context, request *pb.ReleaseRequest) (*pb.ReleaseResponse, error) {
+	id, err := uuid.FromString(request.FileId.GetValue())
+	if err != nil {
+		return nil, apiError(app.ErrNotValidID)
+	}
+
+	err = a.app.ReleaseFile(ctx, a.principal(ctx), id, request.Ref)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.ReleaseResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+// GetQuota returns storage limits and usage of principal.
+func (a *api) GetQuota(ctx context.Context, request *pb.GetQuotaRequest) (*pb.GetQuotaResponse, error) {
+	quota, err := a.app.GetQuota(ctx, a.principal(ctx), app.Principal(request.Owner))
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.GetQuotaResponse{Quota: apiQuota(quota)}, nil
+}
+
+// SetQuota sets storage limits of principal.
+func (a *api) SetQuota(ctx context.Context, request *pb.SetQuotaRequest) (*pb.SetQuotaResponse, error) {
+	quota, err := a.app.SetQuota(ctx, a.principal(ctx), app.Quota{
+		Owner:    app.Principal(request.Owner),
+		MaxBytes: request.MaxBytes,
+		MaxFiles: request.MaxFiles,
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.SetQuotaResponse{Quota: apiQuota(quota)}, nil
+}
+
+// SignURL sign expiring download URL of file readable by caller.
+func (a *api) SignURL(ctx context.Context, request *pb.SignURLRequest) (*pb.SignURLResponse, error) {
+	id, err := uuid.FromString(request.FileId.GetValue())
+	if err != nil {
+		return nil, apiError(app.ErrNotValidID)
+	}
+
+	var ip net.IP
+	if request.Ip != "" {
+		ip = net.ParseIP(request.Ip)
+		if ip == nil {
+			return nil, apiError(app.ErrNotValidIP)
+		}
+	}
+
+	signed, err := a.app.SignURL(ctx, a.principal(ctx), app.SignedURL{
+		FileID:      id,
+		ExpiresAt:   request.ExpiresAt.AsTime(),
+		Disposition: request.Disposition,
+		IP:          ip,
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.SignURLResponse{Url: a.signedURL(signed)}, nil
+}
+
+// Download file from database.
+func (a *api) Download(request *pb.DownloadRequest, stream pb.Service_DownloadServer) error {
+	id, err := uuid.FromString(request.FileId.Value)
+	if err != nil {
+		return apiError(app.ErrNotValidID)
+	}
+
+	ctx := stream.Context()
+	file, err := a.app.GetFile(ctx, a.principal(ctx), id)
+	if err != nil {
+		return apiError(err)
+	}
+	defer log.WarnIfFail(*zerolog.Ctx(ctx), file.Close)
+
+	_, err = io.CopyBuffer(NewWriter(stream), file, make([]byte, app.MaxChunkSize))
+	if err != nil {
+		return apiError(err)
+	}
+
+	return nil
+}
+
+// CreateUpload session.
+func (a *api) CreateUpload(ctx context.Context, request *pb.CreateUploadRequest) (*pb.CreateUploadResponse, error) {
+	session, err := a.app.CreateUpload(ctx, a.principal(ctx), request.Size, appPolicy(request.Policy))
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.CreateUploadResponse{Session: apiUploadSession(session)}, nil
+}
+
+// GetUpload session with received parts.
+func (a *api) GetUpload(ctx context.Context, request *pb.GetUploadRequest) (*pb.GetUploadResponse, error) {
+	id, err := uuid.FromString(request.SessionId.Value)
+	if err != nil {
+		return nil, apiError(app.ErrNotValidID)
+	}
+
+	session, err := a.app.GetUpload(ctx, a.principal(ctx), id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.GetUploadResponse{Session: apiUploadSession(session)}, nil
+}
+
+// UploadPart of upload session.
+func (a *api) UploadPart(stream pb.Service_UploadPartServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return apiError(err)
+	}
+
+	id, err := uuid.FromString(first.SessionId.Value)
+	if err != nil {
+		return apiError(app.ErrNotValidID)
+	}
+
+	ctx := stream.Context()
+	part, err := a.app.UploadPart(ctx, a.principal(ctx), id, int(first.Number), NewPartReader(first, stream))
+	if err != nil {
+		return apiError(err)
+	}
+
+	return stream.SendAndClose(&pb.UploadPartResponse{Part: apiPart(*part)})
+}
+
+// CompleteUpload session and build file.
+func (a *api) CompleteUpload(ctx context.Context, request *pb.CompleteUploadRequest) (*pb.CompleteUploadResponse, error) {
+	id, err := uuid.FromString(request.SessionId.Value)
+	if err != nil {
+		return nil, apiError(app.ErrNotValidID)
+	}
+
+	file, err := a.app.CompleteUpload(ctx, a.principal(ctx), id, appAccess(request.Access))
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.CompleteUploadResponse{
+		FileId: &pb.UUID{Value: file.ID.String()},
+		Digest: file.Digest,
+		Size:   file.Size,
+	}, nil
+}
+
+// AbortUpload session.
+func (a *api) AbortUpload(ctx context.Context, request *pb.AbortUploadRequest) (*pb.AbortUploadResponse, error) {
+	id, err := uuid.FromString(request.SessionId.Value)
+	if err != nil {
+		return nil, apiError(app.ErrNotValidID)
+	}
+
+	err = a.app.AbortUpload(ctx, a.principal(ctx), id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.AbortUploadResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func apiUploadSession(session *app.UploadSession) *pb.UploadSession {
+	parts := make([]*pb.Part, len(session.Parts))
+	for i := range session.Parts {
+		parts[i] = apiPart(session.Parts[i])
+	}
+
+	return &pb.UploadSession{
+		Id:     &pb.UUID{Value: session.ID.String()},
+		Size:   session.Size,
+		Offset: session.Offset(),
+		Parts:  parts,
+	}
+}
+
+// List files.
+func (a *api) List(ctx context.Context, request *pb.ListRequest) (*pb.ListResponse, error) {
+	params := app.ListParams{
+		Owner:       app.Principal(request.Owner),
+		Cursor:      request.Cursor,
+		Limit:       int(request.Limit),
+		MinSize:     request.MinSize,
+		MaxSize:     request.MaxSize,
+		ContentType: request.ContentType,
+		SortBy:      appSortField(request.SortBy),
+		Desc:        request.Desc,
+	}
+
+	if request.CreatedAfter != nil {
+		params.CreatedAfter = request.CreatedAfter.AsTime()
+	}
+	if request.CreatedBefore != nil {
+		params.CreatedBefore = request.CreatedBefore.AsTime()
+	}
+
+	if request.Metadata != nil {
+		js, err := request.Metadata.MarshalJSON()
+		if err != nil {
+			return nil, apiError(err)
+		}
+		params.Metadata = js
+	}
+
+	files, next, err := a.app.List(ctx, a.principal(ctx), params)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	res := &pb.ListResponse{
+		Files:      make([]*pb.FileInfo, len(files)),
+		NextCursor: next,
+	}
+	for i := range files {
+		res.Files[i], err = apiFileInfo(files[i])
+		if err != nil {
+			return nil, apiError(err)
+		}
+	}
+
+	return res, nil
+}
+
+func appSortField(field pb.SortField) app.SortField {
+	switch field {
+	case pb.SortField_SORT_FIELD_SIZE:
+		return app.SortBySize
+	default:
+		return app.SortByCreatedAt
+	}
+}
+
+func apiFileInfo(file app.File) (*pb.FileInfo, error) {
+	res := &pb.FileInfo{
+		Id:          &pb.UUID{Value: file.ID.String()},
+		Size:        file.Size,
+		Digest:      file.Digest,
+		ContentType: file.ContentType,
+		CreatedAt:   timestamppb.New(file.CreatedAt),
+		UpdatedAt:   timestamppb.New(file.UpdatedAt),
+		Owner:       string(file.Owner),
+		Access:      apiAccess(file.Access),
+		ScanStatus:  string(file.Scan.Status),
+		ScanThreat:  file.Scan.Threat,
+	}
+
+	if len(file.Metadata) > 0 {
+		res.Metadata = &structpb.Struct{}
+		err := res.Metadata.UnmarshalJSON(file.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("res.Metadata.UnmarshalJSON: %w", err)
+		}
+	}
+
+	return res, nil
+}
+
+func apiPart(part app.Part) *pb.Part {
+	return &pb.Part{
+		Number: int32(part.Number),
+		Size:   part.Size,
+	}
+}
+
+func appPolicy(policy *pb.UploadPolicy) app.Policy {
+	return app.Policy{
+		AllowedTypes: policy.GetAllowedTypes(),
+		MaxSize:      policy.GetMaxSize(),
+		Account:      app.Principal(policy.GetAccount()),
+	}
+}
+
+func apiQuota(quota *app.Quota) *pb.Quota {
+	return &pb.Quota{
+		Owner:     string(quota.Owner),
+		MaxBytes:  quota.MaxBytes,
+		MaxFiles:  quota.MaxFiles,
+		UsedBytes: quota.UsedBytes,
+		UsedFiles: quota.UsedFiles,
+	}
+}
+
+func appAccess(access *pb.Access) app.Access {
+	res := app.Access{Visibility: app.VisibilityPrivate}
+	switch access.GetVisibility() {
+	case pb.Visibility_VISIBILITY_UNSPECIFIED, pb.Visibility_VISIBILITY_PRIVATE:
+	case pb.Visibility_VISIBILITY_PUBLIC:
+		res.Visibility = app.VisibilityPublic
+	case pb.Visibility_VISIBILITY_SHARED:
+		res.Visibility = app.VisibilityShared
+	default:
+		res.Visibility = app.Visibility(access.GetVisibility().String())
+	}
+
+	for _, principal := range access.GetSharedWith() {
+		res.SharedWith = append(res.SharedWith, app.Principal(principal))
+	}
+
+	return res
+}
+
+func apiAccess(access app.Access) *pb.Access {
+	res := &pb.Access{Visibility: pb.Visibility_VISIBILITY_PRIVATE}
+	switch access.Visibility {
+	case app.VisibilityPublic:
+		res.Visibility = pb.Visibility_VISIBILITY_PUBLIC
+	case app.VisibilityShared:
+		res.Visibility = pb.Visibility_VISIBILITY_SHARED
+	}
+
+	for _, principal := range access.SharedWith {
+		res.SharedWith = append(res.SharedWith, string(principal))
+	}
+
+	return res
+}
+
+// signedURL returns download URL with signed params in query,
+// names of params are same as in getFile web api method.
+func (a *api) signedURL(signed *app.SignedURL) string {
+	q := url.Values{}
+	q.Set("id", signed.FileID.String())
+	q.Set("expires", strconv.FormatInt(signed.ExpiresAt.Unix(), 10))
+	if signed.Disposition != "" {
+		q.Set("disposition", signed.Disposition)
+	}
+	q.Set("sig", signed.Signature)
+
+	return a.cfg.DownloadURL + "?" + q.Encode()
+}
+
 func apiError(err error) error {
 	if err == nil {
 		return nil
@@ -69,8 +458,24 @@ func apiError(err error) error {
 	switch {
 	case errors.Is(err, app.ErrNotFound):
 		code = codes.NotFound
-	case errors.Is(err, app.ErrNotValidID):
+	case errors.Is(err, app.ErrNotValidID), errors.Is(err, app.ErrNotValidPart),
+		errors.Is(err, app.ErrNotValidSize), errors.Is(err, app.ErrEmptyPart), errors.Is(err, app.ErrPartTooLarge):
+		code = codes.InvalidArgument
+	case errors.Is(err, app.ErrUploadIncomplete), errors.Is(err, app.ErrQuarantined), errors.Is(err, app.ErrInfected):
+		code = codes.FailedPrecondition
+	case errors.Is(err, app.ErrNotAllowedType), errors.Is(err, app.ErrNotValidCursor),
+		errors.Is(err, app.ErrNotValidLimit), errors.Is(err, app.ErrNotValidSort), errors.Is(err, app.ErrNotValidMetadata):
+		code = codes.InvalidArgument
+	case errors.Is(err, app.ErrNotValidAccess), errors.Is(err, app.ErrNotValidIP),
+		errors.Is(err, app.ErrNotValidExpiry), errors.Is(err, app.ErrNotValidDisposition), errors.Is(err, app.ErrNotValidRef),
+		errors.Is(err, app.ErrNotValidQuota):
 		code = codes.InvalidArgument
+	case errors.Is(err, app.ErrAccessDenied):
+		code = codes.PermissionDenied
+	case errors.Is(err, app.ErrTooLarge):
+		code = codes.OutOfRange
+	case errors.Is(err, app.ErrQuotaExceeded):
+		code = codes.ResourceExhausted
 	case errors.Is(err, context.DeadlineExceeded):
 		code = codes.DeadlineExceeded
 	case errors.Is(err, context.Canceled):
@@ -5,7 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"net"
 	"os"
 	"testing"
 	"time"
@@ -14,11 +16,15 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/libs/log"
+	librpc "github.com/Meat-Hook/back-template/libs/rpc"
 	pb "github.com/Meat-Hook/back-template/proto/gen/go/file/v1"
 )
 
@@ -57,15 +63,31 @@ func TestApi_Upload(t *testing.T) {
 	buf, err := io.ReadAll(file)
 	assert.NoError(err)
 
+	errNotAllowedType := status.Error(codes.InvalidArgument, app.ErrNotAllowedType.Error())
+	errTooLarge := status.Error(codes.OutOfRange, app.ErrTooLarge.Error())
+	errQuotaExceeded := status.Error(codes.ResourceExhausted, app.ErrQuotaExceeded.Error())
+	errInfected := status.Error(codes.FailedPrecondition, app.ErrInfected.Error())
+
+	policy := app.Policy{AllowedTypes: []string{"image/*"}, MaxSize: int64(len(buf))}
+	accountPolicy := app.Policy{Account: "user:id"}
+	shared := app.Access{Visibility: app.VisibilityShared, SharedWith: []app.Principal{"user:id"}}
+	success := &app.File{ID: fileID, Digest: digest, ContentType: "image/jpeg"}
+	want := &pb.UploadResponse{FileId: &pb.UUID{Value: fileID.String()}, Digest: digest, ContentType: "image/jpeg"}
+
 	testCases := []struct {
 		name    string
-		file    io.Reader
+		policy  app.Policy
 		want    *pb.UploadResponse
-		appRes  uuid.UUID
+		appRes  *app.File
 		appErr  error
 		wantErr error
 	}{
-		{"success", bytes.NewBuffer(buf), &pb.UploadResponse{FileId: &pb.UUID{Value: fileID.String()}}, fileID, nil, nil},
+		{"success", app.Policy{}, want, success, nil, nil},
+		{"success_policy", policy, want, success, nil, nil},
+		{"err_not_allowed_type", policy, nil, nil, app.ErrNotAllowedType, errNotAllowedType},
+		{"err_too_large", policy, nil, nil, app.ErrTooLarge, errTooLarge},
+		{"err_quota_exceeded", accountPolicy, nil, nil, app.ErrQuotaExceeded, errQuotaExceeded},
+		{"err_infected", app.Policy{}, nil, nil, app.ErrInfected, errInfected},
 	}
 
 	for _, tc := range testCases {
@@ -73,34 +95,32 @@ func TestApi_Upload(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
 			defer cancel()
 
 			c, mockApp, assert := start(t)
 
 			mockApp.EXPECT().
-				UploadFile(gomock.Any(), fileMatcher{buf, assert}).
+				UploadFile(gomock.Any(), caller, fileMatcher{buf, assert}, tc.policy, shared).
 				Return(tc.appRes, tc.appErr)
 
 			stream, err := c.Upload(ctx)
 			assert.NoError(err)
 
-			buf = make([]byte, app.MaxChunkSize)
-
-			for {
-				n, err := tc.file.Read(buf)
-				if err != nil && !errors.Is(err, io.EOF) {
-					assert.NoError(err)
-				}
-
-				if n == 0 {
-					break
+			for i := 0; i < len(buf); i += app.MaxChunkSize {
+				end := i + app.MaxChunkSize
+				if end > len(buf) {
+					end = len(buf)
 				}
 
-				in := &pb.UploadRequest{
-					Chunk: &pb.Chunk{
-						Content: buf[:n],
-					},
+				in := &pb.UploadRequest{Chunk: &pb.Chunk{Content: buf[i:end]}}
+				if i == 0 {
+					in.Policy = &pb.UploadPolicy{
+						AllowedTypes: tc.policy.AllowedTypes,
+						MaxSize:      tc.policy.MaxSize,
+						Account:      string(tc.policy.Account),
+					}
+					in.Access = &pb.Access{Visibility: pb.Visibility_VISIBILITY_SHARED, SharedWith: []string{"user:id"}}
 				}
 
 				err = stream.Send(in)
@@ -141,7 +161,7 @@ func TestApi_SetMetadata(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
 			defer cancel()
 
 			c, mockApp, assert := start(t)
@@ -149,7 +169,7 @@ func TestApi_SetMetadata(t *testing.T) {
 			js, err := json.Marshal(md)
 			assert.NoError(err)
 
-			mockApp.EXPECT().SetMetadata(gomock.Any(), tc.fileID, js).Return(tc.appErr)
+			mockApp.EXPECT().SetMetadata(gomock.Any(), caller, tc.fileID, js).Return(tc.appErr)
 
 			st, err := structpb.NewStruct(md)
 			assert.NoError(err)
@@ -170,6 +190,7 @@ func TestApi_Delete(t *testing.T) {
 	t.Parallel()
 
 	errNotFound := status.Error(codes.NotFound, app.ErrNotFound.Error())
+	errAccessDenied := status.Error(codes.PermissionDenied, app.ErrAccessDenied.Error())
 	errDeadline := status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
 	errCanceled := status.Error(codes.Canceled, context.Canceled.Error())
 	errInternal := status.Error(codes.Internal, errAny.Error())
@@ -182,6 +203,7 @@ func TestApi_Delete(t *testing.T) {
 	}{
 		{"success", uuid.Must(uuid.NewV4()), nil, nil},
 		{"err_not_found", uuid.Must(uuid.NewV4()), app.ErrNotFound, errNotFound},
+		{"err_access_denied", uuid.Must(uuid.NewV4()), app.ErrAccessDenied, errAccessDenied},
 		{"err_deadline", uuid.Must(uuid.NewV4()), context.DeadlineExceeded, errDeadline},
 		{"err_canceled", uuid.Must(uuid.NewV4()), context.Canceled, errCanceled},
 		{"err_any", uuid.Must(uuid.NewV4()), errAny, errInternal},
@@ -192,12 +214,12 @@ func TestApi_Delete(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
 			defer cancel()
 
 			c, mockApp, assert := start(t)
 
-			mockApp.EXPECT().Delete(gomock.Any(), tc.fileID).Return(tc.appErr)
+			mockApp.EXPECT().Delete(gomock.Any(), caller, tc.fileID).Return(tc.appErr)
 
 			_, err := c.Delete(ctx, &pb.DeleteRequest{
 				FileId: &pb.UUID{Value: tc.fileID.String()},
@@ -207,3 +229,666 @@ func TestApi_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestApi_SetAccess(t *testing.T) {
+	t.Parallel()
+
+	errNotValidAccess := status.Error(codes.InvalidArgument, app.ErrNotValidAccess.Error())
+	errAccessDenied := status.Error(codes.PermissionDenied, app.ErrAccessDenied.Error())
+
+	testCases := []struct {
+		name      string
+		ctx       context.Context
+		principal app.Principal
+		access    *pb.Access
+		want      app.Access
+		appErr    error
+		wantErr   error
+	}{
+		{"success", callerCtx, caller, &pb.Access{Visibility: pb.Visibility_VISIBILITY_PUBLIC},
+			app.Access{Visibility: app.VisibilityPublic}, nil, nil},
+		{"success_unspecified", callerCtx, caller, nil, private, nil, nil},
+		{"err_not_valid_access", callerCtx, caller, &pb.Access{Visibility: pb.Visibility_VISIBILITY_SHARED},
+			app.Access{Visibility: app.VisibilityShared}, app.ErrNotValidAccess, errNotValidAccess},
+		{"err_anonymous", context.Background(), app.Anonymous, &pb.Access{Visibility: pb.Visibility_VISIBILITY_PUBLIC},
+			app.Access{Visibility: app.VisibilityPublic}, app.ErrAccessDenied, errAccessDenied},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(tc.ctx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().SetAccess(gomock.Any(), tc.principal, fileID, tc.want).Return(tc.appErr)
+
+			_, err := c.SetAccess(ctx, &pb.SetAccessRequest{
+				FileId: &pb.UUID{Value: fileID.String()},
+				Access: tc.access,
+			})
+			assert.ErrorIs(err, tc.wantErr)
+		})
+	}
+}
+
+func TestApi_Claim(t *testing.T) {
+	t.Parallel()
+
+	const ref = "user:1:avatar"
+
+	errNotValidRef := status.Error(codes.InvalidArgument, app.ErrNotValidRef.Error())
+	errNotFound := status.Error(codes.NotFound, app.ErrNotFound.Error())
+	errAccessDenied := status.Error(codes.PermissionDenied, app.ErrAccessDenied.Error())
+
+	testCases := []struct {
+		name   string
+		ref    string
+		appErr error
+		want   error
+	}{
+		{"success", ref, nil, nil},
+		{"err_not_valid_ref", "", app.ErrNotValidRef, errNotValidRef},
+		{"err_not_found", ref, app.ErrNotFound, errNotFound},
+		{"err_access_denied", ref, app.ErrAccessDenied, errAccessDenied},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().ClaimFile(gomock.Any(), caller, fileID, tc.ref).Return(tc.appErr)
+
+			_, err := c.Claim(ctx, &pb.ClaimRequest{
+				FileId: &pb.UUID{Value: fileID.String()},
+				Ref:    tc.ref,
+			})
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestApi_Release(t *testing.T) {
+	t.Parallel()
+
+	const ref = "user:1:avatar"
+
+	errNotFound := status.Error(codes.NotFound, app.ErrNotFound.Error())
+	errAccessDenied := status.Error(codes.PermissionDenied, app.ErrAccessDenied.Error())
+
+	testCases := []struct {
+		name   string
+		appErr error
+		want   error
+	}{
+		{"success", nil, nil},
+		{"err_not_found", app.ErrNotFound, errNotFound},
+		{"err_access_denied", app.ErrAccessDenied, errAccessDenied},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().ReleaseFile(gomock.Any(), caller, fileID, ref).Return(tc.appErr)
+
+			_, err := c.Release(ctx, &pb.ReleaseRequest{
+				FileId: &pb.UUID{Value: fileID.String()},
+				Ref:    ref,
+			})
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestApi_GetQuota(t *testing.T) {
+	t.Parallel()
+
+	errNotValidQuota := status.Error(codes.InvalidArgument, app.ErrNotValidQuota.Error())
+	errAccessDenied := status.Error(codes.PermissionDenied, app.ErrAccessDenied.Error())
+
+	wrongKeyCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(log.Service, "test", librpc.ServiceKey, "wrong"))
+	unknownCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(log.Service, "unknown", librpc.ServiceKey, serviceKey))
+
+	quota := &app.Quota{Owner: "user:id", MaxBytes: 100, MaxFiles: 10, UsedBytes: 50, UsedFiles: 1}
+	want := &pb.GetQuotaResponse{Quota: &pb.Quota{Owner: "user:id", MaxBytes: 100, MaxFiles: 10, UsedBytes: 50, UsedFiles: 1}}
+
+	testCases := []struct {
+		name      string
+		ctx       context.Context
+		principal app.Principal
+		owner     string
+		appRes    *app.Quota
+		appErr    error
+		want      *pb.GetQuotaResponse
+		wantErr   error
+	}{
+		{"success", callerCtx, caller, "user:id", quota, nil, want, nil},
+		{"err_not_valid_quota", callerCtx, caller, "", nil, app.ErrNotValidQuota, nil, errNotValidQuota},
+		{"err_anonymous", context.Background(), app.Anonymous, "user:id", nil, app.ErrAccessDenied, nil, errAccessDenied},
+		{"err_wrong_key", wrongKeyCtx, app.Anonymous, "user:id", nil, app.ErrAccessDenied, nil, errAccessDenied},
+		{"err_unknown_service", unknownCtx, app.Anonymous, "user:id", nil, app.ErrAccessDenied, nil, errAccessDenied},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(tc.ctx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().GetQuota(gomock.Any(), tc.principal, app.Principal(tc.owner)).Return(tc.appRes, tc.appErr)
+
+			res, err := c.GetQuota(ctx, &pb.GetQuotaRequest{Owner: tc.owner})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
+
+func TestApi_SetQuota(t *testing.T) {
+	t.Parallel()
+
+	errNotValidQuota := status.Error(codes.InvalidArgument, app.ErrNotValidQuota.Error())
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	limit := app.Quota{Owner: "user:id", MaxBytes: 100, MaxFiles: 10}
+	quota := &app.Quota{Owner: "user:id", MaxBytes: 100, MaxFiles: 10, UsedBytes: 500, UsedFiles: 1}
+	want := &pb.SetQuotaResponse{Quota: &pb.Quota{Owner: "user:id", MaxBytes: 100, MaxFiles: 10, UsedBytes: 500, UsedFiles: 1}}
+
+	testCases := []struct {
+		name    string
+		quota   app.Quota
+		appRes  *app.Quota
+		appErr  error
+		want    *pb.SetQuotaResponse
+		wantErr error
+	}{
+		{"success", limit, quota, nil, want, nil},
+		{"err_not_valid_quota", app.Quota{Owner: "user:id", MaxBytes: -1}, nil, app.ErrNotValidQuota, nil, errNotValidQuota},
+		{"err_any", limit, nil, errAny, nil, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().SetQuota(gomock.Any(), caller, tc.quota).Return(tc.appRes, tc.appErr)
+
+			res, err := c.SetQuota(ctx, &pb.SetQuotaRequest{
+				Owner:    string(tc.quota.Owner),
+				MaxBytes: tc.quota.MaxBytes,
+				MaxFiles: tc.quota.MaxFiles,
+			})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
+
+func TestApi_SignURL(t *testing.T) {
+	t.Parallel()
+
+	var (
+		expiresAt = time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+		u         = app.SignedURL{FileID: fileID, ExpiresAt: expiresAt}
+		bound     = app.SignedURL{FileID: fileID, ExpiresAt: expiresAt, Disposition: "attachment; filename=a b.png", IP: net.ParseIP("10.0.0.1")}
+		signed    = func(u app.SignedURL) *app.SignedURL {
+			u.Signature = "sig"
+			return &u
+		}
+	)
+
+	errNotValidExpiry := status.Error(codes.InvalidArgument, app.ErrNotValidExpiry.Error())
+	errAccessDenied := status.Error(codes.PermissionDenied, app.ErrAccessDenied.Error())
+	errNotValidIP := status.Error(codes.InvalidArgument, app.ErrNotValidIP.Error())
+
+	testCases := []struct {
+		name    string
+		ip      string
+		want    app.SignedURL
+		appRes  *app.SignedURL
+		appErr  error
+		wantURL string
+		wantErr error
+	}{
+		{"success", "", u, signed(u), nil,
+			fmt.Sprintf("%s?expires=%d&id=%s&sig=sig", downloadURL, expiresAt.Unix(), fileID), nil},
+		{"success_bound", "10.0.0.1", bound, signed(bound), nil,
+			fmt.Sprintf("%s?disposition=attachment%%3B+filename%%3Da+b.png&expires=%d&id=%s&sig=sig", downloadURL, expiresAt.Unix(), fileID), nil},
+		{"err_not_valid_expiry", "", u, nil, app.ErrNotValidExpiry, "", errNotValidExpiry},
+		{"err_access_denied", "", u, nil, app.ErrAccessDenied, "", errAccessDenied},
+		{"err_not_valid_ip", "ip", u, nil, nil, "", errNotValidIP},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			if tc.appRes != nil || tc.appErr != nil {
+				mockApp.EXPECT().SignURL(gomock.Any(), caller, tc.want).Return(tc.appRes, tc.appErr)
+			}
+
+			res, err := c.SignURL(ctx, &pb.SignURLRequest{
+				FileId:      &pb.UUID{Value: fileID.String()},
+				ExpiresAt:   timestamppb.New(expiresAt),
+				Disposition: tc.want.Disposition,
+				Ip:          tc.ip,
+			})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.wantURL, res.GetUrl())
+		})
+	}
+}
+
+func TestApi_Download(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	buf, err := os.ReadFile(testFile)
+	assert.NoError(err)
+
+	errNotFound := status.Error(codes.NotFound, app.ErrNotFound.Error())
+	errQuarantined := status.Error(codes.FailedPrecondition, app.ErrQuarantined.Error())
+	errDeadline := status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	errCanceled := status.Error(codes.Canceled, context.Canceled.Error())
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	testCases := []struct {
+		name    string
+		want    []byte
+		appErr  error
+		wantErr error
+	}{
+		{"success", buf, nil, nil},
+		{"err_not_found", nil, app.ErrNotFound, errNotFound},
+		{"err_quarantined", nil, app.ErrQuarantined, errQuarantined},
+		{"err_deadline", nil, context.DeadlineExceeded, errDeadline},
+		{"err_canceled", nil, context.Canceled, errCanceled},
+		{"err_any", nil, errAny, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			id := uuid.Must(uuid.NewV4())
+			var appFile *app.File
+			if tc.appErr == nil {
+				file, err := os.Open(testFile)
+				assert.NoError(err)
+
+				appFile = &app.File{
+					ReadSeekCloser: file,
+					ID:             id,
+					Size:           int64(len(buf)),
+				}
+			}
+
+			mockApp.EXPECT().GetFile(gomock.Any(), caller, id).Return(appFile, tc.appErr)
+
+			stream, err := c.Download(ctx, &pb.DownloadRequest{FileId: &pb.UUID{Value: id.String()}})
+			assert.NoError(err)
+
+			res := &bytes.Buffer{}
+			for {
+				msg, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					assert.ErrorIs(err, tc.wantErr)
+
+					return
+				}
+
+				assert.LessOrEqual(len(msg.Chunk.Content), app.MaxChunkSize)
+				res.Write(msg.Chunk.Content)
+			}
+
+			assert.Nil(tc.wantErr)
+			assert.Equal(tc.want, res.Bytes())
+		})
+	}
+}
+
+func TestApi_CreateUpload(t *testing.T) {
+	t.Parallel()
+
+	errTooLarge := status.Error(codes.OutOfRange, app.ErrTooLarge.Error())
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	sessionID := uuid.Must(uuid.NewV4())
+	policy := app.Policy{AllowedTypes: []string{"image/*"}, MaxSize: 30}
+	session := &app.UploadSession{ID: sessionID, Size: 30, Policy: policy}
+
+	testCases := []struct {
+		name    string
+		appRes  *app.UploadSession
+		appErr  error
+		want    *pb.CreateUploadResponse
+		wantErr error
+	}{
+		{"success", session, nil, &pb.CreateUploadResponse{Session: &pb.UploadSession{
+			Id:    &pb.UUID{Value: sessionID.String()},
+			Size:  30,
+			Parts: []*pb.Part{},
+		}}, nil},
+		{"err_too_large", nil, app.ErrTooLarge, nil, errTooLarge},
+		{"err_any", nil, errAny, nil, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().CreateUpload(gomock.Any(), caller, int64(30), policy).Return(tc.appRes, tc.appErr)
+
+			res, err := c.CreateUpload(ctx, &pb.CreateUploadRequest{
+				Size:   30,
+				Policy: &pb.UploadPolicy{AllowedTypes: policy.AllowedTypes, MaxSize: policy.MaxSize},
+			})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
+
+func TestApi_GetUpload(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := status.Error(codes.NotFound, app.ErrNotFound.Error())
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	sessionID := uuid.Must(uuid.NewV4())
+	session := &app.UploadSession{
+		ID:    sessionID,
+		Size:  30,
+		Parts: []app.Part{{Number: 1, Size: 10}, {Number: 3, Size: 10}},
+	}
+
+	testCases := []struct {
+		name    string
+		appRes  *app.UploadSession
+		appErr  error
+		want    *pb.GetUploadResponse
+		wantErr error
+	}{
+		{"success", session, nil, &pb.GetUploadResponse{Session: &pb.UploadSession{
+			Id:     &pb.UUID{Value: sessionID.String()},
+			Size:   30,
+			Offset: 10,
+			Parts:  []*pb.Part{{Number: 1, Size: 10}, {Number: 3, Size: 10}},
+		}}, nil},
+		{"err_not_found", nil, app.ErrNotFound, nil, errNotFound},
+		{"err_any", nil, errAny, nil, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().GetUpload(gomock.Any(), caller, sessionID).Return(tc.appRes, tc.appErr)
+
+			res, err := c.GetUpload(ctx, &pb.GetUploadRequest{
+				SessionId: &pb.UUID{Value: sessionID.String()},
+			})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
+
+func TestApi_UploadPart(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	buf, err := os.ReadFile(testFile)
+	assert.NoError(err)
+
+	errNotFound := status.Error(codes.NotFound, app.ErrNotFound.Error())
+	errTooLarge := status.Error(codes.InvalidArgument, app.ErrPartTooLarge.Error())
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	sessionID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name    string
+		appRes  *app.Part
+		appErr  error
+		want    *pb.UploadPartResponse
+		wantErr error
+	}{
+		{"success", &app.Part{Number: 2, Size: int64(len(buf))}, nil, &pb.UploadPartResponse{Part: &pb.Part{Number: 2, Size: int64(len(buf))}}, nil},
+		{"err_not_found", nil, app.ErrNotFound, nil, errNotFound},
+		{"err_too_large", nil, app.ErrPartTooLarge, nil, errTooLarge},
+		{"err_any", nil, errAny, nil, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().
+				UploadPart(gomock.Any(), caller, sessionID, 2, fileMatcher{buf, assert}).
+				Return(tc.appRes, tc.appErr)
+
+			stream, err := c.UploadPart(ctx)
+			assert.NoError(err)
+
+			err = stream.Send(&pb.UploadPartRequest{
+				SessionId: &pb.UUID{Value: sessionID.String()},
+				Number:    2,
+			})
+			assert.NoError(err)
+
+			for i := 0; i < len(buf); i += app.MaxChunkSize {
+				end := i + app.MaxChunkSize
+				if end > len(buf) {
+					end = len(buf)
+				}
+
+				err = stream.Send(&pb.UploadPartRequest{Chunk: &pb.Chunk{Content: buf[i:end]}})
+				assert.NoError(err)
+			}
+
+			res, err := stream.CloseAndRecv()
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
+
+func TestApi_CompleteUpload(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := status.Error(codes.NotFound, app.ErrNotFound.Error())
+	errIncomplete := status.Error(codes.FailedPrecondition, app.ErrUploadIncomplete.Error())
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	sessionID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name    string
+		appRes  *app.File
+		appErr  error
+		want    *pb.CompleteUploadResponse
+		wantErr error
+	}{
+		{"success", &app.File{ID: fileID, Size: 100, Digest: digest}, nil, &pb.CompleteUploadResponse{
+			FileId: &pb.UUID{Value: fileID.String()},
+			Digest: digest,
+			Size:   100,
+		}, nil},
+		{"err_not_found", nil, app.ErrNotFound, nil, errNotFound},
+		{"err_incomplete", nil, app.ErrUploadIncomplete, nil, errIncomplete},
+		{"err_any", nil, errAny, nil, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().CompleteUpload(gomock.Any(), caller, sessionID, private).Return(tc.appRes, tc.appErr)
+
+			res, err := c.CompleteUpload(ctx, &pb.CompleteUploadRequest{
+				SessionId: &pb.UUID{Value: sessionID.String()},
+			})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
+
+func TestApi_List(t *testing.T) {
+	t.Parallel()
+
+	errNotValidCursor := status.Error(codes.InvalidArgument, app.ErrNotValidCursor.Error())
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	createdAt := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	file := app.File{
+		ID:          fileID,
+		Size:        100,
+		Digest:      digest,
+		ContentType: "image/png",
+		Owner:       caller,
+		Access:      app.Access{Visibility: app.VisibilityPublic},
+		Metadata:    json.RawMessage(`{"key":"value"}`),
+		Scan:        app.Scan{Status: app.ScanInfected, Threat: "Eicar-Signature"},
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+
+	md, err := structpb.NewStruct(map[string]interface{}{"key": "value"})
+	require.NoError(t, err)
+
+	request := &pb.ListRequest{
+		Owner:        string(app.AnyOwner),
+		Cursor:       "cursor",
+		Limit:        10,
+		MinSize:      1,
+		CreatedAfter: timestamppb.New(createdAt),
+		ContentType:  "image/*",
+		Metadata:     md,
+		SortBy:       pb.SortField_SORT_FIELD_SIZE,
+		Desc:         true,
+	}
+	params := app.ListParams{
+		Owner:        app.AnyOwner,
+		Cursor:       "cursor",
+		Limit:        10,
+		MinSize:      1,
+		CreatedAfter: createdAt,
+		ContentType:  "image/*",
+		Metadata:     json.RawMessage(`{"key":"value"}`),
+		SortBy:       app.SortBySize,
+		Desc:         true,
+	}
+
+	testCases := []struct {
+		name    string
+		appRes  []app.File
+		appNext string
+		appErr  error
+		want    *pb.ListResponse
+		wantErr error
+	}{
+		{"success", []app.File{file}, "next", nil, &pb.ListResponse{
+			Files: []*pb.FileInfo{{
+				Id:          &pb.UUID{Value: fileID.String()},
+				Size:        100,
+				Digest:      digest,
+				ContentType: "image/png",
+				Metadata:    md,
+				CreatedAt:   timestamppb.New(createdAt),
+				UpdatedAt:   timestamppb.New(createdAt),
+				Owner:       string(caller),
+				Access:      &pb.Access{Visibility: pb.Visibility_VISIBILITY_PUBLIC},
+				ScanStatus:  string(app.ScanInfected),
+				ScanThreat:  "Eicar-Signature",
+			}},
+			NextCursor: "next",
+		}, nil},
+		{"err_not_valid_cursor", nil, "", app.ErrNotValidCursor, nil, errNotValidCursor},
+		{"err_any", nil, "", errAny, nil, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(callerCtx, time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t)
+
+			mockApp.EXPECT().List(gomock.Any(), caller, params).Return(tc.appRes, tc.appNext, tc.appErr)
+
+			res, err := c.List(ctx, request)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
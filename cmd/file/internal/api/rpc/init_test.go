@@ -13,18 +13,30 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/rpc"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/libs/log"
 	"github.com/Meat-Hook/back-template/libs/metrics"
 	librpc "github.com/Meat-Hook/back-template/libs/rpc"
 	pb "github.com/Meat-Hook/back-template/proto/gen/go/file/v1"
 )
 
-const testFile = `test.jpg`
+const (
+	testFile    = `test.jpg`
+	digest      = `9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08`
+	downloadURL = `http://localhost/file/api/v1/file`
+	serviceKey  = `test-key`
+)
 
 var (
 	reg    = prometheus.NewPedanticRegistry()
 	fileID = uuid.Must(uuid.NewV4())
+
+	caller    = app.ServicePrincipal("test")
+	callerCtx = metadata.NewOutgoingContext(context.Background(), metadata.Pairs(log.Service, "test", librpc.ServiceKey, serviceKey))
+	private   = app.Access{Visibility: app.VisibilityPrivate}
 )
 
 func TestMain(m *testing.M) {
@@ -41,7 +53,10 @@ func start(t *testing.T) (pb.ServiceClient, *Mockfiles, *require.Assertions) {
 	mockApp := NewMockfiles(ctrl)
 	logger := zerolog.New(os.Stdout)
 
-	server := rpc.New(logger.WithContext(context.Background()), mockApp, librpc.NewServerMetrics(reg, strings.Replace(t.Name(), "/", "_", -1)))
+	server := rpc.New(logger.WithContext(context.Background()), mockApp, librpc.NewServerMetrics(reg, strings.Replace(t.Name(), "/", "_", -1)), rpc.Config{
+		DownloadURL: downloadURL,
+		ServiceKeys: map[string]string{"test": serviceKey},
+	})
 
 	ln, err := net.Listen("tcp", "127.0.0.1:0")
 	assert.NoError(err)
@@ -10,6 +10,7 @@ import (
 	io "io"
 	reflect "reflect"
 
+	app "github.com/Meat-Hook/back-template/cmd/file/internal/app"
 	uuid "github.com/gofrs/uuid"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -37,45 +38,237 @@ func (m *Mockfiles) EXPECT() *MockfilesMockRecorder {
 	return m.recorder
 }
 
+// AbortUpload mocks base method.
+func (m *Mockfiles) AbortUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortUpload", ctx, principal, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortUpload indicates an expected call of AbortUpload.
+func (mr *MockfilesMockRecorder) AbortUpload(ctx, principal, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortUpload", reflect.TypeOf((*Mockfiles)(nil).AbortUpload), ctx, principal, sessionID)
+}
+
+// ClaimFile mocks base method.
+func (m *Mockfiles) ClaimFile(ctx context.Context, principal app.Principal, fileID uuid.UUID, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimFile", ctx, principal, fileID, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimFile indicates an expected call of ClaimFile.
+func (mr *MockfilesMockRecorder) ClaimFile(ctx, principal, fileID, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimFile", reflect.TypeOf((*Mockfiles)(nil).ClaimFile), ctx, principal, fileID, ref)
+}
+
+// CompleteUpload mocks base method.
+func (m *Mockfiles) CompleteUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID, access app.Access) (*app.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", ctx, principal, sessionID, access)
+	ret0, _ := ret[0].(*app.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockfilesMockRecorder) CompleteUpload(ctx, principal, sessionID, access interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*Mockfiles)(nil).CompleteUpload), ctx, principal, sessionID, access)
+}
+
+// CreateUpload mocks base method.
+func (m *Mockfiles) CreateUpload(ctx context.Context, owner app.Principal, size int64, policy app.Policy) (*app.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpload", ctx, owner, size, policy)
+	ret0, _ := ret[0].(*app.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUpload indicates an expected call of CreateUpload.
+func (mr *MockfilesMockRecorder) CreateUpload(ctx, owner, size, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpload", reflect.TypeOf((*Mockfiles)(nil).CreateUpload), ctx, owner, size, policy)
+}
+
 // Delete mocks base method.
-func (m *Mockfiles) Delete(ctx context.Context, fileID uuid.UUID) error {
+func (m *Mockfiles) Delete(ctx context.Context, principal app.Principal, fileID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, fileID)
+	ret := m.ctrl.Call(m, "Delete", ctx, principal, fileID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockfilesMockRecorder) Delete(ctx, fileID interface{}) *gomock.Call {
+func (mr *MockfilesMockRecorder) Delete(ctx, principal, fileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Mockfiles)(nil).Delete), ctx, principal, fileID)
+}
+
+// GetFile mocks base method.
+func (m *Mockfiles) GetFile(ctx context.Context, principal app.Principal, fileID uuid.UUID) (*app.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFile", ctx, principal, fileID)
+	ret0, _ := ret[0].(*app.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFile indicates an expected call of GetFile.
+func (mr *MockfilesMockRecorder) GetFile(ctx, principal, fileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFile", reflect.TypeOf((*Mockfiles)(nil).GetFile), ctx, principal, fileID)
+}
+
+// GetQuota mocks base method.
+func (m *Mockfiles) GetQuota(ctx context.Context, principal, owner app.Principal) (*app.Quota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuota", ctx, principal, owner)
+	ret0, _ := ret[0].(*app.Quota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuota indicates an expected call of GetQuota.
+func (mr *MockfilesMockRecorder) GetQuota(ctx, principal, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuota", reflect.TypeOf((*Mockfiles)(nil).GetQuota), ctx, principal, owner)
+}
+
+// GetUpload mocks base method.
+func (m *Mockfiles) GetUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID) (*app.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpload", ctx, principal, sessionID)
+	ret0, _ := ret[0].(*app.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpload indicates an expected call of GetUpload.
+func (mr *MockfilesMockRecorder) GetUpload(ctx, principal, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpload", reflect.TypeOf((*Mockfiles)(nil).GetUpload), ctx, principal, sessionID)
+}
+
+// List mocks base method.
+func (m *Mockfiles) List(ctx context.Context, principal app.Principal, params app.ListParams) ([]app.File, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, principal, params)
+	ret0, _ := ret[0].([]app.File)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockfilesMockRecorder) List(ctx, principal, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*Mockfiles)(nil).List), ctx, principal, params)
+}
+
+// ReleaseFile mocks base method.
+func (m *Mockfiles) ReleaseFile(ctx context.Context, principal app.Principal, fileID uuid.UUID, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseFile", ctx, principal, fileID, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseFile indicates an expected call of ReleaseFile.
+func (mr *MockfilesMockRecorder) ReleaseFile(ctx, principal, fileID, ref interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Mockfiles)(nil).Delete), ctx, fileID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseFile", reflect.TypeOf((*Mockfiles)(nil).ReleaseFile), ctx, principal, fileID, ref)
+}
+
+// SetAccess mocks base method.
+func (m *Mockfiles) SetAccess(ctx context.Context, principal app.Principal, fileID uuid.UUID, access app.Access) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccess", ctx, principal, fileID, access)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccess indicates an expected call of SetAccess.
+func (mr *MockfilesMockRecorder) SetAccess(ctx, principal, fileID, access interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccess", reflect.TypeOf((*Mockfiles)(nil).SetAccess), ctx, principal, fileID, access)
 }
 
 // SetMetadata mocks base method.
-func (m *Mockfiles) SetMetadata(ctx context.Context, fileID uuid.UUID, metadata json.RawMessage) error {
+func (m *Mockfiles) SetMetadata(ctx context.Context, principal app.Principal, fileID uuid.UUID, metadata json.RawMessage) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetMetadata", ctx, fileID, metadata)
+	ret := m.ctrl.Call(m, "SetMetadata", ctx, principal, fileID, metadata)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetMetadata indicates an expected call of SetMetadata.
-func (mr *MockfilesMockRecorder) SetMetadata(ctx, fileID, metadata interface{}) *gomock.Call {
+func (mr *MockfilesMockRecorder) SetMetadata(ctx, principal, fileID, metadata interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMetadata", reflect.TypeOf((*Mockfiles)(nil).SetMetadata), ctx, fileID, metadata)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMetadata", reflect.TypeOf((*Mockfiles)(nil).SetMetadata), ctx, principal, fileID, metadata)
+}
+
+// SetQuota mocks base method.
+func (m *Mockfiles) SetQuota(ctx context.Context, principal app.Principal, quota app.Quota) (*app.Quota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuota", ctx, principal, quota)
+	ret0, _ := ret[0].(*app.Quota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuota indicates an expected call of SetQuota.
+func (mr *MockfilesMockRecorder) SetQuota(ctx, principal, quota interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuota", reflect.TypeOf((*Mockfiles)(nil).SetQuota), ctx, principal, quota)
+}
+
+// SignURL mocks base method.
+func (m *Mockfiles) SignURL(ctx context.Context, principal app.Principal, u app.SignedURL) (*app.SignedURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignURL", ctx, principal, u)
+	ret0, _ := ret[0].(*app.SignedURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignURL indicates an expected call of SignURL.
+func (mr *MockfilesMockRecorder) SignURL(ctx, principal, u interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignURL", reflect.TypeOf((*Mockfiles)(nil).SignURL), ctx, principal, u)
 }
 
 // UploadFile mocks base method.
-func (m *Mockfiles) UploadFile(ctx context.Context, file io.Reader) (uuid.UUID, error) {
+func (m *Mockfiles) UploadFile(ctx context.Context, owner app.Principal, file io.Reader, policy app.Policy, access app.Access) (*app.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadFile", ctx, file)
-	ret0, _ := ret[0].(uuid.UUID)
+	ret := m.ctrl.Call(m, "UploadFile", ctx, owner, file, policy, access)
+	ret0, _ := ret[0].(*app.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UploadFile indicates an expected call of UploadFile.
-func (mr *MockfilesMockRecorder) UploadFile(ctx, file interface{}) *gomock.Call {
+func (mr *MockfilesMockRecorder) UploadFile(ctx, owner, file, policy, access interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*Mockfiles)(nil).UploadFile), ctx, owner, file, policy, access)
+}
+
+// UploadPart mocks base method.
+func (m *Mockfiles) UploadPart(ctx context.Context, principal app.Principal, sessionID uuid.UUID, number int, part io.Reader) (*app.Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPart", ctx, principal, sessionID, number, part)
+	ret0, _ := ret[0].(*app.Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadPart indicates an expected call of UploadPart.
+func (mr *MockfilesMockRecorder) UploadPart(ctx, principal, sessionID, number, part interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*Mockfiles)(nil).UploadFile), ctx, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPart", reflect.TypeOf((*Mockfiles)(nil).UploadPart), ctx, principal, sessionID, number, part)
 }
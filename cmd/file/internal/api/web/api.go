@@ -4,12 +4,16 @@ package web
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"path"
+	"strings"
 
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 	swag_middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/security"
 	"github.com/gofrs/uuid"
 	"github.com/rs/zerolog"
 	"github.com/sebest/xff"
@@ -27,7 +31,17 @@ type (
 	// For easy testing.
 	// Wrapper for app.Module.
 	application interface {
-		GetFile(ctx context.Context, fileID uuid.UUID) (*app.File, error)
+		GetFile(ctx context.Context, principal app.Principal, fileID uuid.UUID) (*app.File, error)
+		GetVariant(ctx context.Context, principal app.Principal, fileID uuid.UUID, width int) (*app.File, error)
+		GetSignedFile(ctx context.Context, u app.SignedURL, ip net.IP) (*app.File, error)
+		SetAccess(ctx context.Context, principal app.Principal, fileID uuid.UUID, access app.Access) error
+		List(ctx context.Context, principal app.Principal, params app.ListParams) ([]app.File, string, error)
+		CreateUpload(ctx context.Context, owner app.Principal, size int64, policy app.Policy) (*app.UploadSession, error)
+		GetUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID) (*app.UploadSession, error)
+		UploadPart(ctx context.Context, principal app.Principal, sessionID uuid.UUID, number int, part io.Reader) (*app.Part, error)
+		CompleteUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID, access app.Access) (*app.File, error)
+		AbortUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID) error
+		Auth(ctx context.Context, token string, origin app.Origin) (*app.Session, error)
 	}
 
 	service struct {
@@ -55,8 +69,21 @@ func New(ctx context.Context, module application, m *web.Metric, cfg Config) (*r
 	api := operations.NewFileServiceAPI(swaggerSpec)
 	swaggerLogger := logger.With().Str(log.Subsystem, "swagger").Logger()
 	api.Logger = swaggerLogger.Printf
+	api.APIKeyAuthenticator = svc.authorizerFunc
+	// Because it doesn't have context.
+	// See previews code line.
+	api.CookieKeyAuth = func(string) (*app.Session, error) {
+		return nil, nil
+	}
 
 	api.GetFileHandler = operations.GetFileHandlerFunc(svc.getFile)
+	api.SetFileAccessHandler = operations.SetFileAccessHandlerFunc(svc.setFileAccess)
+	api.ListFilesHandler = operations.ListFilesHandlerFunc(svc.listFiles)
+	api.CreateUploadHandler = operations.CreateUploadHandlerFunc(svc.createUpload)
+	api.GetUploadHandler = operations.GetUploadHandlerFunc(svc.getUpload)
+	api.UploadPartHandler = operations.UploadPartHandlerFunc(svc.uploadPart)
+	api.CompleteUploadHandler = operations.CompleteUploadHandlerFunc(svc.completeUpload)
+	api.AbortUploadHandler = operations.AbortUploadHandlerFunc(svc.abortUpload)
 
 	server := restapi.NewServer(api)
 	server.Host = cfg.Host
@@ -85,11 +112,57 @@ func New(ctx context.Context, module application, m *web.Metric, cfg Config) (*r
 	return server, nil
 }
 
-func fromRequest(r *http.Request) (context.Context, zerolog.Logger, net.IP) {
+func fromRequest(r *http.Request, session *app.Session) (context.Context, zerolog.Logger, app.Principal) {
 	ctx := r.Context()
+	userID := uuid.Nil
+	principal := app.Anonymous
+	if session != nil {
+		userID = session.UserID
+		principal = app.UserPrincipal(session.UserID)
+	}
+
+	logger := zerolog.Ctx(r.Context()).With().Stringer(log.User, userID).Logger()
+
+	return ctx, logger, principal
+}
+
+func remoteIP(r *http.Request) net.IP {
+	ip, _, _ := net.SplitHostPort(r.RemoteAddr)
+
+	return net.ParseIP(ip)
+}
+
+func (svc *service) authorizerFunc(name, in string, _ security.TokenAuthentication) runtime.Authenticator {
+	const (
+		query  = "query"
+		header = "header"
+	)
+
+	inl := strings.ToLower(in)
+	if inl != query && inl != header {
+		// panic because this is most likely a typo
+		panic(`api key auth: in value needs to be either "query" or "header"`)
+	}
+
+	var getToken func(*http.Request) string
+	switch inl {
+	case header:
+		getToken = func(r *http.Request) string { return r.Header.Get(name) }
+	case query:
+		getToken = func(r *http.Request) string { return r.URL.Query().Get(name) }
+	}
+
+	return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
+		token := getToken(r)
+		if token == "" {
+			return false, nil, nil
+		}
 
-	logger := zerolog.Ctx(r.Context())
-	remoteIP, _, _ := net.SplitHostPort(r.RemoteAddr)
+		p, err := svc.cookieKeyAuth(r.Context(), token, app.Origin{
+			IP:        remoteIP(r),
+			UserAgent: r.Header.Get("User-Agent"),
+		})
 
-	return ctx, *logger, net.ParseIP(remoteIP)
+		return true, p, err
+	})
 }
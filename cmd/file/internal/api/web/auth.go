@@ -0,0 +1,40 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	unautnError "github.com/go-openapi/errors"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+const (
+	cookieTokenName = "authKey"
+)
+
+func (svc *service) cookieKeyAuth(ctx context.Context, raw string, origin app.Origin) (*app.Session, error) {
+	session, err := svc.app.Auth(ctx, parseToken(raw), origin)
+	switch {
+	case errors.Is(err, app.ErrNotFound):
+		return nil, unautnError.Unauthenticated("file")
+	case err != nil:
+		return nil, fmt.Errorf("auth: %w", err)
+	default:
+		return session, nil
+	}
+}
+
+func parseToken(raw string) string {
+	header := http.Header{}
+	header.Add("Cookie", raw)
+	request := http.Request{Header: header}
+	cookieKey, err := request.Cookie(cookieTokenName)
+	if err != nil {
+		return ""
+	}
+
+	return cookieKey.Value
+}
@@ -0,0 +1,116 @@
+package web
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/gofrs/uuid"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// UploadSession conversion app.UploadSession => models.UploadSession.
+func UploadSession(s *app.UploadSession) *models.UploadSession {
+	id := strfmt.UUID(s.ID.String())
+
+	parts := make([]*models.Part, len(s.Parts))
+	for i := range s.Parts {
+		parts[i] = Part(s.Parts[i])
+	}
+
+	return &models.UploadSession{
+		ID:     &id,
+		Size:   swag.Int64(s.Size),
+		Offset: swag.Int64(s.Offset()),
+		Parts:  parts,
+	}
+}
+
+// Part conversion app.Part => models.Part.
+func Part(p app.Part) *models.Part {
+	return &models.Part{
+		Number: swag.Int32(int32(p.Number)),
+		Size:   swag.Int64(p.Size),
+	}
+}
+
+// UploadedFile conversion app.File => models.UploadedFile.
+func UploadedFile(f *app.File) *models.UploadedFile {
+	id := strfmt.UUID(f.ID.String())
+
+	return &models.UploadedFile{
+		ID:          &id,
+		Size:        swag.Int64(f.Size),
+		Digest:      swag.String(f.Digest),
+		ContentType: f.ContentType,
+		ScanStatus:  models.ScanStatus(f.Scan.Status),
+	}
+}
+
+// FileInfo conversion app.File => models.FileInfo.
+func FileInfo(f app.File) *models.FileInfo {
+	id := strfmt.UUID(f.ID.String())
+
+	res := &models.FileInfo{
+		ID:          &id,
+		Size:        swag.Int64(f.Size),
+		Digest:      swag.String(f.Digest),
+		ContentType: swag.String(f.ContentType),
+		CreatedAt:   (*strfmt.DateTime)(&f.CreatedAt),
+		UpdatedAt:   (*strfmt.DateTime)(&f.UpdatedAt),
+		Owner:       string(f.Owner),
+		Access:      Access(f.Access),
+		ScanStatus:  models.ScanStatus(f.Scan.Status),
+		ScanThreat:  f.Scan.Threat,
+	}
+
+	if len(f.Metadata) > 0 {
+		res.Metadata = f.Metadata
+	}
+
+	return res
+}
+
+func appAccess(a *models.Access) app.Access {
+	res := app.Access{}
+	if a == nil {
+		return res
+	}
+
+	if a.Visibility != nil {
+		res.Visibility = app.Visibility(*a.Visibility)
+	}
+
+	for _, id := range a.SharedWith {
+		res.SharedWith = append(res.SharedWith, app.UserPrincipal(uuid.FromStringOrNil(id.String())))
+	}
+
+	return res
+}
+
+// Access conversion app.Access => models.Access.
+func Access(a app.Access) *models.Access {
+	visibility := models.Visibility(a.Visibility)
+
+	sharedWith := make([]strfmt.UUID, 0, len(a.SharedWith))
+	for _, p := range a.SharedWith {
+		if userID := p.UserID(); userID != uuid.Nil {
+			sharedWith = append(sharedWith, strfmt.UUID(userID.String()))
+		}
+	}
+
+	return &models.Access{
+		Visibility: &visibility,
+		SharedWith: sharedWith,
+	}
+}
+
+// Files conversion []app.File => []*models.FileInfo.
+func Files(files []app.File) []*models.FileInfo {
+	res := make([]*models.FileInfo, len(files))
+	for i := range files {
+		res[i] = FileInfo(files[i])
+	}
+
+	return res
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAbortUploadParams creates a new AbortUploadParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAbortUploadParams() *AbortUploadParams {
+	return &AbortUploadParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAbortUploadParamsWithTimeout creates a new AbortUploadParams object
+// with the ability to set a timeout on a request.
+func NewAbortUploadParamsWithTimeout(timeout time.Duration) *AbortUploadParams {
+	return &AbortUploadParams{
+		timeout: timeout,
+	}
+}
+
+// NewAbortUploadParamsWithContext creates a new AbortUploadParams object
+// with the ability to set a context for a request.
+func NewAbortUploadParamsWithContext(ctx context.Context) *AbortUploadParams {
+	return &AbortUploadParams{
+		Context: ctx,
+	}
+}
+
+// NewAbortUploadParamsWithHTTPClient creates a new AbortUploadParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAbortUploadParamsWithHTTPClient(client *http.Client) *AbortUploadParams {
+	return &AbortUploadParams{
+		HTTPClient: client,
+	}
+}
+
+/* AbortUploadParams contains all the parameters to send to the API endpoint
+   for the abort upload operation.
+
+   Typically these are written to a http.Request.
+*/
+type AbortUploadParams struct {
+
+	// ID.
+	//
+	// Format: uuid
+	ID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the abort upload params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AbortUploadParams) WithDefaults() *AbortUploadParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the abort upload params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AbortUploadParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the abort upload params
+func (o *AbortUploadParams) WithTimeout(timeout time.Duration) *AbortUploadParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the abort upload params
+func (o *AbortUploadParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the abort upload params
+func (o *AbortUploadParams) WithContext(ctx context.Context) *AbortUploadParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the abort upload params
+func (o *AbortUploadParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the abort upload params
+func (o *AbortUploadParams) WithHTTPClient(client *http.Client) *AbortUploadParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the abort upload params
+func (o *AbortUploadParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the abort upload params
+func (o *AbortUploadParams) WithID(id strfmt.UUID) *AbortUploadParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the abort upload params
+func (o *AbortUploadParams) SetID(id strfmt.UUID) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AbortUploadParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// AbortUploadReader is a Reader for the AbortUpload structure.
+type AbortUploadReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AbortUploadReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewAbortUploadNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewAbortUploadDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAbortUploadNoContent creates a AbortUploadNoContent with default headers values
+func NewAbortUploadNoContent() *AbortUploadNoContent {
+	return &AbortUploadNoContent{}
+}
+
+/* AbortUploadNoContent describes a response with status code 204, with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type AbortUploadNoContent struct {
+}
+
+func (o *AbortUploadNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /uploads/{id}][%d] abortUploadNoContent ", 204)
+}
+
+func (o *AbortUploadNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAbortUploadDefault creates a AbortUploadDefault with default headers values
+func NewAbortUploadDefault(code int) *AbortUploadDefault {
+	return &AbortUploadDefault{
+		_statusCode: code,
+	}
+}
+
+/* AbortUploadDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type AbortUploadDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the abort upload default response
+func (o *AbortUploadDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AbortUploadDefault) Error() string {
+	return fmt.Sprintf("[DELETE /uploads/{id}][%d] abortUpload default  %+v", o._statusCode, o.Payload)
+}
+func (o *AbortUploadDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AbortUploadDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCompleteUploadParams creates a new CompleteUploadParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCompleteUploadParams() *CompleteUploadParams {
+	return &CompleteUploadParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCompleteUploadParamsWithTimeout creates a new CompleteUploadParams object
+// with the ability to set a timeout on a request.
+func NewCompleteUploadParamsWithTimeout(timeout time.Duration) *CompleteUploadParams {
+	return &CompleteUploadParams{
+		timeout: timeout,
+	}
+}
+
+// NewCompleteUploadParamsWithContext creates a new CompleteUploadParams object
+// with the ability to set a context for a request.
+func NewCompleteUploadParamsWithContext(ctx context.Context) *CompleteUploadParams {
+	return &CompleteUploadParams{
+		Context: ctx,
+	}
+}
+
+// NewCompleteUploadParamsWithHTTPClient creates a new CompleteUploadParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCompleteUploadParamsWithHTTPClient(client *http.Client) *CompleteUploadParams {
+	return &CompleteUploadParams{
+		HTTPClient: client,
+	}
+}
+
+/* CompleteUploadParams contains all the parameters to send to the API endpoint
+   for the complete upload operation.
+
+   Typically these are written to a http.Request.
+*/
+type CompleteUploadParams struct {
+
+	// ID.
+	//
+	// Format: uuid
+	ID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the complete upload params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CompleteUploadParams) WithDefaults() *CompleteUploadParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the complete upload params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CompleteUploadParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the complete upload params
+func (o *CompleteUploadParams) WithTimeout(timeout time.Duration) *CompleteUploadParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the complete upload params
+func (o *CompleteUploadParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the complete upload params
+func (o *CompleteUploadParams) WithContext(ctx context.Context) *CompleteUploadParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the complete upload params
+func (o *CompleteUploadParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the complete upload params
+func (o *CompleteUploadParams) WithHTTPClient(client *http.Client) *CompleteUploadParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the complete upload params
+func (o *CompleteUploadParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the complete upload params
+func (o *CompleteUploadParams) WithID(id strfmt.UUID) *CompleteUploadParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the complete upload params
+func (o *CompleteUploadParams) SetID(id strfmt.UUID) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CompleteUploadParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
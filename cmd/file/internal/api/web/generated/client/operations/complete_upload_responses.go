@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// CompleteUploadReader is a Reader for the CompleteUpload structure.
+type CompleteUploadReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CompleteUploadReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCompleteUploadOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCompleteUploadDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCompleteUploadOK creates a CompleteUploadOK with default headers values
+func NewCompleteUploadOK() *CompleteUploadOK {
+	return &CompleteUploadOK{}
+}
+
+/* CompleteUploadOK describes a response with status code 200, with default header values.
+
+Uploaded file.
+*/
+type CompleteUploadOK struct {
+	Payload *models.UploadedFile
+}
+
+func (o *CompleteUploadOK) Error() string {
+	return fmt.Sprintf("[POST /uploads/{id}/complete][%d] completeUploadOK  %+v", 200, o.Payload)
+}
+func (o *CompleteUploadOK) GetPayload() *models.UploadedFile {
+	return o.Payload
+}
+
+func (o *CompleteUploadOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.UploadedFile)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCompleteUploadDefault creates a CompleteUploadDefault with default headers values
+func NewCompleteUploadDefault(code int) *CompleteUploadDefault {
+	return &CompleteUploadDefault{
+		_statusCode: code,
+	}
+}
+
+/* CompleteUploadDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type CompleteUploadDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the complete upload default response
+func (o *CompleteUploadDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CompleteUploadDefault) Error() string {
+	return fmt.Sprintf("[POST /uploads/{id}/complete][%d] completeUpload default  %+v", o._statusCode, o.Payload)
+}
+func (o *CompleteUploadDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CompleteUploadDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// CreateUploadReader is a Reader for the CreateUpload structure.
+type CreateUploadReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateUploadReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateUploadCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCreateUploadDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateUploadCreated creates a CreateUploadCreated with default headers values
+func NewCreateUploadCreated() *CreateUploadCreated {
+	return &CreateUploadCreated{}
+}
+
+/* CreateUploadCreated describes a response with status code 201, with default header values.
+
+Created upload session.
+*/
+type CreateUploadCreated struct {
+	Location     string
+	UploadOffset int64
+
+	Payload *models.UploadSession
+}
+
+func (o *CreateUploadCreated) Error() string {
+	return fmt.Sprintf("[POST /uploads][%d] createUploadCreated  %+v", 201, o.Payload)
+}
+func (o *CreateUploadCreated) GetPayload() *models.UploadSession {
+	return o.Payload
+}
+
+func (o *CreateUploadCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Location
+	hdrLocation := response.GetHeader("Location")
+
+	if hdrLocation != "" {
+		o.Location = hdrLocation
+	}
+
+	// hydrates response header Upload-Offset
+	hdrUploadOffset := response.GetHeader("Upload-Offset")
+
+	if hdrUploadOffset != "" {
+		valuploadOffset, err := swag.ConvertInt64(hdrUploadOffset)
+		if err != nil {
+			return errors.InvalidType("Upload-Offset", "header", "int64", hdrUploadOffset)
+		}
+		o.UploadOffset = valuploadOffset
+	}
+
+	o.Payload = new(models.UploadSession)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUploadDefault creates a CreateUploadDefault with default headers values
+func NewCreateUploadDefault(code int) *CreateUploadDefault {
+	return &CreateUploadDefault{
+		_statusCode: code,
+	}
+}
+
+/* CreateUploadDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type CreateUploadDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the create upload default response
+func (o *CreateUploadDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateUploadDefault) Error() string {
+	return fmt.Sprintf("[POST /uploads][%d] createUpload default  %+v", o._statusCode, o.Payload)
+}
+func (o *CreateUploadDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateUploadDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*CreateUploadBody create upload body
+swagger:model CreateUploadBody
+*/
+type CreateUploadBody struct {
+
+	// Declared size of whole file, 0 if unknown.
+	// Minimum: 0
+	Size *int64 `json:"size,omitempty"`
+}
+
+// Validate validates this create upload body
+func (o *CreateUploadBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateUploadBody) validateSize(formats strfmt.Registry) error {
+	if swag.IsZero(o.Size) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("args"+"."+"size", "body", *o.Size, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this create upload body based on context it is used
+func (o *CreateUploadBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateUploadBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateUploadBody) UnmarshalBinary(b []byte) error {
+	var res CreateUploadBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetFileParams creates a new GetFileParams object,
@@ -59,11 +60,57 @@ func NewGetFileParamsWithHTTPClient(client *http.Client) *GetFileParams {
 */
 type GetFileParams struct {
 
+	// IfModifiedSince.
+	IfModifiedSince *string
+
+	// IfNoneMatch.
+	IfNoneMatch *string
+
+	/* IfRange.
+
+	   ETag or Last-Modified value, Range is ignored when the file was changed.
+	*/
+	IfRange *string
+
+	/* Range.
+
+	   Byte ranges of file, single or multiple (RFC 7233).
+	*/
+	Range *string
+
+	/* Disposition.
+
+	   Content-Disposition header value of signed URL.
+	*/
+	Disposition *string
+
+	/* Expires.
+
+	   Expiry of signed URL, Unix time in seconds.
+
+	   Format: int64
+	*/
+	Expires *int64
+
 	// ID.
 	//
 	// Format: uuid
 	ID strfmt.UUID
 
+	/* Sig.
+
+	   Signature of signed URL, session and w are ignored if set.
+	*/
+	Sig *string
+
+	/* W.
+
+	   Width of resized image variant, made on first request. Images are never upscaled.
+
+	   Format: int32
+	*/
+	W *int32
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -117,6 +164,72 @@ func (o *GetFileParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfModifiedSince adds the ifModifiedSince to the get file params
+func (o *GetFileParams) WithIfModifiedSince(ifModifiedSince *string) *GetFileParams {
+	o.SetIfModifiedSince(ifModifiedSince)
+	return o
+}
+
+// SetIfModifiedSince adds the ifModifiedSince to the get file params
+func (o *GetFileParams) SetIfModifiedSince(ifModifiedSince *string) {
+	o.IfModifiedSince = ifModifiedSince
+}
+
+// WithIfNoneMatch adds the ifNoneMatch to the get file params
+func (o *GetFileParams) WithIfNoneMatch(ifNoneMatch *string) *GetFileParams {
+	o.SetIfNoneMatch(ifNoneMatch)
+	return o
+}
+
+// SetIfNoneMatch adds the ifNoneMatch to the get file params
+func (o *GetFileParams) SetIfNoneMatch(ifNoneMatch *string) {
+	o.IfNoneMatch = ifNoneMatch
+}
+
+// WithIfRange adds the ifRange to the get file params
+func (o *GetFileParams) WithIfRange(ifRange *string) *GetFileParams {
+	o.SetIfRange(ifRange)
+	return o
+}
+
+// SetIfRange adds the ifRange to the get file params
+func (o *GetFileParams) SetIfRange(ifRange *string) {
+	o.IfRange = ifRange
+}
+
+// WithRange adds the rangeVar to the get file params
+func (o *GetFileParams) WithRange(rangeVar *string) *GetFileParams {
+	o.SetRange(rangeVar)
+	return o
+}
+
+// SetRange adds the range to the get file params
+func (o *GetFileParams) SetRange(rangeVar *string) {
+	o.Range = rangeVar
+}
+
+// WithDisposition adds the disposition to the get file params
+func (o *GetFileParams) WithDisposition(disposition *string) *GetFileParams {
+	o.SetDisposition(disposition)
+	return o
+}
+
+// SetDisposition adds the disposition to the get file params
+func (o *GetFileParams) SetDisposition(disposition *string) {
+	o.Disposition = disposition
+}
+
+// WithExpires adds the expires to the get file params
+func (o *GetFileParams) WithExpires(expires *int64) *GetFileParams {
+	o.SetExpires(expires)
+	return o
+}
+
+// SetExpires adds the expires to the get file params
+func (o *GetFileParams) SetExpires(expires *int64) {
+	o.Expires = expires
+}
+
 // WithID adds the id to the get file params
 func (o *GetFileParams) WithID(id strfmt.UUID) *GetFileParams {
 	o.SetID(id)
@@ -128,6 +241,28 @@ func (o *GetFileParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithSig adds the sig to the get file params
+func (o *GetFileParams) WithSig(sig *string) *GetFileParams {
+	o.SetSig(sig)
+	return o
+}
+
+// SetSig adds the sig to the get file params
+func (o *GetFileParams) SetSig(sig *string) {
+	o.Sig = sig
+}
+
+// WithW adds the w to the get file params
+func (o *GetFileParams) WithW(w *int32) *GetFileParams {
+	o.SetW(w)
+	return o
+}
+
+// SetW adds the w to the get file params
+func (o *GetFileParams) SetW(w *int32) {
+	o.W = w
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetFileParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -136,6 +271,72 @@ func (o *GetFileParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regis
 	}
 	var res []error
 
+	if o.IfModifiedSince != nil {
+
+		// header param If-Modified-Since
+		if err := r.SetHeaderParam("If-Modified-Since", *o.IfModifiedSince); err != nil {
+			return err
+		}
+	}
+
+	if o.IfNoneMatch != nil {
+
+		// header param If-None-Match
+		if err := r.SetHeaderParam("If-None-Match", *o.IfNoneMatch); err != nil {
+			return err
+		}
+	}
+
+	if o.IfRange != nil {
+
+		// header param If-Range
+		if err := r.SetHeaderParam("If-Range", *o.IfRange); err != nil {
+			return err
+		}
+	}
+
+	if o.Range != nil {
+
+		// header param Range
+		if err := r.SetHeaderParam("Range", *o.Range); err != nil {
+			return err
+		}
+	}
+
+	if o.Disposition != nil {
+
+		// query param disposition
+		var qrDisposition string
+
+		if o.Disposition != nil {
+			qrDisposition = *o.Disposition
+		}
+		qDisposition := qrDisposition
+		if qDisposition != "" {
+
+			if err := r.SetQueryParam("disposition", qDisposition); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Expires != nil {
+
+		// query param expires
+		var qrExpires int64
+
+		if o.Expires != nil {
+			qrExpires = *o.Expires
+		}
+		qExpires := swag.FormatInt64(qrExpires)
+		if qExpires != "" {
+
+			if err := r.SetQueryParam("expires", qExpires); err != nil {
+				return err
+			}
+		}
+	}
+
 	// query param id
 	qrID := o.ID
 	qID := qrID.String()
@@ -146,6 +347,40 @@ func (o *GetFileParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regis
 		}
 	}
 
+	if o.Sig != nil {
+
+		// query param sig
+		var qrSig string
+
+		if o.Sig != nil {
+			qrSig = *o.Sig
+		}
+		qSig := qrSig
+		if qSig != "" {
+
+			if err := r.SetQueryParam("sig", qSig); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.W != nil {
+
+		// query param w
+		var qrW int32
+
+		if o.W != nil {
+			qrW = *o.W
+		}
+		qW := swag.FormatInt32(qrW)
+		if qW != "" {
+
+			if err := r.SetQueryParam("w", qW); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -30,6 +30,24 @@ func (o *GetFileReader) ReadResponse(response runtime.ClientResponse, consumer r
 			return nil, err
 		}
 		return result, nil
+	case 206:
+		result := NewGetFilePartialContent(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 304:
+		result := NewGetFileNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 416:
+		result := NewGetFileRequestRangeNotSatisfiable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		result := NewGetFileDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -55,6 +73,27 @@ func NewGetFileOK(writer io.Writer) *GetFileOK {
 download file
 */
 type GetFileOK struct {
+	AcceptRanges string
+
+	/* Set by signed URL with disposition.
+	 */
+	ContentDisposition string
+
+	/* Set to gzip when file is served as stored.
+	 */
+	ContentEncoding string
+
+	/* MIME type detected by file content on upload.
+	 */
+	ContentType string
+
+	/* SHA-256 of full file content (RFC 3230), e.g. sha-256=base64.
+	 */
+	Digest       string
+	ETag         string
+	LastModified string
+	Vary         string
+
 	Payload io.Writer
 }
 
@@ -67,6 +106,139 @@ func (o *GetFileOK) GetPayload() io.Writer {
 
 func (o *GetFileOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header Accept-Ranges
+	hdrAcceptRanges := response.GetHeader("Accept-Ranges")
+
+	if hdrAcceptRanges != "" {
+		o.AcceptRanges = hdrAcceptRanges
+	}
+
+	// hydrates response header Content-Disposition
+	hdrContentDisposition := response.GetHeader("Content-Disposition")
+
+	if hdrContentDisposition != "" {
+		o.ContentDisposition = hdrContentDisposition
+	}
+
+	// hydrates response header Content-Encoding
+	hdrContentEncoding := response.GetHeader("Content-Encoding")
+
+	if hdrContentEncoding != "" {
+		o.ContentEncoding = hdrContentEncoding
+	}
+
+	// hydrates response header Content-Type
+	hdrContentType := response.GetHeader("Content-Type")
+
+	if hdrContentType != "" {
+		o.ContentType = hdrContentType
+	}
+
+	// hydrates response header Digest
+	hdrDigest := response.GetHeader("Digest")
+
+	if hdrDigest != "" {
+		o.Digest = hdrDigest
+	}
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	// hydrates response header Last-Modified
+	hdrLastModified := response.GetHeader("Last-Modified")
+
+	if hdrLastModified != "" {
+		o.LastModified = hdrLastModified
+	}
+
+	// hydrates response header Vary
+	hdrVary := response.GetHeader("Vary")
+
+	if hdrVary != "" {
+		o.Vary = hdrVary
+	}
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFilePartialContent creates a GetFilePartialContent with default headers values
+func NewGetFilePartialContent(writer io.Writer) *GetFilePartialContent {
+	return &GetFilePartialContent{
+
+		Payload: writer,
+	}
+}
+
+/* GetFilePartialContent describes a response with status code 206, with default header values.
+
+Partial file content. Multiple ranges are returned as multipart/byteranges.
+*/
+type GetFilePartialContent struct {
+	ContentRange string
+	ContentType  string
+
+	/* SHA-256 of full file content (RFC 3230), e.g. sha-256=base64.
+	 */
+	Digest       string
+	ETag         string
+	LastModified string
+
+	Payload io.Writer
+}
+
+func (o *GetFilePartialContent) Error() string {
+	return fmt.Sprintf("[GET /file][%d] getFilePartialContent  %+v", 206, o.Payload)
+}
+func (o *GetFilePartialContent) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *GetFilePartialContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Content-Range
+	hdrContentRange := response.GetHeader("Content-Range")
+
+	if hdrContentRange != "" {
+		o.ContentRange = hdrContentRange
+	}
+
+	// hydrates response header Content-Type
+	hdrContentType := response.GetHeader("Content-Type")
+
+	if hdrContentType != "" {
+		o.ContentType = hdrContentType
+	}
+
+	// hydrates response header Digest
+	hdrDigest := response.GetHeader("Digest")
+
+	if hdrDigest != "" {
+		o.Digest = hdrDigest
+	}
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	// hydrates response header Last-Modified
+	hdrLastModified := response.GetHeader("Last-Modified")
+
+	if hdrLastModified != "" {
+		o.LastModified = hdrLastModified
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
 		return err
@@ -75,6 +247,56 @@ func (o *GetFileOK) readResponse(response runtime.ClientResponse, consumer runti
 	return nil
 }
 
+// NewGetFileNotModified creates a GetFileNotModified with default headers values
+func NewGetFileNotModified() *GetFileNotModified {
+	return &GetFileNotModified{}
+}
+
+/* GetFileNotModified describes a response with status code 304, with default header values.
+
+The file was not modified.
+*/
+type GetFileNotModified struct {
+}
+
+func (o *GetFileNotModified) Error() string {
+	return fmt.Sprintf("[GET /file][%d] getFileNotModified ", 304)
+}
+
+func (o *GetFileNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetFileRequestRangeNotSatisfiable creates a GetFileRequestRangeNotSatisfiable with default headers values
+func NewGetFileRequestRangeNotSatisfiable() *GetFileRequestRangeNotSatisfiable {
+	return &GetFileRequestRangeNotSatisfiable{}
+}
+
+/* GetFileRequestRangeNotSatisfiable describes a response with status code 416, with default header values.
+
+Requested range not satisfiable.
+*/
+type GetFileRequestRangeNotSatisfiable struct {
+	ContentRange string
+}
+
+func (o *GetFileRequestRangeNotSatisfiable) Error() string {
+	return fmt.Sprintf("[GET /file][%d] getFileRequestRangeNotSatisfiable ", 416)
+}
+
+func (o *GetFileRequestRangeNotSatisfiable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Content-Range
+	hdrContentRange := response.GetHeader("Content-Range")
+
+	if hdrContentRange != "" {
+		o.ContentRange = hdrContentRange
+	}
+
+	return nil
+}
+
 // NewGetFileDefault creates a GetFileDefault with default headers values
 func NewGetFileDefault(code int) *GetFileDefault {
 	return &GetFileDefault{
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetUploadParams creates a new GetUploadParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetUploadParams() *GetUploadParams {
+	return &GetUploadParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetUploadParamsWithTimeout creates a new GetUploadParams object
+// with the ability to set a timeout on a request.
+func NewGetUploadParamsWithTimeout(timeout time.Duration) *GetUploadParams {
+	return &GetUploadParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetUploadParamsWithContext creates a new GetUploadParams object
+// with the ability to set a context for a request.
+func NewGetUploadParamsWithContext(ctx context.Context) *GetUploadParams {
+	return &GetUploadParams{
+		Context: ctx,
+	}
+}
+
+// NewGetUploadParamsWithHTTPClient creates a new GetUploadParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetUploadParamsWithHTTPClient(client *http.Client) *GetUploadParams {
+	return &GetUploadParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetUploadParams contains all the parameters to send to the API endpoint
+   for the get upload operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetUploadParams struct {
+
+	// ID.
+	//
+	// Format: uuid
+	ID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get upload params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetUploadParams) WithDefaults() *GetUploadParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get upload params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetUploadParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get upload params
+func (o *GetUploadParams) WithTimeout(timeout time.Duration) *GetUploadParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get upload params
+func (o *GetUploadParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get upload params
+func (o *GetUploadParams) WithContext(ctx context.Context) *GetUploadParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get upload params
+func (o *GetUploadParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get upload params
+func (o *GetUploadParams) WithHTTPClient(client *http.Client) *GetUploadParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get upload params
+func (o *GetUploadParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the get upload params
+func (o *GetUploadParams) WithID(id strfmt.UUID) *GetUploadParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get upload params
+func (o *GetUploadParams) SetID(id strfmt.UUID) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetUploadParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// GetUploadReader is a Reader for the GetUpload structure.
+type GetUploadReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetUploadReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetUploadOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetUploadDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetUploadOK creates a GetUploadOK with default headers values
+func NewGetUploadOK() *GetUploadOK {
+	return &GetUploadOK{}
+}
+
+/* GetUploadOK describes a response with status code 200, with default header values.
+
+Upload session.
+*/
+type GetUploadOK struct {
+	UploadLength int64
+	UploadOffset int64
+
+	Payload *models.UploadSession
+}
+
+func (o *GetUploadOK) Error() string {
+	return fmt.Sprintf("[GET /uploads/{id}][%d] getUploadOK  %+v", 200, o.Payload)
+}
+func (o *GetUploadOK) GetPayload() *models.UploadSession {
+	return o.Payload
+}
+
+func (o *GetUploadOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Upload-Length
+	hdrUploadLength := response.GetHeader("Upload-Length")
+
+	if hdrUploadLength != "" {
+		valuploadLength, err := swag.ConvertInt64(hdrUploadLength)
+		if err != nil {
+			return errors.InvalidType("Upload-Length", "header", "int64", hdrUploadLength)
+		}
+		o.UploadLength = valuploadLength
+	}
+
+	// hydrates response header Upload-Offset
+	hdrUploadOffset := response.GetHeader("Upload-Offset")
+
+	if hdrUploadOffset != "" {
+		valuploadOffset, err := swag.ConvertInt64(hdrUploadOffset)
+		if err != nil {
+			return errors.InvalidType("Upload-Offset", "header", "int64", hdrUploadOffset)
+		}
+		o.UploadOffset = valuploadOffset
+	}
+
+	o.Payload = new(models.UploadSession)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUploadDefault creates a GetUploadDefault with default headers values
+func NewGetUploadDefault(code int) *GetUploadDefault {
+	return &GetUploadDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetUploadDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type GetUploadDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get upload default response
+func (o *GetUploadDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetUploadDefault) Error() string {
+	return fmt.Sprintf("[GET /uploads/{id}][%d] getUpload default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetUploadDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetUploadDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
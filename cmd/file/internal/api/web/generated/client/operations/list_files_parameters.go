@@ -0,0 +1,485 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListFilesParams creates a new ListFilesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListFilesParams() *ListFilesParams {
+	return &ListFilesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListFilesParamsWithTimeout creates a new ListFilesParams object
+// with the ability to set a timeout on a request.
+func NewListFilesParamsWithTimeout(timeout time.Duration) *ListFilesParams {
+	return &ListFilesParams{
+		timeout: timeout,
+	}
+}
+
+// NewListFilesParamsWithContext creates a new ListFilesParams object
+// with the ability to set a context for a request.
+func NewListFilesParamsWithContext(ctx context.Context) *ListFilesParams {
+	return &ListFilesParams{
+		Context: ctx,
+	}
+}
+
+// NewListFilesParamsWithHTTPClient creates a new ListFilesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListFilesParamsWithHTTPClient(client *http.Client) *ListFilesParams {
+	return &ListFilesParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListFilesParams contains all the parameters to send to the API endpoint
+   for the list files operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListFilesParams struct {
+
+	/* ContentType.
+
+	   MIME type, e.g. image/png or image/*.
+	*/
+	ContentType *string
+
+	/* CreatedAfter.
+
+	   Files created at or after time.
+
+	   Format: date-time
+	*/
+	CreatedAfter *strfmt.DateTime
+
+	/* CreatedBefore.
+
+	   Files created before time.
+
+	   Format: date-time
+	*/
+	CreatedBefore *strfmt.DateTime
+
+	/* Cursor.
+
+	   Cursor returned with previous page.
+	*/
+	Cursor *string
+
+	// Limit.
+	//
+	// Format: int32
+	// Default: 20
+	Limit *int32
+
+	// MaxSize.
+	//
+	// Format: int64
+	MaxSize *int64
+
+	/* Metadata.
+
+	   JSON object, files which metadata contains it are returned.
+	*/
+	Metadata *string
+
+	// MinSize.
+	//
+	// Format: int64
+	MinSize *int64
+
+	// Order.
+	//
+	// Default: "asc"
+	Order *string
+
+	// SortBy.
+	//
+	// Default: "createdAt"
+	SortBy *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list files params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListFilesParams) WithDefaults() *ListFilesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list files params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListFilesParams) SetDefaults() {
+	var (
+		limitDefault = int32(20)
+
+		orderDefault = string("asc")
+
+		sortByDefault = string("createdAt")
+	)
+
+	val := ListFilesParams{
+		Limit:  &limitDefault,
+		Order:  &orderDefault,
+		SortBy: &sortByDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the list files params
+func (o *ListFilesParams) WithTimeout(timeout time.Duration) *ListFilesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list files params
+func (o *ListFilesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list files params
+func (o *ListFilesParams) WithContext(ctx context.Context) *ListFilesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list files params
+func (o *ListFilesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list files params
+func (o *ListFilesParams) WithHTTPClient(client *http.Client) *ListFilesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list files params
+func (o *ListFilesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithContentType adds the contentType to the list files params
+func (o *ListFilesParams) WithContentType(contentType *string) *ListFilesParams {
+	o.SetContentType(contentType)
+	return o
+}
+
+// SetContentType adds the contentType to the list files params
+func (o *ListFilesParams) SetContentType(contentType *string) {
+	o.ContentType = contentType
+}
+
+// WithCreatedAfter adds the createdAfter to the list files params
+func (o *ListFilesParams) WithCreatedAfter(createdAfter *strfmt.DateTime) *ListFilesParams {
+	o.SetCreatedAfter(createdAfter)
+	return o
+}
+
+// SetCreatedAfter adds the createdAfter to the list files params
+func (o *ListFilesParams) SetCreatedAfter(createdAfter *strfmt.DateTime) {
+	o.CreatedAfter = createdAfter
+}
+
+// WithCreatedBefore adds the createdBefore to the list files params
+func (o *ListFilesParams) WithCreatedBefore(createdBefore *strfmt.DateTime) *ListFilesParams {
+	o.SetCreatedBefore(createdBefore)
+	return o
+}
+
+// SetCreatedBefore adds the createdBefore to the list files params
+func (o *ListFilesParams) SetCreatedBefore(createdBefore *strfmt.DateTime) {
+	o.CreatedBefore = createdBefore
+}
+
+// WithCursor adds the cursor to the list files params
+func (o *ListFilesParams) WithCursor(cursor *string) *ListFilesParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the list files params
+func (o *ListFilesParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithLimit adds the limit to the list files params
+func (o *ListFilesParams) WithLimit(limit *int32) *ListFilesParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list files params
+func (o *ListFilesParams) SetLimit(limit *int32) {
+	o.Limit = limit
+}
+
+// WithMaxSize adds the maxSize to the list files params
+func (o *ListFilesParams) WithMaxSize(maxSize *int64) *ListFilesParams {
+	o.SetMaxSize(maxSize)
+	return o
+}
+
+// SetMaxSize adds the maxSize to the list files params
+func (o *ListFilesParams) SetMaxSize(maxSize *int64) {
+	o.MaxSize = maxSize
+}
+
+// WithMetadata adds the metadata to the list files params
+func (o *ListFilesParams) WithMetadata(metadata *string) *ListFilesParams {
+	o.SetMetadata(metadata)
+	return o
+}
+
+// SetMetadata adds the metadata to the list files params
+func (o *ListFilesParams) SetMetadata(metadata *string) {
+	o.Metadata = metadata
+}
+
+// WithMinSize adds the minSize to the list files params
+func (o *ListFilesParams) WithMinSize(minSize *int64) *ListFilesParams {
+	o.SetMinSize(minSize)
+	return o
+}
+
+// SetMinSize adds the minSize to the list files params
+func (o *ListFilesParams) SetMinSize(minSize *int64) {
+	o.MinSize = minSize
+}
+
+// WithOrder adds the order to the list files params
+func (o *ListFilesParams) WithOrder(order *string) *ListFilesParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the list files params
+func (o *ListFilesParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithSortBy adds the sortBy to the list files params
+func (o *ListFilesParams) WithSortBy(sortBy *string) *ListFilesParams {
+	o.SetSortBy(sortBy)
+	return o
+}
+
+// SetSortBy adds the sortBy to the list files params
+func (o *ListFilesParams) SetSortBy(sortBy *string) {
+	o.SortBy = sortBy
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListFilesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.ContentType != nil {
+
+		// query param contentType
+		var qrContentType string
+
+		if o.ContentType != nil {
+			qrContentType = *o.ContentType
+		}
+		qContentType := qrContentType
+		if qContentType != "" {
+
+			if err := r.SetQueryParam("contentType", qContentType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.CreatedAfter != nil {
+
+		// query param createdAfter
+		var qrCreatedAfter strfmt.DateTime
+
+		if o.CreatedAfter != nil {
+			qrCreatedAfter = *o.CreatedAfter
+		}
+		qCreatedAfter := qrCreatedAfter.String()
+		if qCreatedAfter != "" {
+
+			if err := r.SetQueryParam("createdAfter", qCreatedAfter); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.CreatedBefore != nil {
+
+		// query param createdBefore
+		var qrCreatedBefore strfmt.DateTime
+
+		if o.CreatedBefore != nil {
+			qrCreatedBefore = *o.CreatedBefore
+		}
+		qCreatedBefore := qrCreatedBefore.String()
+		if qCreatedBefore != "" {
+
+			if err := r.SetQueryParam("createdBefore", qCreatedBefore); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int32
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt32(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MaxSize != nil {
+
+		// query param maxSize
+		var qrMaxSize int64
+
+		if o.MaxSize != nil {
+			qrMaxSize = *o.MaxSize
+		}
+		qMaxSize := swag.FormatInt64(qrMaxSize)
+		if qMaxSize != "" {
+
+			if err := r.SetQueryParam("maxSize", qMaxSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Metadata != nil {
+
+		// query param metadata
+		var qrMetadata string
+
+		if o.Metadata != nil {
+			qrMetadata = *o.Metadata
+		}
+		qMetadata := qrMetadata
+		if qMetadata != "" {
+
+			if err := r.SetQueryParam("metadata", qMetadata); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinSize != nil {
+
+		// query param minSize
+		var qrMinSize int64
+
+		if o.MinSize != nil {
+			qrMinSize = *o.MinSize
+		}
+		qMinSize := swag.FormatInt64(qrMinSize)
+		if qMinSize != "" {
+
+			if err := r.SetQueryParam("minSize", qMinSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SortBy != nil {
+
+		// query param sortBy
+		var qrSortBy string
+
+		if o.SortBy != nil {
+			qrSortBy = *o.SortBy
+		}
+		qSortBy := qrSortBy
+		if qSortBy != "" {
+
+			if err := r.SetQueryParam("sortBy", qSortBy); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// ListFilesReader is a Reader for the ListFiles structure.
+type ListFilesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListFilesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListFilesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListFilesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListFilesOK creates a ListFilesOK with default headers values
+func NewListFilesOK() *ListFilesOK {
+	return &ListFilesOK{}
+}
+
+/* ListFilesOK describes a response with status code 200, with default header values.
+
+Page of files.
+*/
+type ListFilesOK struct {
+	Payload *models.FileList
+}
+
+func (o *ListFilesOK) Error() string {
+	return fmt.Sprintf("[GET /files][%d] listFilesOK  %+v", 200, o.Payload)
+}
+func (o *ListFilesOK) GetPayload() *models.FileList {
+	return o.Payload
+}
+
+func (o *ListFilesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.FileList)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListFilesDefault creates a ListFilesDefault with default headers values
+func NewListFilesDefault(code int) *ListFilesDefault {
+	return &ListFilesDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListFilesDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type ListFilesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list files default response
+func (o *ListFilesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListFilesDefault) Error() string {
+	return fmt.Sprintf("[GET /files][%d] listFiles default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListFilesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListFilesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -30,15 +30,150 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	GetFile(params *GetFileParams, writer io.Writer, opts ...ClientOption) (*GetFileOK, error)
+	AbortUpload(params *AbortUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AbortUploadNoContent, error)
+
+	CompleteUpload(params *CompleteUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CompleteUploadOK, error)
+
+	CreateUpload(params *CreateUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateUploadCreated, error)
+
+	GetFile(params *GetFileParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*GetFileOK, *GetFilePartialContent, error)
+
+	GetUpload(params *GetUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUploadOK, error)
+
+	ListFiles(params *ListFilesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListFilesOK, error)
+
+	SetFileAccess(params *SetFileAccessParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetFileAccessNoContent, error)
+
+	UploadPart(params *UploadPartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UploadPartOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
 /*
-  GetFile get file API
+  AbortUpload Abort upload session and remove received parts.
+*/
+func (a *Client) AbortUpload(params *AbortUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AbortUploadNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAbortUploadParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "abortUpload",
+		Method:             "DELETE",
+		PathPattern:        "/uploads/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AbortUploadReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AbortUploadNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AbortUploadDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  CompleteUpload Complete upload session and build file from received parts.
+Infected file is rejected, file is quarantined if it can't be scanned yet.
+
 */
-func (a *Client) GetFile(params *GetFileParams, writer io.Writer, opts ...ClientOption) (*GetFileOK, error) {
+func (a *Client) CompleteUpload(params *CompleteUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CompleteUploadOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCompleteUploadParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "completeUpload",
+		Method:             "POST",
+		PathPattern:        "/uploads/{id}/complete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CompleteUploadReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CompleteUploadOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CompleteUploadDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  CreateUpload Create resumable upload session.
+*/
+func (a *Client) CreateUpload(params *CreateUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateUploadCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateUploadParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createUpload",
+		Method:             "POST",
+		PathPattern:        "/uploads",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreateUploadReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateUploadCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateUploadDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetFile Download file, Content-Type is detected by file content on upload.
+Public files are served without session, private and shared files to owner and users it is shared with.
+Any file is served without session by URL signed by file service until it expires.
+File stored in gzip is served with Content-Encoding gzip if client accepts it and doesn't request ranges.
+Quarantined file isn't served until it is scanned, infected file is never served.
+
+*/
+func (a *Client) GetFile(params *GetFileParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*GetFileOK, *GetFilePartialContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetFileParams()
@@ -47,11 +182,52 @@ func (a *Client) GetFile(params *GetFileParams, writer io.Writer, opts ...Client
 		ID:                 "getFile",
 		Method:             "GET",
 		PathPattern:        "/file",
-		ProducesMediaTypes: []string{"image/jpeg", "image/png"},
+		ProducesMediaTypes: []string{"application/octet-stream", "image/gif", "image/jpeg", "image/png", "image/webp"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &GetFileReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *GetFileOK:
+		return value, nil, nil
+	case *GetFilePartialContent:
+		return nil, value, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetFileDefault)
+	return nil, nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetUpload Get upload session with received parts.
+*/
+func (a *Client) GetUpload(params *GetUploadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUploadOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetUploadParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getUpload",
+		Method:             "GET",
+		PathPattern:        "/uploads/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetUploadReader{formats: a.formats},
+		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -63,12 +239,126 @@ func (a *Client) GetFile(params *GetFileParams, writer io.Writer, opts ...Client
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFileOK)
+	success, ok := result.(*GetUploadOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetFileDefault)
+	unexpectedSuccess := result.(*GetUploadDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListFiles List user's files with filters, sorting and cursor pagination. Derived files are skipped.
+*/
+func (a *Client) ListFiles(params *ListFilesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListFilesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListFilesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listFiles",
+		Method:             "GET",
+		PathPattern:        "/files",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListFilesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListFilesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListFilesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  SetFileAccess Set file visibility and users it is shared with, only owner can set it.
+*/
+func (a *Client) SetFileAccess(params *SetFileAccessParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetFileAccessNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetFileAccessParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "setFileAccess",
+		Method:             "PUT",
+		PathPattern:        "/file/access",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SetFileAccessReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SetFileAccessNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SetFileAccessDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  UploadPart Upload part of upload session, re-uploading part replaces its content.
+*/
+func (a *Client) UploadPart(params *UploadPartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UploadPartOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUploadPartParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "uploadPart",
+		Method:             "PUT",
+		PathPattern:        "/uploads/{id}/parts/{number}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/octet-stream"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UploadPartReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UploadPartOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UploadPartDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
@@ -0,0 +1,174 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// NewSetFileAccessParams creates a new SetFileAccessParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSetFileAccessParams() *SetFileAccessParams {
+	return &SetFileAccessParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSetFileAccessParamsWithTimeout creates a new SetFileAccessParams object
+// with the ability to set a timeout on a request.
+func NewSetFileAccessParamsWithTimeout(timeout time.Duration) *SetFileAccessParams {
+	return &SetFileAccessParams{
+		timeout: timeout,
+	}
+}
+
+// NewSetFileAccessParamsWithContext creates a new SetFileAccessParams object
+// with the ability to set a context for a request.
+func NewSetFileAccessParamsWithContext(ctx context.Context) *SetFileAccessParams {
+	return &SetFileAccessParams{
+		Context: ctx,
+	}
+}
+
+// NewSetFileAccessParamsWithHTTPClient creates a new SetFileAccessParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSetFileAccessParamsWithHTTPClient(client *http.Client) *SetFileAccessParams {
+	return &SetFileAccessParams{
+		HTTPClient: client,
+	}
+}
+
+/* SetFileAccessParams contains all the parameters to send to the API endpoint
+   for the set file access operation.
+
+   Typically these are written to a http.Request.
+*/
+type SetFileAccessParams struct {
+
+	// Access.
+	Access *models.Access
+
+	// ID.
+	//
+	// Format: uuid
+	ID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the set file access params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetFileAccessParams) WithDefaults() *SetFileAccessParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the set file access params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetFileAccessParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the set file access params
+func (o *SetFileAccessParams) WithTimeout(timeout time.Duration) *SetFileAccessParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the set file access params
+func (o *SetFileAccessParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the set file access params
+func (o *SetFileAccessParams) WithContext(ctx context.Context) *SetFileAccessParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the set file access params
+func (o *SetFileAccessParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the set file access params
+func (o *SetFileAccessParams) WithHTTPClient(client *http.Client) *SetFileAccessParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the set file access params
+func (o *SetFileAccessParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccess adds the access to the set file access params
+func (o *SetFileAccessParams) WithAccess(access *models.Access) *SetFileAccessParams {
+	o.SetAccess(access)
+	return o
+}
+
+// SetAccess adds the access to the set file access params
+func (o *SetFileAccessParams) SetAccess(access *models.Access) {
+	o.Access = access
+}
+
+// WithID adds the id to the set file access params
+func (o *SetFileAccessParams) WithID(id strfmt.UUID) *SetFileAccessParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the set file access params
+func (o *SetFileAccessParams) SetID(id strfmt.UUID) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SetFileAccessParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Access != nil {
+		if err := r.SetBodyParam(o.Access); err != nil {
+			return err
+		}
+	}
+
+	// query param id
+	qrID := o.ID
+	qID := qrID.String()
+	if qID != "" {
+
+		if err := r.SetQueryParam("id", qID); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// SetFileAccessReader is a Reader for the SetFileAccess structure.
+type SetFileAccessReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SetFileAccessReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewSetFileAccessNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewSetFileAccessDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSetFileAccessNoContent creates a SetFileAccessNoContent with default headers values
+func NewSetFileAccessNoContent() *SetFileAccessNoContent {
+	return &SetFileAccessNoContent{}
+}
+
+/* SetFileAccessNoContent describes a response with status code 204, with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type SetFileAccessNoContent struct {
+}
+
+func (o *SetFileAccessNoContent) Error() string {
+	return fmt.Sprintf("[PUT /file/access][%d] setFileAccessNoContent ", 204)
+}
+
+func (o *SetFileAccessNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSetFileAccessDefault creates a SetFileAccessDefault with default headers values
+func NewSetFileAccessDefault(code int) *SetFileAccessDefault {
+	return &SetFileAccessDefault{
+		_statusCode: code,
+	}
+}
+
+/* SetFileAccessDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type SetFileAccessDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the set file access default response
+func (o *SetFileAccessDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SetFileAccessDefault) Error() string {
+	return fmt.Sprintf("[PUT /file/access][%d] setFileAccess default  %+v", o._statusCode, o.Payload)
+}
+func (o *SetFileAccessDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetFileAccessDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
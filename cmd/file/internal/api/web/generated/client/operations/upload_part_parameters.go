@@ -0,0 +1,192 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewUploadPartParams creates a new UploadPartParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUploadPartParams() *UploadPartParams {
+	return &UploadPartParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUploadPartParamsWithTimeout creates a new UploadPartParams object
+// with the ability to set a timeout on a request.
+func NewUploadPartParamsWithTimeout(timeout time.Duration) *UploadPartParams {
+	return &UploadPartParams{
+		timeout: timeout,
+	}
+}
+
+// NewUploadPartParamsWithContext creates a new UploadPartParams object
+// with the ability to set a context for a request.
+func NewUploadPartParamsWithContext(ctx context.Context) *UploadPartParams {
+	return &UploadPartParams{
+		Context: ctx,
+	}
+}
+
+// NewUploadPartParamsWithHTTPClient creates a new UploadPartParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUploadPartParamsWithHTTPClient(client *http.Client) *UploadPartParams {
+	return &UploadPartParams{
+		HTTPClient: client,
+	}
+}
+
+/* UploadPartParams contains all the parameters to send to the API endpoint
+   for the upload part operation.
+
+   Typically these are written to a http.Request.
+*/
+type UploadPartParams struct {
+
+	// ID.
+	//
+	// Format: uuid
+	ID strfmt.UUID
+
+	// Number.
+	//
+	// Format: int32
+	Number int32
+
+	// Part.
+	//
+	// Format: binary
+	Part io.ReadCloser
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the upload part params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UploadPartParams) WithDefaults() *UploadPartParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the upload part params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UploadPartParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the upload part params
+func (o *UploadPartParams) WithTimeout(timeout time.Duration) *UploadPartParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the upload part params
+func (o *UploadPartParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the upload part params
+func (o *UploadPartParams) WithContext(ctx context.Context) *UploadPartParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the upload part params
+func (o *UploadPartParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the upload part params
+func (o *UploadPartParams) WithHTTPClient(client *http.Client) *UploadPartParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the upload part params
+func (o *UploadPartParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the upload part params
+func (o *UploadPartParams) WithID(id strfmt.UUID) *UploadPartParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the upload part params
+func (o *UploadPartParams) SetID(id strfmt.UUID) {
+	o.ID = id
+}
+
+// WithNumber adds the number to the upload part params
+func (o *UploadPartParams) WithNumber(number int32) *UploadPartParams {
+	o.SetNumber(number)
+	return o
+}
+
+// SetNumber adds the number to the upload part params
+func (o *UploadPartParams) SetNumber(number int32) {
+	o.Number = number
+}
+
+// WithPart adds the part to the upload part params
+func (o *UploadPartParams) WithPart(part io.ReadCloser) *UploadPartParams {
+	o.SetPart(part)
+	return o
+}
+
+// SetPart adds the part to the upload part params
+func (o *UploadPartParams) SetPart(part io.ReadCloser) {
+	o.Part = part
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UploadPartParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID.String()); err != nil {
+		return err
+	}
+
+	// path param number
+	if err := r.SetPathParam("number", swag.FormatInt32(o.Number)); err != nil {
+		return err
+	}
+	if o.Part != nil {
+		if err := r.SetBodyParam(o.Part); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// UploadPartReader is a Reader for the UploadPart structure.
+type UploadPartReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UploadPartReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUploadPartOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewUploadPartDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUploadPartOK creates a UploadPartOK with default headers values
+func NewUploadPartOK() *UploadPartOK {
+	return &UploadPartOK{}
+}
+
+/* UploadPartOK describes a response with status code 200, with default header values.
+
+Received part.
+*/
+type UploadPartOK struct {
+	Payload *models.Part
+}
+
+func (o *UploadPartOK) Error() string {
+	return fmt.Sprintf("[PUT /uploads/{id}/parts/{number}][%d] uploadPartOK  %+v", 200, o.Payload)
+}
+func (o *UploadPartOK) GetPayload() *models.Part {
+	return o.Payload
+}
+
+func (o *UploadPartOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Part)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUploadPartDefault creates a UploadPartDefault with default headers values
+func NewUploadPartDefault(code int) *UploadPartDefault {
+	return &UploadPartDefault{
+		_statusCode: code,
+	}
+}
+
+/* UploadPartDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type UploadPartDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the upload part default response
+func (o *UploadPartDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UploadPartDefault) Error() string {
+	return fmt.Sprintf("[PUT /uploads/{id}/parts/{number}][%d] uploadPart default  %+v", o._statusCode, o.Payload)
+}
+func (o *UploadPartDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UploadPartDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
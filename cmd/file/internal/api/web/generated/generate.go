@@ -2,5 +2,5 @@
 package generated
 
 //go:generate rm -rf models restapi client
-//go:generate swagger generate server -f ../../../../swagger.yml --strict-responders --strict-additional-properties --principal github.com/Meat-Hook/back-template/cmd/session/internal/app.Session --exclude-main
+//go:generate swagger generate server -f ../../../../swagger.yml --strict-responders --strict-additional-properties --principal github.com/Meat-Hook/back-template/cmd/file/internal/app.Session --exclude-main
 //go:generate swagger generate client -f ../../../../swagger.yml
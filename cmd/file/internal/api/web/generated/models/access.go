@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Access access
+//
+// swagger:model Access
+type Access struct {
+
+	// Users who can read file with shared visibility.
+	SharedWith []strfmt.UUID `json:"sharedWith"`
+
+	// visibility
+	// Required: true
+	Visibility *Visibility `json:"visibility"`
+}
+
+// Validate validates this access
+func (m *Access) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSharedWith(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVisibility(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Access) validateSharedWith(formats strfmt.Registry) error {
+	if swag.IsZero(m.SharedWith) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.SharedWith); i++ {
+
+		if err := validate.FormatOf("sharedWith"+"."+strconv.Itoa(i), "body", "uuid", m.SharedWith[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Access) validateVisibility(formats strfmt.Registry) error {
+
+	if err := validate.Required("visibility", "body", m.Visibility); err != nil {
+		return err
+	}
+
+	if err := validate.Required("visibility", "body", m.Visibility); err != nil {
+		return err
+	}
+
+	if m.Visibility != nil {
+		if err := m.Visibility.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("visibility")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this access based on the context it is used
+func (m *Access) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateVisibility(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Access) contextValidateVisibility(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Visibility != nil {
+		if err := m.Visibility.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("visibility")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Access) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Access) UnmarshalBinary(b []byte) error {
+	var res Access
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
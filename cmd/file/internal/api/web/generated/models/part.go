@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Part part
+//
+// swagger:model Part
+type Part struct {
+
+	// number
+	// Required: true
+	// Minimum: 1
+	Number *int32 `json:"number"`
+
+	// size
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this part
+func (m *Part) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNumber(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Part) validateNumber(formats strfmt.Registry) error {
+
+	if err := validate.Required("number", "body", m.Number); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("number", "body", int64(*m.Number), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Part) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("size", "body", m.Size); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this part based on context it is used
+func (m *Part) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Part) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Part) UnmarshalBinary(b []byte) error {
+	var res Part
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// UploadSession upload session
+//
+// swagger:model UploadSession
+type UploadSession struct {
+
+	// id
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id"`
+
+	// Amount of bytes received without gaps from the start of file.
+	// Required: true
+	Offset *int64 `json:"offset"`
+
+	// parts
+	// Required: true
+	Parts []*Part `json:"parts"`
+
+	// Declared size of whole file, 0 if unknown.
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this upload session
+func (m *UploadSession) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOffset(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateParts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UploadSession) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UploadSession) validateOffset(formats strfmt.Registry) error {
+
+	if err := validate.Required("offset", "body", m.Offset); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UploadSession) validateParts(formats strfmt.Registry) error {
+
+	if err := validate.Required("parts", "body", m.Parts); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Parts); i++ {
+		if swag.IsZero(m.Parts[i]) { // not required
+			continue
+		}
+
+		if m.Parts[i] != nil {
+			if err := m.Parts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("parts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *UploadSession) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("size", "body", m.Size); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this upload session based on the context it is used
+func (m *UploadSession) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateParts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UploadSession) contextValidateParts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Parts); i++ {
+
+		if m.Parts[i] != nil {
+			if err := m.Parts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("parts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UploadSession) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UploadSession) UnmarshalBinary(b []byte) error {
+	var res UploadSession
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
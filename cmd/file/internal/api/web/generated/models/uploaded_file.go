@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// UploadedFile uploaded file
+//
+// swagger:model UploadedFile
+type UploadedFile struct {
+
+	// MIME type detected by file content.
+	ContentType string `json:"contentType,omitempty"`
+
+	// Hex encoded SHA-256 of file content.
+	// Required: true
+	Digest *string `json:"digest"`
+
+	// id
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id"`
+
+	// scan status
+	ScanStatus ScanStatus `json:"scanStatus,omitempty"`
+
+	// size
+	// Required: true
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this uploaded file
+func (m *UploadedFile) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDigest(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScanStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UploadedFile) validateDigest(formats strfmt.Registry) error {
+
+	if err := validate.Required("digest", "body", m.Digest); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UploadedFile) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UploadedFile) validateScanStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.ScanStatus) { // not required
+		return nil
+	}
+
+	if err := m.ScanStatus.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("scanStatus")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *UploadedFile) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("size", "body", m.Size); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this uploaded file based on the context it is used
+func (m *UploadedFile) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateScanStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UploadedFile) contextValidateScanStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.ScanStatus.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("scanStatus")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UploadedFile) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UploadedFile) UnmarshalBinary(b []byte) error {
+	var res UploadedFile
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
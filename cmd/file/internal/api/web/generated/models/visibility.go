@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// Visibility Who can read file besides owner.
+//
+// swagger:model Visibility
+type Visibility string
+
+func NewVisibility(value Visibility) *Visibility {
+	v := value
+	return &v
+}
+
+const (
+
+	// VisibilityPrivate captures enum value "private"
+	VisibilityPrivate Visibility = "private"
+
+	// VisibilityPublic captures enum value "public"
+	VisibilityPublic Visibility = "public"
+
+	// VisibilityShared captures enum value "shared"
+	VisibilityShared Visibility = "shared"
+)
+
+// for schema
+var visibilityEnum []interface{}
+
+func init() {
+	var res []Visibility
+	if err := json.Unmarshal([]byte(`["private","public","shared"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		visibilityEnum = append(visibilityEnum, v)
+	}
+}
+
+func (m Visibility) validateVisibilityEnum(path, location string, value Visibility) error {
+	if err := validate.EnumCase(path, location, value, visibilityEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this visibility
+func (m Visibility) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateVisibilityEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this visibility based on context it is used
+func (m Visibility) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -10,9 +10,10 @@ import (
 	"github.com/go-openapi/runtime"
 
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/restapi/operations"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
 )
 
-//go:generate swagger generate server --target ../../generated --name FileService --spec ../../../../../swagger.yml --principal github.com/Meat-Hook/back-template/cmd/session/internal/app.Session --exclude-main --strict-responders
+//go:generate swagger generate server --target ../../generated --name FileService --spec ../../../../../swagger.yml --principal github.com/Meat-Hook/back-template/cmd/file/internal/app.Session --exclude-main --strict-responders
 
 func configureFlags(api *operations.FileServiceAPI) {
 	// api.CommandLineOptionsGroups = []swag.CommandLineOptionsGroup{ ... }
@@ -32,15 +33,65 @@ func configureAPI(api *operations.FileServiceAPI) http.Handler {
 	// To continue using redoc as your UI, uncomment the following line
 	// api.UseRedoc()
 
+	api.BinConsumer = runtime.ByteStreamConsumer()
 	api.JSONConsumer = runtime.JSONConsumer()
 
 	api.BinProducer = runtime.ByteStreamProducer()
+	api.JSONProducer = runtime.JSONProducer()
 
+	// Applies when the "Cookie" header is set
+	if api.CookieKeyAuth == nil {
+		api.CookieKeyAuth = func(token string) (*app.Session, error) {
+			return nil, errors.NotImplemented("api key auth (cookieKey) Cookie from header param [Cookie] has not yet been implemented")
+		}
+	}
+
+	// Set your custom authorizer if needed. Default one is security.Authorized()
+	// Expected interface runtime.Authorizer
+	//
+	// Example:
+	// api.APIAuthorizer = security.Authorized()
+
+	if api.AbortUploadHandler == nil {
+		api.AbortUploadHandler = operations.AbortUploadHandlerFunc(func(params operations.AbortUploadParams, principal *app.Session) operations.AbortUploadResponder {
+			return operations.AbortUploadNotImplemented()
+		})
+	}
+	if api.CompleteUploadHandler == nil {
+		api.CompleteUploadHandler = operations.CompleteUploadHandlerFunc(func(params operations.CompleteUploadParams, principal *app.Session) operations.CompleteUploadResponder {
+			return operations.CompleteUploadNotImplemented()
+		})
+	}
+	if api.CreateUploadHandler == nil {
+		api.CreateUploadHandler = operations.CreateUploadHandlerFunc(func(params operations.CreateUploadParams, principal *app.Session) operations.CreateUploadResponder {
+			return operations.CreateUploadNotImplemented()
+		})
+	}
 	if api.GetFileHandler == nil {
-		api.GetFileHandler = operations.GetFileHandlerFunc(func(params operations.GetFileParams) operations.GetFileResponder {
+		api.GetFileHandler = operations.GetFileHandlerFunc(func(params operations.GetFileParams, principal *app.Session) operations.GetFileResponder {
 			return operations.GetFileNotImplemented()
 		})
 	}
+	if api.GetUploadHandler == nil {
+		api.GetUploadHandler = operations.GetUploadHandlerFunc(func(params operations.GetUploadParams, principal *app.Session) operations.GetUploadResponder {
+			return operations.GetUploadNotImplemented()
+		})
+	}
+	if api.ListFilesHandler == nil {
+		api.ListFilesHandler = operations.ListFilesHandlerFunc(func(params operations.ListFilesParams, principal *app.Session) operations.ListFilesResponder {
+			return operations.ListFilesNotImplemented()
+		})
+	}
+	if api.SetFileAccessHandler == nil {
+		api.SetFileAccessHandler = operations.SetFileAccessHandlerFunc(func(params operations.SetFileAccessParams, principal *app.Session) operations.SetFileAccessResponder {
+			return operations.SetFileAccessNotImplemented()
+		})
+	}
+	if api.UploadPartHandler == nil {
+		api.UploadPartHandler = operations.UploadPartHandlerFunc(func(params operations.UploadPartParams, principal *app.Session) operations.UploadPartResponder {
+			return operations.UploadPartNotImplemented()
+		})
+	}
 
 	api.PreServerShutdown = func() {}
 
@@ -10,11 +10,16 @@
 //  Version: 0.1.0
 //
 //  Consumes:
+//    - application/octet-stream
 //    - application/json
 //
 //  Produces:
+//    - application/octet-stream
+//    - image/gif
 //    - image/jpeg
 //    - image/png
+//    - image/webp
+//    - application/json
 //
 // swagger:meta
 package restapi
@@ -18,10 +18,6 @@ var (
 
 func init() {
 	SwaggerJSON = json.RawMessage([]byte(`{
-  "produces": [
-    "image/png",
-    "image/jpeg"
-  ],
   "swagger": "2.0",
   "info": {
     "description": "Microservice for managing file.",
@@ -32,6 +28,20 @@ func init() {
   "paths": {
     "/file": {
       "get": {
+        "security": [
+          {
+            "cookieKey": []
+          },
+          {}
+        ],
+        "description": "Download file, Content-Type is detected by file content on upload.\nPublic files are served without session, private and shared files to owner and users it is shared with.\nAny file is served without session by URL signed by file service until it expires.\nFile stored in gzip is served with Content-Encoding gzip if client accepts it and doesn't request ranges.\nQuarantined file isn't served until it is scanned, infected file is never served.\n",
+        "produces": [
+          "application/octet-stream",
+          "image/png",
+          "image/jpeg",
+          "image/gif",
+          "image/webp"
+        ],
         "operationId": "getFile",
         "parameters": [
           {
@@ -40,6 +50,59 @@ func init() {
             "name": "id",
             "in": "query",
             "required": true
+          },
+          {
+            "enum": [
+              64,
+              256,
+              1024
+            ],
+            "type": "integer",
+            "format": "int32",
+            "description": "Width of resized image variant, made on first request. Images are never upscaled.",
+            "name": "w",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry of signed URL, Unix time in seconds.",
+            "name": "expires",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Content-Disposition header value of signed URL.",
+            "name": "disposition",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Signature of signed URL, session and w are ignored if set.",
+            "name": "sig",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Byte ranges of file, single or multiple (RFC 7233).",
+            "name": "Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "ETag or Last-Modified value, Range is ignored when the file was changed.",
+            "name": "If-Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "If-Modified-Since",
+            "in": "header"
           }
         ],
         "responses": {
@@ -47,6 +110,385 @@ func init() {
             "description": "download file",
             "schema": {
               "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string"
+              },
+              "Content-Disposition": {
+                "type": "string",
+                "description": "Set by signed URL with disposition."
+              },
+              "Content-Encoding": {
+                "type": "string",
+                "description": "Set to gzip when file is served as stored."
+              },
+              "Content-Type": {
+                "type": "string",
+                "description": "MIME type detected by file content on upload."
+              },
+              "Digest": {
+                "type": "string",
+                "description": "SHA-256 of full file content (RFC 3230), e.g. sha-256=base64."
+              },
+              "ETag": {
+                "type": "string"
+              },
+              "Last-Modified": {
+                "type": "string"
+              },
+              "Vary": {
+                "type": "string"
+              }
+            }
+          },
+          "206": {
+            "description": "Partial file content. Multiple ranges are returned as multipart/byteranges.",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string"
+              },
+              "Content-Type": {
+                "type": "string"
+              },
+              "Digest": {
+                "type": "string",
+                "description": "SHA-256 of full file content (RFC 3230), e.g. sha-256=base64."
+              },
+              "ETag": {
+                "type": "string"
+              },
+              "Last-Modified": {
+                "type": "string"
+              }
+            }
+          },
+          "304": {
+            "description": "The file was not modified."
+          },
+          "416": {
+            "description": "Requested range not satisfiable.",
+            "headers": {
+              "Content-Range": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/file/access": {
+      "put": {
+        "description": "Set file visibility and users it is shared with, only owner can set it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "setFileAccess",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "access",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Access"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/files": {
+      "get": {
+        "description": "List user's files with filters, sorting and cursor pagination. Derived files are skipped.",
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "listFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Cursor returned with previous page.",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "minSize",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "maxSize",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Files created at or after time.",
+            "name": "createdAfter",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Files created before time.",
+            "name": "createdBefore",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "MIME type, e.g. image/png or image/*.",
+            "name": "contentType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "JSON object, files which metadata contains it are returned.",
+            "name": "metadata",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "createdAt",
+              "size"
+            ],
+            "type": "string",
+            "default": "createdAt",
+            "name": "sortBy",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "name": "order",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Page of files.",
+            "schema": {
+              "$ref": "#/definitions/FileList"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/uploads": {
+      "post": {
+        "description": "Create resumable upload session.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "createUpload",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "size": {
+                  "description": "Declared size of whole file, 0 if unknown.",
+                  "type": "integer",
+                  "format": "int64"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created upload session.",
+            "schema": {
+              "$ref": "#/definitions/UploadSession"
+            },
+            "headers": {
+              "Location": {
+                "type": "string"
+              },
+              "Upload-Offset": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/uploads/{id}": {
+      "get": {
+        "description": "Get upload session with received parts.",
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getUpload",
+        "responses": {
+          "200": {
+            "description": "Upload session.",
+            "schema": {
+              "$ref": "#/definitions/UploadSession"
+            },
+            "headers": {
+              "Upload-Length": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "Upload-Offset": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      },
+      "delete": {
+        "description": "Abort upload session and remove received parts.",
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "abortUpload",
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/uploads/{id}/complete": {
+      "post": {
+        "description": "Complete upload session and build file from received parts.\nInfected file is rejected, file is quarantined if it can't be scanned yet.\n",
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "completeUpload",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Uploaded file.",
+            "schema": {
+              "$ref": "#/definitions/UploadedFile"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/uploads/{id}/parts/{number}": {
+      "put": {
+        "description": "Upload part of upload session, re-uploading part replaces its content.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "uploadPart",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "part",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Received part.",
+            "schema": {
+              "$ref": "#/definitions/Part"
             }
           },
           "default": {
@@ -57,6 +499,25 @@ func init() {
     }
   },
   "definitions": {
+    "Access": {
+      "type": "object",
+      "required": [
+        "visibility"
+      ],
+      "properties": {
+        "sharedWith": {
+          "description": "Users who can read file with shared visibility.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "visibility": {
+          "$ref": "#/definitions/Visibility"
+        }
+      }
+    },
     "Error": {
       "type": "object",
       "required": [
@@ -67,6 +528,175 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "FileInfo": {
+      "type": "object",
+      "required": [
+        "id",
+        "size",
+        "digest",
+        "contentType",
+        "createdAt",
+        "updatedAt"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/Access"
+        },
+        "contentType": {
+          "description": "MIME type detected by file content.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "digest": {
+          "description": "Hex encoded SHA-256 of file content.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "metadata": {
+          "type": "object"
+        },
+        "owner": {
+          "description": "Principal who uploaded file, e.g. user:\u003cid\u003e or service:\u003cname\u003e.",
+          "type": "string"
+        },
+        "scanStatus": {
+          "$ref": "#/definitions/ScanStatus"
+        },
+        "scanThreat": {
+          "description": "Name of malware found in infected file.",
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "FileList": {
+      "type": "object",
+      "required": [
+        "files"
+      ],
+      "properties": {
+        "files": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FileInfo"
+          }
+        },
+        "nextCursor": {
+          "description": "Cursor of next page, absent for last page.",
+          "type": "string"
+        }
+      }
+    },
+    "Part": {
+      "type": "object",
+      "required": [
+        "number",
+        "size"
+      ],
+      "properties": {
+        "number": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ScanStatus": {
+      "description": "State of scanning file content for malware, file is downloaded only if it is clean or was uploaded without scanning.\nPending file is quarantined until it is scanned.\n",
+      "type": "string",
+      "enum": [
+        "skipped",
+        "pending",
+        "clean",
+        "infected"
+      ]
+    },
+    "UploadSession": {
+      "type": "object",
+      "required": [
+        "id",
+        "size",
+        "offset",
+        "parts"
+      ],
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "offset": {
+          "description": "Amount of bytes received without gaps from the start of file.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "parts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Part"
+          }
+        },
+        "size": {
+          "description": "Declared size of whole file, 0 if unknown.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "UploadedFile": {
+      "type": "object",
+      "required": [
+        "id",
+        "size",
+        "digest"
+      ],
+      "properties": {
+        "contentType": {
+          "description": "MIME type detected by file content.",
+          "type": "string"
+        },
+        "digest": {
+          "description": "Hex encoded SHA-256 of file content.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "scanStatus": {
+          "$ref": "#/definitions/ScanStatus"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Visibility": {
+      "description": "Who can read file besides owner.",
+      "type": "string",
+      "enum": [
+        "private",
+        "public",
+        "shared"
+      ]
     }
   },
   "responses": {
@@ -79,13 +709,22 @@ func init() {
     "NoContent": {
       "description": "The server successfully processed the request and is not returning any content."
     }
-  }
+  },
+  "securityDefinitions": {
+    "cookieKey": {
+      "description": "Session auth inside cookie.",
+      "type": "apiKey",
+      "name": "Cookie",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "cookieKey": []
+    }
+  ]
 }`))
 	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "produces": [
-    "image/jpeg",
-    "image/png"
-  ],
   "swagger": "2.0",
   "info": {
     "description": "Microservice for managing file.",
@@ -96,6 +735,20 @@ func init() {
   "paths": {
     "/file": {
       "get": {
+        "security": [
+          {
+            "cookieKey": []
+          },
+          {}
+        ],
+        "description": "Download file, Content-Type is detected by file content on upload.\nPublic files are served without session, private and shared files to owner and users it is shared with.\nAny file is served without session by URL signed by file service until it expires.\nFile stored in gzip is served with Content-Encoding gzip if client accepts it and doesn't request ranges.\nQuarantined file isn't served until it is scanned, infected file is never served.\n",
+        "produces": [
+          "application/octet-stream",
+          "image/gif",
+          "image/jpeg",
+          "image/png",
+          "image/webp"
+        ],
         "operationId": "getFile",
         "parameters": [
           {
@@ -104,6 +757,59 @@ func init() {
             "name": "id",
             "in": "query",
             "required": true
+          },
+          {
+            "enum": [
+              64,
+              256,
+              1024
+            ],
+            "type": "integer",
+            "format": "int32",
+            "description": "Width of resized image variant, made on first request. Images are never upscaled.",
+            "name": "w",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry of signed URL, Unix time in seconds.",
+            "name": "expires",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Content-Disposition header value of signed URL.",
+            "name": "disposition",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Signature of signed URL, session and w are ignored if set.",
+            "name": "sig",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Byte ranges of file, single or multiple (RFC 7233).",
+            "name": "Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "ETag or Last-Modified value, Range is ignored when the file was changed.",
+            "name": "If-Range",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "name": "If-Modified-Since",
+            "in": "header"
           }
         ],
         "responses": {
@@ -111,6 +817,409 @@ func init() {
             "description": "download file",
             "schema": {
               "type": "file"
+            },
+            "headers": {
+              "Accept-Ranges": {
+                "type": "string"
+              },
+              "Content-Disposition": {
+                "type": "string",
+                "description": "Set by signed URL with disposition."
+              },
+              "Content-Encoding": {
+                "type": "string",
+                "description": "Set to gzip when file is served as stored."
+              },
+              "Content-Type": {
+                "type": "string",
+                "description": "MIME type detected by file content on upload."
+              },
+              "Digest": {
+                "type": "string",
+                "description": "SHA-256 of full file content (RFC 3230), e.g. sha-256=base64."
+              },
+              "ETag": {
+                "type": "string"
+              },
+              "Last-Modified": {
+                "type": "string"
+              },
+              "Vary": {
+                "type": "string"
+              }
+            }
+          },
+          "206": {
+            "description": "Partial file content. Multiple ranges are returned as multipart/byteranges.",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Range": {
+                "type": "string"
+              },
+              "Content-Type": {
+                "type": "string"
+              },
+              "Digest": {
+                "type": "string",
+                "description": "SHA-256 of full file content (RFC 3230), e.g. sha-256=base64."
+              },
+              "ETag": {
+                "type": "string"
+              },
+              "Last-Modified": {
+                "type": "string"
+              }
+            }
+          },
+          "304": {
+            "description": "The file was not modified."
+          },
+          "416": {
+            "description": "Requested range not satisfiable.",
+            "headers": {
+              "Content-Range": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/file/access": {
+      "put": {
+        "description": "Set file visibility and users it is shared with, only owner can set it.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "setFileAccess",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "access",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Access"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/files": {
+      "get": {
+        "description": "List user's files with filters, sorting and cursor pagination. Derived files are skipped.",
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "listFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Cursor returned with previous page.",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "name": "minSize",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "name": "maxSize",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Files created at or after time.",
+            "name": "createdAfter",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Files created before time.",
+            "name": "createdBefore",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "MIME type, e.g. image/png or image/*.",
+            "name": "contentType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "JSON object, files which metadata contains it are returned.",
+            "name": "metadata",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "createdAt",
+              "size"
+            ],
+            "type": "string",
+            "default": "createdAt",
+            "name": "sortBy",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "name": "order",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Page of files.",
+            "schema": {
+              "$ref": "#/definitions/FileList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/uploads": {
+      "post": {
+        "description": "Create resumable upload session.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "createUpload",
+        "parameters": [
+          {
+            "name": "args",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "size": {
+                  "description": "Declared size of whole file, 0 if unknown.",
+                  "type": "integer",
+                  "format": "int64",
+                  "minimum": 0
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created upload session.",
+            "schema": {
+              "$ref": "#/definitions/UploadSession"
+            },
+            "headers": {
+              "Location": {
+                "type": "string"
+              },
+              "Upload-Offset": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/uploads/{id}": {
+      "get": {
+        "description": "Get upload session with received parts.",
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getUpload",
+        "responses": {
+          "200": {
+            "description": "Upload session.",
+            "schema": {
+              "$ref": "#/definitions/UploadSession"
+            },
+            "headers": {
+              "Upload-Length": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "Upload-Offset": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Abort upload session and remove received parts.",
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "abortUpload",
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/uploads/{id}/complete": {
+      "post": {
+        "description": "Complete upload session and build file from received parts.\nInfected file is rejected, file is quarantined if it can't be scanned yet.\n",
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "completeUpload",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Uploaded file.",
+            "schema": {
+              "$ref": "#/definitions/UploadedFile"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/uploads/{id}/parts/{number}": {
+      "put": {
+        "description": "Upload part of upload session, re-uploading part replaces its content.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "uploadPart",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "part",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Received part.",
+            "schema": {
+              "$ref": "#/definitions/Part"
             }
           },
           "default": {
@@ -124,6 +1233,25 @@ func init() {
     }
   },
   "definitions": {
+    "Access": {
+      "type": "object",
+      "required": [
+        "visibility"
+      ],
+      "properties": {
+        "sharedWith": {
+          "description": "Users who can read file with shared visibility.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "visibility": {
+          "$ref": "#/definitions/Visibility"
+        }
+      }
+    },
     "Error": {
       "type": "object",
       "required": [
@@ -134,6 +1262,175 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "FileInfo": {
+      "type": "object",
+      "required": [
+        "id",
+        "size",
+        "digest",
+        "contentType",
+        "createdAt",
+        "updatedAt"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/Access"
+        },
+        "contentType": {
+          "description": "MIME type detected by file content.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "digest": {
+          "description": "Hex encoded SHA-256 of file content.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "metadata": {
+          "type": "object"
+        },
+        "owner": {
+          "description": "Principal who uploaded file, e.g. user:\u003cid\u003e or service:\u003cname\u003e.",
+          "type": "string"
+        },
+        "scanStatus": {
+          "$ref": "#/definitions/ScanStatus"
+        },
+        "scanThreat": {
+          "description": "Name of malware found in infected file.",
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "FileList": {
+      "type": "object",
+      "required": [
+        "files"
+      ],
+      "properties": {
+        "files": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FileInfo"
+          }
+        },
+        "nextCursor": {
+          "description": "Cursor of next page, absent for last page.",
+          "type": "string"
+        }
+      }
+    },
+    "Part": {
+      "type": "object",
+      "required": [
+        "number",
+        "size"
+      ],
+      "properties": {
+        "number": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ScanStatus": {
+      "description": "State of scanning file content for malware, file is downloaded only if it is clean or was uploaded without scanning.\nPending file is quarantined until it is scanned.\n",
+      "type": "string",
+      "enum": [
+        "skipped",
+        "pending",
+        "clean",
+        "infected"
+      ]
+    },
+    "UploadSession": {
+      "type": "object",
+      "required": [
+        "id",
+        "size",
+        "offset",
+        "parts"
+      ],
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "offset": {
+          "description": "Amount of bytes received without gaps from the start of file.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "parts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Part"
+          }
+        },
+        "size": {
+          "description": "Declared size of whole file, 0 if unknown.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "UploadedFile": {
+      "type": "object",
+      "required": [
+        "id",
+        "size",
+        "digest"
+      ],
+      "properties": {
+        "contentType": {
+          "description": "MIME type detected by file content.",
+          "type": "string"
+        },
+        "digest": {
+          "description": "Hex encoded SHA-256 of file content.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "scanStatus": {
+          "$ref": "#/definitions/ScanStatus"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Visibility": {
+      "description": "Who can read file besides owner.",
+      "type": "string",
+      "enum": [
+        "private",
+        "public",
+        "shared"
+      ]
     }
   },
   "responses": {
@@ -146,6 +1443,19 @@ func init() {
     "NoContent": {
       "description": "The server successfully processed the request and is not returning any content."
     }
-  }
+  },
+  "securityDefinitions": {
+    "cookieKey": {
+      "description": "Session auth inside cookie.",
+      "type": "apiKey",
+      "name": "Cookie",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "cookieKey": []
+    }
+  ]
 }`))
 }
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// AbortUploadHandlerFunc turns a function with the right signature into a abort upload handler
+type AbortUploadHandlerFunc func(AbortUploadParams, *app.Session) AbortUploadResponder
+
+// Handle executing the request and returning a response
+func (fn AbortUploadHandlerFunc) Handle(params AbortUploadParams, principal *app.Session) AbortUploadResponder {
+	return fn(params, principal)
+}
+
+// AbortUploadHandler interface for that can handle valid abort upload params
+type AbortUploadHandler interface {
+	Handle(AbortUploadParams, *app.Session) AbortUploadResponder
+}
+
+// NewAbortUpload creates a new http.Handler for the abort upload operation
+func NewAbortUpload(ctx *middleware.Context, handler AbortUploadHandler) *AbortUpload {
+	return &AbortUpload{Context: ctx, Handler: handler}
+}
+
+/* AbortUpload swagger:route DELETE /uploads/{id} abortUpload
+
+Abort upload session and remove received parts.
+
+*/
+type AbortUpload struct {
+	Context *middleware.Context
+	Handler AbortUploadHandler
+}
+
+func (o *AbortUpload) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAbortUploadParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
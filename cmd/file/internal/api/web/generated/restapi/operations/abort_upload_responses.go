@@ -0,0 +1,120 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// AbortUploadNoContentCode is the HTTP code returned for type AbortUploadNoContent
+const AbortUploadNoContentCode int = 204
+
+/*AbortUploadNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response abortUploadNoContent
+*/
+type AbortUploadNoContent struct {
+}
+
+// NewAbortUploadNoContent creates AbortUploadNoContent with default headers values
+func NewAbortUploadNoContent() *AbortUploadNoContent {
+
+	return &AbortUploadNoContent{}
+}
+
+// WriteResponse to the client
+func (o *AbortUploadNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+func (o *AbortUploadNoContent) AbortUploadResponder() {}
+
+/*AbortUploadDefault Generic error response.
+
+swagger:response abortUploadDefault
+*/
+type AbortUploadDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAbortUploadDefault creates AbortUploadDefault with default headers values
+func NewAbortUploadDefault(code int) *AbortUploadDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AbortUploadDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the abort upload default response
+func (o *AbortUploadDefault) WithStatusCode(code int) *AbortUploadDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the abort upload default response
+func (o *AbortUploadDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the abort upload default response
+func (o *AbortUploadDefault) WithPayload(payload *models.Error) *AbortUploadDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the abort upload default response
+func (o *AbortUploadDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AbortUploadDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *AbortUploadDefault) AbortUploadResponder() {}
+
+type AbortUploadNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*AbortUploadNotImplementedResponder) AbortUploadResponder() {}
+
+func AbortUploadNotImplemented() AbortUploadResponder {
+	return &AbortUploadNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.AbortUpload has not yet been implemented",
+		),
+	}
+}
+
+type AbortUploadResponder interface {
+	middleware.Responder
+	AbortUploadResponder()
+}
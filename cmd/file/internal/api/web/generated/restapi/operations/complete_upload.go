@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// CompleteUploadHandlerFunc turns a function with the right signature into a complete upload handler
+type CompleteUploadHandlerFunc func(CompleteUploadParams, *app.Session) CompleteUploadResponder
+
+// Handle executing the request and returning a response
+func (fn CompleteUploadHandlerFunc) Handle(params CompleteUploadParams, principal *app.Session) CompleteUploadResponder {
+	return fn(params, principal)
+}
+
+// CompleteUploadHandler interface for that can handle valid complete upload params
+type CompleteUploadHandler interface {
+	Handle(CompleteUploadParams, *app.Session) CompleteUploadResponder
+}
+
+// NewCompleteUpload creates a new http.Handler for the complete upload operation
+func NewCompleteUpload(ctx *middleware.Context, handler CompleteUploadHandler) *CompleteUpload {
+	return &CompleteUpload{Context: ctx, Handler: handler}
+}
+
+/* CompleteUpload swagger:route POST /uploads/{id}/complete completeUpload
+
+Complete upload session and build file from received parts.
+Infected file is rejected, file is quarantined if it can't be scanned yet.
+
+
+*/
+type CompleteUpload struct {
+	Context *middleware.Context
+	Handler CompleteUploadHandler
+}
+
+func (o *CompleteUpload) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCompleteUploadParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,91 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewCompleteUploadParams creates a new CompleteUploadParams object
+//
+// There are no default values defined in the spec.
+func NewCompleteUploadParams() CompleteUploadParams {
+
+	return CompleteUploadParams{}
+}
+
+// CompleteUploadParams contains all the bound params for the complete upload operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters completeUpload
+type CompleteUploadParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	ID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewCompleteUploadParams() beforehand.
+func (o *CompleteUploadParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *CompleteUploadParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("id", "path", "strfmt.UUID", raw)
+	}
+	o.ID = *(value.(*strfmt.UUID))
+
+	if err := o.validateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateID carries on validations for parameter ID
+func (o *CompleteUploadParams) validateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("id", "path", "uuid", o.ID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
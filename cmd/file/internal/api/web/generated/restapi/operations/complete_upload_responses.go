@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// CompleteUploadOKCode is the HTTP code returned for type CompleteUploadOK
+const CompleteUploadOKCode int = 200
+
+/*CompleteUploadOK Uploaded file.
+
+swagger:response completeUploadOK
+*/
+type CompleteUploadOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.UploadedFile `json:"body,omitempty"`
+}
+
+// NewCompleteUploadOK creates CompleteUploadOK with default headers values
+func NewCompleteUploadOK() *CompleteUploadOK {
+
+	return &CompleteUploadOK{}
+}
+
+// WithPayload adds the payload to the complete upload o k response
+func (o *CompleteUploadOK) WithPayload(payload *models.UploadedFile) *CompleteUploadOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the complete upload o k response
+func (o *CompleteUploadOK) SetPayload(payload *models.UploadedFile) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CompleteUploadOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *CompleteUploadOK) CompleteUploadResponder() {}
+
+/*CompleteUploadDefault Generic error response.
+
+swagger:response completeUploadDefault
+*/
+type CompleteUploadDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCompleteUploadDefault creates CompleteUploadDefault with default headers values
+func NewCompleteUploadDefault(code int) *CompleteUploadDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CompleteUploadDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the complete upload default response
+func (o *CompleteUploadDefault) WithStatusCode(code int) *CompleteUploadDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the complete upload default response
+func (o *CompleteUploadDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the complete upload default response
+func (o *CompleteUploadDefault) WithPayload(payload *models.Error) *CompleteUploadDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the complete upload default response
+func (o *CompleteUploadDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CompleteUploadDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *CompleteUploadDefault) CompleteUploadResponder() {}
+
+type CompleteUploadNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*CompleteUploadNotImplementedResponder) CompleteUploadResponder() {}
+
+func CompleteUploadNotImplemented() CompleteUploadResponder {
+	return &CompleteUploadNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.CompleteUpload has not yet been implemented",
+		),
+	}
+}
+
+type CompleteUploadResponder interface {
+	middleware.Responder
+	CompleteUploadResponder()
+}
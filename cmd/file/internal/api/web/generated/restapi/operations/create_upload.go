@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// CreateUploadHandlerFunc turns a function with the right signature into a create upload handler
+type CreateUploadHandlerFunc func(CreateUploadParams, *app.Session) CreateUploadResponder
+
+// Handle executing the request and returning a response
+func (fn CreateUploadHandlerFunc) Handle(params CreateUploadParams, principal *app.Session) CreateUploadResponder {
+	return fn(params, principal)
+}
+
+// CreateUploadHandler interface for that can handle valid create upload params
+type CreateUploadHandler interface {
+	Handle(CreateUploadParams, *app.Session) CreateUploadResponder
+}
+
+// NewCreateUpload creates a new http.Handler for the create upload operation
+func NewCreateUpload(ctx *middleware.Context, handler CreateUploadHandler) *CreateUpload {
+	return &CreateUpload{Context: ctx, Handler: handler}
+}
+
+/* CreateUpload swagger:route POST /uploads createUpload
+
+Create resumable upload session.
+
+*/
+type CreateUpload struct {
+	Context *middleware.Context
+	Handler CreateUploadHandler
+}
+
+func (o *CreateUpload) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateUploadParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// CreateUploadBody create upload body
+//
+// swagger:model CreateUploadBody
+type CreateUploadBody struct {
+
+	// Declared size of whole file, 0 if unknown.
+	// Minimum: 0
+	Size *int64 `json:"size,omitempty"`
+}
+
+// Validate validates this create upload body
+func (o *CreateUploadBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateUploadBody) validateSize(formats strfmt.Registry) error {
+	if swag.IsZero(o.Size) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("args"+"."+"size", "body", *o.Size, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this create upload body based on context it is used
+func (o *CreateUploadBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateUploadBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateUploadBody) UnmarshalBinary(b []byte) error {
+	var res CreateUploadBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,185 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// CreateUploadCreatedCode is the HTTP code returned for type CreateUploadCreated
+const CreateUploadCreatedCode int = 201
+
+/*CreateUploadCreated Created upload session.
+
+swagger:response createUploadCreated
+*/
+type CreateUploadCreated struct {
+	/*
+
+	 */
+	Location string `json:"Location"`
+	/*
+
+	 */
+	UploadOffset int64 `json:"Upload-Offset"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.UploadSession `json:"body,omitempty"`
+}
+
+// NewCreateUploadCreated creates CreateUploadCreated with default headers values
+func NewCreateUploadCreated() *CreateUploadCreated {
+
+	return &CreateUploadCreated{}
+}
+
+// WithLocation adds the location to the create upload created response
+func (o *CreateUploadCreated) WithLocation(location string) *CreateUploadCreated {
+	o.Location = location
+	return o
+}
+
+// SetLocation sets the location to the create upload created response
+func (o *CreateUploadCreated) SetLocation(location string) {
+	o.Location = location
+}
+
+// WithUploadOffset adds the uploadOffset to the create upload created response
+func (o *CreateUploadCreated) WithUploadOffset(uploadOffset int64) *CreateUploadCreated {
+	o.UploadOffset = uploadOffset
+	return o
+}
+
+// SetUploadOffset sets the uploadOffset to the create upload created response
+func (o *CreateUploadCreated) SetUploadOffset(uploadOffset int64) {
+	o.UploadOffset = uploadOffset
+}
+
+// WithPayload adds the payload to the create upload created response
+func (o *CreateUploadCreated) WithPayload(payload *models.UploadSession) *CreateUploadCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create upload created response
+func (o *CreateUploadCreated) SetPayload(payload *models.UploadSession) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUploadCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Location
+
+	location := o.Location
+	if location != "" {
+		rw.Header().Set("Location", location)
+	}
+
+	// response header Upload-Offset
+
+	uploadOffset := swag.FormatInt64(o.UploadOffset)
+	if uploadOffset != "" {
+		rw.Header().Set("Upload-Offset", uploadOffset)
+	}
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *CreateUploadCreated) CreateUploadResponder() {}
+
+/*CreateUploadDefault Generic error response.
+
+swagger:response createUploadDefault
+*/
+type CreateUploadDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateUploadDefault creates CreateUploadDefault with default headers values
+func NewCreateUploadDefault(code int) *CreateUploadDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateUploadDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create upload default response
+func (o *CreateUploadDefault) WithStatusCode(code int) *CreateUploadDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create upload default response
+func (o *CreateUploadDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create upload default response
+func (o *CreateUploadDefault) WithPayload(payload *models.Error) *CreateUploadDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create upload default response
+func (o *CreateUploadDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUploadDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *CreateUploadDefault) CreateUploadResponder() {}
+
+type CreateUploadNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*CreateUploadNotImplementedResponder) CreateUploadResponder() {}
+
+func CreateUploadNotImplemented() CreateUploadResponder {
+	return &CreateUploadNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.CreateUpload has not yet been implemented",
+		),
+	}
+}
+
+type CreateUploadResponder interface {
+	middleware.Responder
+	CreateUploadResponder()
+}
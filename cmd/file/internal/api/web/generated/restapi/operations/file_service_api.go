@@ -18,6 +18,8 @@ import (
 	"github.com/go-openapi/spec"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
 )
 
 // NewFileServiceAPI creates a new FileService instance
@@ -38,13 +40,43 @@ func NewFileServiceAPI(spec *loads.Document) *FileServiceAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
+		BinConsumer:  runtime.ByteStreamConsumer(),
 		JSONConsumer: runtime.JSONConsumer(),
 
-		BinProducer: runtime.ByteStreamProducer(),
+		BinProducer:  runtime.ByteStreamProducer(),
+		JSONProducer: runtime.JSONProducer(),
 
-		GetFileHandler: GetFileHandlerFunc(func(params GetFileParams) GetFileResponder {
+		AbortUploadHandler: AbortUploadHandlerFunc(func(params AbortUploadParams, principal *app.Session) AbortUploadResponder {
+			return AbortUploadNotImplemented()
+		}),
+		CompleteUploadHandler: CompleteUploadHandlerFunc(func(params CompleteUploadParams, principal *app.Session) CompleteUploadResponder {
+			return CompleteUploadNotImplemented()
+		}),
+		CreateUploadHandler: CreateUploadHandlerFunc(func(params CreateUploadParams, principal *app.Session) CreateUploadResponder {
+			return CreateUploadNotImplemented()
+		}),
+		GetFileHandler: GetFileHandlerFunc(func(params GetFileParams, principal *app.Session) GetFileResponder {
 			return GetFileNotImplemented()
 		}),
+		GetUploadHandler: GetUploadHandlerFunc(func(params GetUploadParams, principal *app.Session) GetUploadResponder {
+			return GetUploadNotImplemented()
+		}),
+		ListFilesHandler: ListFilesHandlerFunc(func(params ListFilesParams, principal *app.Session) ListFilesResponder {
+			return ListFilesNotImplemented()
+		}),
+		SetFileAccessHandler: SetFileAccessHandlerFunc(func(params SetFileAccessParams, principal *app.Session) SetFileAccessResponder {
+			return SetFileAccessNotImplemented()
+		}),
+		UploadPartHandler: UploadPartHandlerFunc(func(params UploadPartParams, principal *app.Session) UploadPartResponder {
+			return UploadPartNotImplemented()
+		}),
+
+		// Applies when the "Cookie" header is set
+		CookieKeyAuth: func(token string) (*app.Session, error) {
+			return nil, errors.NotImplemented("api key auth (cookieKey) Cookie from header param [Cookie] has not yet been implemented")
+		},
+		// default authorizer is authorized meaning no requests are blocked
+		APIAuthorizer: security.Authorized(),
 	}
 }
 
@@ -73,17 +105,47 @@ type FileServiceAPI struct {
 	// It has a default implementation in the security package, however you can replace it for your particular usage.
 	BearerAuthenticator func(string, security.ScopedTokenAuthentication) runtime.Authenticator
 
+	// BinConsumer registers a consumer for the following mime types:
+	//   - application/octet-stream
+	BinConsumer runtime.Consumer
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
 
 	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	//   - image/gif
 	//   - image/jpeg
 	//   - image/png
+	//   - image/webp
 	BinProducer runtime.Producer
+	// JSONProducer registers a producer for the following mime types:
+	//   - application/json
+	JSONProducer runtime.Producer
+
+	// CookieKeyAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key Cookie provided in the header
+	CookieKeyAuth func(string) (*app.Session, error)
 
+	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
+	APIAuthorizer runtime.Authorizer
+
+	// AbortUploadHandler sets the operation handler for the abort upload operation
+	AbortUploadHandler AbortUploadHandler
+	// CompleteUploadHandler sets the operation handler for the complete upload operation
+	CompleteUploadHandler CompleteUploadHandler
+	// CreateUploadHandler sets the operation handler for the create upload operation
+	CreateUploadHandler CreateUploadHandler
 	// GetFileHandler sets the operation handler for the get file operation
 	GetFileHandler GetFileHandler
+	// GetUploadHandler sets the operation handler for the get upload operation
+	GetUploadHandler GetUploadHandler
+	// ListFilesHandler sets the operation handler for the list files operation
+	ListFilesHandler ListFilesHandler
+	// SetFileAccessHandler sets the operation handler for the set file access operation
+	SetFileAccessHandler SetFileAccessHandler
+	// UploadPartHandler sets the operation handler for the upload part operation
+	UploadPartHandler UploadPartHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -153,6 +215,9 @@ func (o *FileServiceAPI) RegisterFormat(name string, format strfmt.Format, valid
 func (o *FileServiceAPI) Validate() error {
 	var unregistered []string
 
+	if o.BinConsumer == nil {
+		unregistered = append(unregistered, "BinConsumer")
+	}
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
@@ -160,10 +225,38 @@ func (o *FileServiceAPI) Validate() error {
 	if o.BinProducer == nil {
 		unregistered = append(unregistered, "BinProducer")
 	}
+	if o.JSONProducer == nil {
+		unregistered = append(unregistered, "JSONProducer")
+	}
+
+	if o.CookieKeyAuth == nil {
+		unregistered = append(unregistered, "CookieAuth")
+	}
 
+	if o.AbortUploadHandler == nil {
+		unregistered = append(unregistered, "AbortUploadHandler")
+	}
+	if o.CompleteUploadHandler == nil {
+		unregistered = append(unregistered, "CompleteUploadHandler")
+	}
+	if o.CreateUploadHandler == nil {
+		unregistered = append(unregistered, "CreateUploadHandler")
+	}
 	if o.GetFileHandler == nil {
 		unregistered = append(unregistered, "GetFileHandler")
 	}
+	if o.GetUploadHandler == nil {
+		unregistered = append(unregistered, "GetUploadHandler")
+	}
+	if o.ListFilesHandler == nil {
+		unregistered = append(unregistered, "ListFilesHandler")
+	}
+	if o.SetFileAccessHandler == nil {
+		unregistered = append(unregistered, "SetFileAccessHandler")
+	}
+	if o.UploadPartHandler == nil {
+		unregistered = append(unregistered, "UploadPartHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -179,12 +272,23 @@ func (o *FileServiceAPI) ServeErrorFor(operationID string) func(http.ResponseWri
 
 // AuthenticatorsFor gets the authenticators for the specified security schemes
 func (o *FileServiceAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
-	return nil
+	result := make(map[string]runtime.Authenticator)
+	for name := range schemes {
+		switch name {
+		case "cookieKey":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, func(token string) (interface{}, error) {
+				return o.CookieKeyAuth(token)
+			})
+
+		}
+	}
+	return result
 }
 
 // Authorizer returns the registered authorizer
 func (o *FileServiceAPI) Authorizer() runtime.Authorizer {
-	return nil
+	return o.APIAuthorizer
 }
 
 // ConsumersFor gets the consumers for the specified media types.
@@ -193,6 +297,8 @@ func (o *FileServiceAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Co
 	result := make(map[string]runtime.Consumer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinConsumer
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
 		}
@@ -210,10 +316,18 @@ func (o *FileServiceAPI) ProducersFor(mediaTypes []string) map[string]runtime.Pr
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
+		case "image/gif":
+			result["image/gif"] = o.BinProducer
 		case "image/jpeg":
 			result["image/jpeg"] = o.BinProducer
 		case "image/png":
 			result["image/png"] = o.BinProducer
+		case "image/webp":
+			result["image/webp"] = o.BinProducer
+		case "application/json":
+			result["application/json"] = o.JSONProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -254,10 +368,38 @@ func (o *FileServiceAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/uploads/{id}"] = NewAbortUpload(o.context, o.AbortUploadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/uploads/{id}/complete"] = NewCompleteUpload(o.context, o.CompleteUploadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/uploads"] = NewCreateUpload(o.context, o.CreateUploadHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/file"] = NewGetFile(o.context, o.GetFileHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/uploads/{id}"] = NewGetUpload(o.context, o.GetUploadHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/files"] = NewListFiles(o.context, o.ListFilesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/file/access"] = NewSetFileAccess(o.context, o.SetFileAccessHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/uploads/{id}/parts/{number}"] = NewUploadPart(o.context, o.UploadPartHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -9,19 +9,21 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
 )
 
 // GetFileHandlerFunc turns a function with the right signature into a get file handler
-type GetFileHandlerFunc func(GetFileParams) GetFileResponder
+type GetFileHandlerFunc func(GetFileParams, *app.Session) GetFileResponder
 
 // Handle executing the request and returning a response
-func (fn GetFileHandlerFunc) Handle(params GetFileParams) GetFileResponder {
-	return fn(params)
+func (fn GetFileHandlerFunc) Handle(params GetFileParams, principal *app.Session) GetFileResponder {
+	return fn(params, principal)
 }
 
 // GetFileHandler interface for that can handle valid get file params
 type GetFileHandler interface {
-	Handle(GetFileParams) GetFileResponder
+	Handle(GetFileParams, *app.Session) GetFileResponder
 }
 
 // NewGetFile creates a new http.Handler for the get file operation
@@ -31,7 +33,12 @@ func NewGetFile(ctx *middleware.Context, handler GetFileHandler) *GetFile {
 
 /* GetFile swagger:route GET /file getFile
 
-GetFile get file API
+Download file, Content-Type is detected by file content on upload.
+Public files are served without session, private and shared files to owner and users it is shared with.
+Any file is served without session by URL signed by file service until it expires.
+File stored in gzip is served with Content-Encoding gzip if client accepts it and doesn't request ranges.
+Quarantined file isn't served until it is scanned, infected file is never served.
+
 
 */
 type GetFile struct {
@@ -45,12 +52,25 @@ func (o *GetFile) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewGetFileParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
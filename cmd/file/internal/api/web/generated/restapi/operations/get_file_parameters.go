@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -32,11 +33,43 @@ type GetFileParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*
+	  In: header
+	*/
+	IfModifiedSince *string
+	/*
+	  In: header
+	*/
+	IfNoneMatch *string
+	/*ETag or Last-Modified value, Range is ignored when the file was changed.
+	  In: header
+	*/
+	IfRange *string
+	/*Byte ranges of file, single or multiple (RFC 7233).
+	  In: header
+	*/
+	Range *string
+	/*Content-Disposition header value of signed URL.
+	  In: query
+	*/
+	Disposition *string
+	/*Expiry of signed URL, Unix time in seconds.
+	  In: query
+	*/
+	Expires *int64
 	/*
 	  Required: true
 	  In: query
 	*/
 	ID strfmt.UUID
+	/*Signature of signed URL, session and w are ignored if set.
+	  In: query
+	*/
+	Sig *string
+	/*Width of resized image variant, made on first request. Images are never upscaled.
+	  In: query
+	*/
+	W *int32
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -50,16 +83,161 @@ func (o *GetFileParams) BindRequest(r *http.Request, route *middleware.MatchedRo
 
 	qs := runtime.Values(r.URL.Query())
 
+	if err := o.bindIfModifiedSince(r.Header[http.CanonicalHeaderKey("If-Modified-Since")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindIfNoneMatch(r.Header[http.CanonicalHeaderKey("If-None-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindIfRange(r.Header[http.CanonicalHeaderKey("If-Range")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindRange(r.Header[http.CanonicalHeaderKey("Range")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDisposition, qhkDisposition, _ := qs.GetOK("disposition")
+	if err := o.bindDisposition(qDisposition, qhkDisposition, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qExpires, qhkExpires, _ := qs.GetOK("expires")
+	if err := o.bindExpires(qExpires, qhkExpires, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qID, qhkID, _ := qs.GetOK("id")
 	if err := o.bindID(qID, qhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qSig, qhkSig, _ := qs.GetOK("sig")
+	if err := o.bindSig(qSig, qhkSig, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qW, qhkW, _ := qs.GetOK("w")
+	if err := o.bindW(qW, qhkW, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindIfModifiedSince binds and validates parameter IfModifiedSince from header.
+func (o *GetFileParams) bindIfModifiedSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfModifiedSince = &raw
+
+	return nil
+}
+
+// bindIfNoneMatch binds and validates parameter IfNoneMatch from header.
+func (o *GetFileParams) bindIfNoneMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfNoneMatch = &raw
+
+	return nil
+}
+
+// bindIfRange binds and validates parameter IfRange from header.
+func (o *GetFileParams) bindIfRange(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfRange = &raw
+
+	return nil
+}
+
+// bindRange binds and validates parameter Range from header.
+func (o *GetFileParams) bindRange(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Range = &raw
+
+	return nil
+}
+
+// bindDisposition binds and validates parameter Disposition from query.
+func (o *GetFileParams) bindDisposition(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Disposition = &raw
+
+	return nil
+}
+
+// bindExpires binds and validates parameter Expires from query.
+func (o *GetFileParams) bindExpires(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("expires", "query", "int64", raw)
+	}
+	o.Expires = &value
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from query.
 func (o *GetFileParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
@@ -99,3 +277,58 @@ func (o *GetFileParams) validateID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindSig binds and validates parameter Sig from query.
+func (o *GetFileParams) bindSig(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Sig = &raw
+
+	return nil
+}
+
+// bindW binds and validates parameter W from query.
+func (o *GetFileParams) bindW(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("w", "query", "int32", raw)
+	}
+	o.W = &value
+
+	if err := o.validateW(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateW carries on validations for parameter W
+func (o *GetFileParams) validateW(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("w", "query", *o.W, []interface{}{64, 256, 1024}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
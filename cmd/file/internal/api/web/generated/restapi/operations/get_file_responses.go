@@ -23,6 +23,38 @@ const GetFileOKCode int = 200
 swagger:response getFileOK
 */
 type GetFileOK struct {
+	/*
+
+	 */
+	AcceptRanges string `json:"Accept-Ranges"`
+	/*Set by signed URL with disposition.
+
+	 */
+	ContentDisposition string `json:"Content-Disposition"`
+	/*Set to gzip when file is served as stored.
+
+	 */
+	ContentEncoding string `json:"Content-Encoding"`
+	/*MIME type detected by file content on upload.
+
+	 */
+	ContentType string `json:"Content-Type"`
+	/*SHA-256 of full file content (RFC 3230), e.g. sha-256=base64.
+
+	 */
+	Digest string `json:"Digest"`
+	/*
+
+	 */
+	ETag string `json:"ETag"`
+	/*
+
+	 */
+	LastModified string `json:"Last-Modified"`
+	/*
+
+	 */
+	Vary string `json:"Vary"`
 
 	/*
 	  In: Body
@@ -36,6 +68,94 @@ func NewGetFileOK() *GetFileOK {
 	return &GetFileOK{}
 }
 
+// WithAcceptRanges adds the acceptRanges to the get file o k response
+func (o *GetFileOK) WithAcceptRanges(acceptRanges string) *GetFileOK {
+	o.AcceptRanges = acceptRanges
+	return o
+}
+
+// SetAcceptRanges sets the acceptRanges to the get file o k response
+func (o *GetFileOK) SetAcceptRanges(acceptRanges string) {
+	o.AcceptRanges = acceptRanges
+}
+
+// WithContentDisposition adds the contentDisposition to the get file o k response
+func (o *GetFileOK) WithContentDisposition(contentDisposition string) *GetFileOK {
+	o.ContentDisposition = contentDisposition
+	return o
+}
+
+// SetContentDisposition sets the contentDisposition to the get file o k response
+func (o *GetFileOK) SetContentDisposition(contentDisposition string) {
+	o.ContentDisposition = contentDisposition
+}
+
+// WithContentEncoding adds the contentEncoding to the get file o k response
+func (o *GetFileOK) WithContentEncoding(contentEncoding string) *GetFileOK {
+	o.ContentEncoding = contentEncoding
+	return o
+}
+
+// SetContentEncoding sets the contentEncoding to the get file o k response
+func (o *GetFileOK) SetContentEncoding(contentEncoding string) {
+	o.ContentEncoding = contentEncoding
+}
+
+// WithContentType adds the contentType to the get file o k response
+func (o *GetFileOK) WithContentType(contentType string) *GetFileOK {
+	o.ContentType = contentType
+	return o
+}
+
+// SetContentType sets the contentType to the get file o k response
+func (o *GetFileOK) SetContentType(contentType string) {
+	o.ContentType = contentType
+}
+
+// WithDigest adds the digest to the get file o k response
+func (o *GetFileOK) WithDigest(digest string) *GetFileOK {
+	o.Digest = digest
+	return o
+}
+
+// SetDigest sets the digest to the get file o k response
+func (o *GetFileOK) SetDigest(digest string) {
+	o.Digest = digest
+}
+
+// WithETag adds the eTag to the get file o k response
+func (o *GetFileOK) WithETag(eTag string) *GetFileOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get file o k response
+func (o *GetFileOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithLastModified adds the lastModified to the get file o k response
+func (o *GetFileOK) WithLastModified(lastModified string) *GetFileOK {
+	o.LastModified = lastModified
+	return o
+}
+
+// SetLastModified sets the lastModified to the get file o k response
+func (o *GetFileOK) SetLastModified(lastModified string) {
+	o.LastModified = lastModified
+}
+
+// WithVary adds the vary to the get file o k response
+func (o *GetFileOK) WithVary(vary string) *GetFileOK {
+	o.Vary = vary
+	return o
+}
+
+// SetVary sets the vary to the get file o k response
+func (o *GetFileOK) SetVary(vary string) {
+	o.Vary = vary
+}
+
 // WithPayload adds the payload to the get file o k response
 func (o *GetFileOK) WithPayload(payload io.ReadCloser) *GetFileOK {
 	o.Payload = payload
@@ -50,6 +170,62 @@ func (o *GetFileOK) SetPayload(payload io.ReadCloser) {
 // WriteResponse to the client
 func (o *GetFileOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Accept-Ranges
+
+	acceptRanges := o.AcceptRanges
+	if acceptRanges != "" {
+		rw.Header().Set("Accept-Ranges", acceptRanges)
+	}
+
+	// response header Content-Disposition
+
+	contentDisposition := o.ContentDisposition
+	if contentDisposition != "" {
+		rw.Header().Set("Content-Disposition", contentDisposition)
+	}
+
+	// response header Content-Encoding
+
+	contentEncoding := o.ContentEncoding
+	if contentEncoding != "" {
+		rw.Header().Set("Content-Encoding", contentEncoding)
+	}
+
+	// response header Content-Type
+
+	contentType := o.ContentType
+	if contentType != "" {
+		rw.Header().Set("Content-Type", contentType)
+	}
+
+	// response header Digest
+
+	digest := o.Digest
+	if digest != "" {
+		rw.Header().Set("Digest", digest)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	// response header Last-Modified
+
+	lastModified := o.LastModified
+	if lastModified != "" {
+		rw.Header().Set("Last-Modified", lastModified)
+	}
+
+	// response header Vary
+
+	vary := o.Vary
+	if vary != "" {
+		rw.Header().Set("Vary", vary)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if err := producer.Produce(rw, payload); err != nil {
@@ -59,6 +235,234 @@ func (o *GetFileOK) WriteResponse(rw http.ResponseWriter, producer runtime.Produ
 
 func (o *GetFileOK) GetFileResponder() {}
 
+// GetFilePartialContentCode is the HTTP code returned for type GetFilePartialContent
+const GetFilePartialContentCode int = 206
+
+/*GetFilePartialContent Partial file content. Multiple ranges are returned as multipart/byteranges.
+
+swagger:response getFilePartialContent
+*/
+type GetFilePartialContent struct {
+	/*
+
+	 */
+	ContentRange string `json:"Content-Range"`
+	/*
+
+	 */
+	ContentType string `json:"Content-Type"`
+	/*SHA-256 of full file content (RFC 3230), e.g. sha-256=base64.
+
+	 */
+	Digest string `json:"Digest"`
+	/*
+
+	 */
+	ETag string `json:"ETag"`
+	/*
+
+	 */
+	LastModified string `json:"Last-Modified"`
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewGetFilePartialContent creates GetFilePartialContent with default headers values
+func NewGetFilePartialContent() *GetFilePartialContent {
+
+	return &GetFilePartialContent{}
+}
+
+// WithContentRange adds the contentRange to the get file partial content response
+func (o *GetFilePartialContent) WithContentRange(contentRange string) *GetFilePartialContent {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the get file partial content response
+func (o *GetFilePartialContent) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WithContentType adds the contentType to the get file partial content response
+func (o *GetFilePartialContent) WithContentType(contentType string) *GetFilePartialContent {
+	o.ContentType = contentType
+	return o
+}
+
+// SetContentType sets the contentType to the get file partial content response
+func (o *GetFilePartialContent) SetContentType(contentType string) {
+	o.ContentType = contentType
+}
+
+// WithDigest adds the digest to the get file partial content response
+func (o *GetFilePartialContent) WithDigest(digest string) *GetFilePartialContent {
+	o.Digest = digest
+	return o
+}
+
+// SetDigest sets the digest to the get file partial content response
+func (o *GetFilePartialContent) SetDigest(digest string) {
+	o.Digest = digest
+}
+
+// WithETag adds the eTag to the get file partial content response
+func (o *GetFilePartialContent) WithETag(eTag string) *GetFilePartialContent {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get file partial content response
+func (o *GetFilePartialContent) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithLastModified adds the lastModified to the get file partial content response
+func (o *GetFilePartialContent) WithLastModified(lastModified string) *GetFilePartialContent {
+	o.LastModified = lastModified
+	return o
+}
+
+// SetLastModified sets the lastModified to the get file partial content response
+func (o *GetFilePartialContent) SetLastModified(lastModified string) {
+	o.LastModified = lastModified
+}
+
+// WithPayload adds the payload to the get file partial content response
+func (o *GetFilePartialContent) WithPayload(payload io.ReadCloser) *GetFilePartialContent {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get file partial content response
+func (o *GetFilePartialContent) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetFilePartialContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	// response header Content-Type
+
+	contentType := o.ContentType
+	if contentType != "" {
+		rw.Header().Set("Content-Type", contentType)
+	}
+
+	// response header Digest
+
+	digest := o.Digest
+	if digest != "" {
+		rw.Header().Set("Digest", digest)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	// response header Last-Modified
+
+	lastModified := o.LastModified
+	if lastModified != "" {
+		rw.Header().Set("Last-Modified", lastModified)
+	}
+
+	rw.WriteHeader(206)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+func (o *GetFilePartialContent) GetFileResponder() {}
+
+// GetFileNotModifiedCode is the HTTP code returned for type GetFileNotModified
+const GetFileNotModifiedCode int = 304
+
+/*GetFileNotModified The file was not modified.
+
+swagger:response getFileNotModified
+*/
+type GetFileNotModified struct {
+}
+
+// NewGetFileNotModified creates GetFileNotModified with default headers values
+func NewGetFileNotModified() *GetFileNotModified {
+
+	return &GetFileNotModified{}
+}
+
+// WriteResponse to the client
+func (o *GetFileNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
+func (o *GetFileNotModified) GetFileResponder() {}
+
+// GetFileRequestRangeNotSatisfiableCode is the HTTP code returned for type GetFileRequestRangeNotSatisfiable
+const GetFileRequestRangeNotSatisfiableCode int = 416
+
+/*GetFileRequestRangeNotSatisfiable Requested range not satisfiable.
+
+swagger:response getFileRequestRangeNotSatisfiable
+*/
+type GetFileRequestRangeNotSatisfiable struct {
+	/*
+
+	 */
+	ContentRange string `json:"Content-Range"`
+}
+
+// NewGetFileRequestRangeNotSatisfiable creates GetFileRequestRangeNotSatisfiable with default headers values
+func NewGetFileRequestRangeNotSatisfiable() *GetFileRequestRangeNotSatisfiable {
+
+	return &GetFileRequestRangeNotSatisfiable{}
+}
+
+// WithContentRange adds the contentRange to the get file request range not satisfiable response
+func (o *GetFileRequestRangeNotSatisfiable) WithContentRange(contentRange string) *GetFileRequestRangeNotSatisfiable {
+	o.ContentRange = contentRange
+	return o
+}
+
+// SetContentRange sets the contentRange to the get file request range not satisfiable response
+func (o *GetFileRequestRangeNotSatisfiable) SetContentRange(contentRange string) {
+	o.ContentRange = contentRange
+}
+
+// WriteResponse to the client
+func (o *GetFileRequestRangeNotSatisfiable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Content-Range
+
+	contentRange := o.ContentRange
+	if contentRange != "" {
+		rw.Header().Set("Content-Range", contentRange)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(416)
+}
+
+func (o *GetFileRequestRangeNotSatisfiable) GetFileResponder() {}
+
 /*GetFileDefault Generic error response.
 
 swagger:response getFileDefault
@@ -11,11 +11,16 @@ import (
 	golangswaggerpaths "path"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // GetFileURL generates an URL for the get file operation
 type GetFileURL struct {
-	ID strfmt.UUID
+	Disposition *string
+	Expires     *int64
+	ID          strfmt.UUID
+	Sig         *string
+	W           *int32
 
 	_basePath string
 	// avoid unkeyed usage
@@ -51,11 +56,43 @@ func (o *GetFileURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var dispositionQ string
+	if o.Disposition != nil {
+		dispositionQ = *o.Disposition
+	}
+	if dispositionQ != "" {
+		qs.Set("disposition", dispositionQ)
+	}
+
+	var expiresQ string
+	if o.Expires != nil {
+		expiresQ = swag.FormatInt64(*o.Expires)
+	}
+	if expiresQ != "" {
+		qs.Set("expires", expiresQ)
+	}
+
 	idQ := o.ID.String()
 	if idQ != "" {
 		qs.Set("id", idQ)
 	}
 
+	var sigQ string
+	if o.Sig != nil {
+		sigQ = *o.Sig
+	}
+	if sigQ != "" {
+		qs.Set("sig", sigQ)
+	}
+
+	var wQ string
+	if o.W != nil {
+		wQ = swag.FormatInt32(*o.W)
+	}
+	if wQ != "" {
+		qs.Set("w", wQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
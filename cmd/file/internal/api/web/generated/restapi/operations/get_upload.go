@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// GetUploadHandlerFunc turns a function with the right signature into a get upload handler
+type GetUploadHandlerFunc func(GetUploadParams, *app.Session) GetUploadResponder
+
+// Handle executing the request and returning a response
+func (fn GetUploadHandlerFunc) Handle(params GetUploadParams, principal *app.Session) GetUploadResponder {
+	return fn(params, principal)
+}
+
+// GetUploadHandler interface for that can handle valid get upload params
+type GetUploadHandler interface {
+	Handle(GetUploadParams, *app.Session) GetUploadResponder
+}
+
+// NewGetUpload creates a new http.Handler for the get upload operation
+func NewGetUpload(ctx *middleware.Context, handler GetUploadHandler) *GetUpload {
+	return &GetUpload{Context: ctx, Handler: handler}
+}
+
+/* GetUpload swagger:route GET /uploads/{id} getUpload
+
+Get upload session with received parts.
+
+*/
+type GetUpload struct {
+	Context *middleware.Context
+	Handler GetUploadHandler
+}
+
+func (o *GetUpload) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetUploadParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,185 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// GetUploadOKCode is the HTTP code returned for type GetUploadOK
+const GetUploadOKCode int = 200
+
+/*GetUploadOK Upload session.
+
+swagger:response getUploadOK
+*/
+type GetUploadOK struct {
+	/*
+
+	 */
+	UploadLength int64 `json:"Upload-Length"`
+	/*
+
+	 */
+	UploadOffset int64 `json:"Upload-Offset"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.UploadSession `json:"body,omitempty"`
+}
+
+// NewGetUploadOK creates GetUploadOK with default headers values
+func NewGetUploadOK() *GetUploadOK {
+
+	return &GetUploadOK{}
+}
+
+// WithUploadLength adds the uploadLength to the get upload o k response
+func (o *GetUploadOK) WithUploadLength(uploadLength int64) *GetUploadOK {
+	o.UploadLength = uploadLength
+	return o
+}
+
+// SetUploadLength sets the uploadLength to the get upload o k response
+func (o *GetUploadOK) SetUploadLength(uploadLength int64) {
+	o.UploadLength = uploadLength
+}
+
+// WithUploadOffset adds the uploadOffset to the get upload o k response
+func (o *GetUploadOK) WithUploadOffset(uploadOffset int64) *GetUploadOK {
+	o.UploadOffset = uploadOffset
+	return o
+}
+
+// SetUploadOffset sets the uploadOffset to the get upload o k response
+func (o *GetUploadOK) SetUploadOffset(uploadOffset int64) {
+	o.UploadOffset = uploadOffset
+}
+
+// WithPayload adds the payload to the get upload o k response
+func (o *GetUploadOK) WithPayload(payload *models.UploadSession) *GetUploadOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get upload o k response
+func (o *GetUploadOK) SetPayload(payload *models.UploadSession) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUploadOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Upload-Length
+
+	uploadLength := swag.FormatInt64(o.UploadLength)
+	if uploadLength != "" {
+		rw.Header().Set("Upload-Length", uploadLength)
+	}
+
+	// response header Upload-Offset
+
+	uploadOffset := swag.FormatInt64(o.UploadOffset)
+	if uploadOffset != "" {
+		rw.Header().Set("Upload-Offset", uploadOffset)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *GetUploadOK) GetUploadResponder() {}
+
+/*GetUploadDefault Generic error response.
+
+swagger:response getUploadDefault
+*/
+type GetUploadDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetUploadDefault creates GetUploadDefault with default headers values
+func NewGetUploadDefault(code int) *GetUploadDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetUploadDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get upload default response
+func (o *GetUploadDefault) WithStatusCode(code int) *GetUploadDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get upload default response
+func (o *GetUploadDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get upload default response
+func (o *GetUploadDefault) WithPayload(payload *models.Error) *GetUploadDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get upload default response
+func (o *GetUploadDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUploadDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *GetUploadDefault) GetUploadResponder() {}
+
+type GetUploadNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*GetUploadNotImplementedResponder) GetUploadResponder() {}
+
+func GetUploadNotImplemented() GetUploadResponder {
+	return &GetUploadNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.GetUpload has not yet been implemented",
+		),
+	}
+}
+
+type GetUploadResponder interface {
+	middleware.Responder
+	GetUploadResponder()
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// ListFilesHandlerFunc turns a function with the right signature into a list files handler
+type ListFilesHandlerFunc func(ListFilesParams, *app.Session) ListFilesResponder
+
+// Handle executing the request and returning a response
+func (fn ListFilesHandlerFunc) Handle(params ListFilesParams, principal *app.Session) ListFilesResponder {
+	return fn(params, principal)
+}
+
+// ListFilesHandler interface for that can handle valid list files params
+type ListFilesHandler interface {
+	Handle(ListFilesParams, *app.Session) ListFilesResponder
+}
+
+// NewListFiles creates a new http.Handler for the list files operation
+func NewListFiles(ctx *middleware.Context, handler ListFilesHandler) *ListFiles {
+	return &ListFiles{Context: ctx, Handler: handler}
+}
+
+/* ListFiles swagger:route GET /files listFiles
+
+List user's files with filters, sorting and cursor pagination. Derived files are skipped.
+
+*/
+type ListFiles struct {
+	Context *middleware.Context
+	Handler ListFilesHandler
+}
+
+func (o *ListFiles) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListFilesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,473 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewListFilesParams creates a new ListFilesParams object
+// with the default values initialized.
+func NewListFilesParams() ListFilesParams {
+
+	var (
+		// initialize parameters with default values
+
+		limitDefault = int32(20)
+
+		orderDefault  = string("asc")
+		sortByDefault = string("createdAt")
+	)
+
+	return ListFilesParams{
+		Limit: &limitDefault,
+
+		Order: &orderDefault,
+
+		SortBy: &sortByDefault,
+	}
+}
+
+// ListFilesParams contains all the bound params for the list files operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listFiles
+type ListFilesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*MIME type, e.g. image/png or image/*.
+	  In: query
+	*/
+	ContentType *string
+	/*Files created at or after time.
+	  In: query
+	*/
+	CreatedAfter *strfmt.DateTime
+	/*Files created before time.
+	  In: query
+	*/
+	CreatedBefore *strfmt.DateTime
+	/*Cursor returned with previous page.
+	  In: query
+	*/
+	Cursor *string
+	/*
+	  Maximum: 100
+	  Minimum: 1
+	  In: query
+	  Default: 20
+	*/
+	Limit *int32
+	/*
+	  Minimum: 0
+	  In: query
+	*/
+	MaxSize *int64
+	/*JSON object, files which metadata contains it are returned.
+	  In: query
+	*/
+	Metadata *string
+	/*
+	  Minimum: 0
+	  In: query
+	*/
+	MinSize *int64
+	/*
+	  In: query
+	  Default: "asc"
+	*/
+	Order *string
+	/*
+	  In: query
+	  Default: "createdAt"
+	*/
+	SortBy *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListFilesParams() beforehand.
+func (o *ListFilesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qContentType, qhkContentType, _ := qs.GetOK("contentType")
+	if err := o.bindContentType(qContentType, qhkContentType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCreatedAfter, qhkCreatedAfter, _ := qs.GetOK("createdAfter")
+	if err := o.bindCreatedAfter(qCreatedAfter, qhkCreatedAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCreatedBefore, qhkCreatedBefore, _ := qs.GetOK("createdBefore")
+	if err := o.bindCreatedBefore(qCreatedBefore, qhkCreatedBefore, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMaxSize, qhkMaxSize, _ := qs.GetOK("maxSize")
+	if err := o.bindMaxSize(qMaxSize, qhkMaxSize, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMetadata, qhkMetadata, _ := qs.GetOK("metadata")
+	if err := o.bindMetadata(qMetadata, qhkMetadata, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinSize, qhkMinSize, _ := qs.GetOK("minSize")
+	if err := o.bindMinSize(qMinSize, qhkMinSize, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrder, qhkOrder, _ := qs.GetOK("order")
+	if err := o.bindOrder(qOrder, qhkOrder, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSortBy, qhkSortBy, _ := qs.GetOK("sortBy")
+	if err := o.bindSortBy(qSortBy, qhkSortBy, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindContentType binds and validates parameter ContentType from query.
+func (o *ListFilesParams) bindContentType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ContentType = &raw
+
+	return nil
+}
+
+// bindCreatedAfter binds and validates parameter CreatedAfter from query.
+func (o *ListFilesParams) bindCreatedAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("createdAfter", "query", "strfmt.DateTime", raw)
+	}
+	o.CreatedAfter = (value.(*strfmt.DateTime))
+
+	if err := o.validateCreatedAfter(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreatedAfter carries on validations for parameter CreatedAfter
+func (o *ListFilesParams) validateCreatedAfter(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("createdAfter", "query", "date-time", o.CreatedAfter.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCreatedBefore binds and validates parameter CreatedBefore from query.
+func (o *ListFilesParams) bindCreatedBefore(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("createdBefore", "query", "strfmt.DateTime", raw)
+	}
+	o.CreatedBefore = (value.(*strfmt.DateTime))
+
+	if err := o.validateCreatedBefore(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreatedBefore carries on validations for parameter CreatedBefore
+func (o *ListFilesParams) validateCreatedBefore(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("createdBefore", "query", "date-time", o.CreatedBefore.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListFilesParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *ListFilesParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListFilesParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int32", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *ListFilesParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64(*o.Limit), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", int64(*o.Limit), 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMaxSize binds and validates parameter MaxSize from query.
+func (o *ListFilesParams) bindMaxSize(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("maxSize", "query", "int64", raw)
+	}
+	o.MaxSize = &value
+
+	if err := o.validateMaxSize(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMaxSize carries on validations for parameter MaxSize
+func (o *ListFilesParams) validateMaxSize(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("maxSize", "query", *o.MaxSize, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMetadata binds and validates parameter Metadata from query.
+func (o *ListFilesParams) bindMetadata(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Metadata = &raw
+
+	return nil
+}
+
+// bindMinSize binds and validates parameter MinSize from query.
+func (o *ListFilesParams) bindMinSize(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("minSize", "query", "int64", raw)
+	}
+	o.MinSize = &value
+
+	if err := o.validateMinSize(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMinSize carries on validations for parameter MinSize
+func (o *ListFilesParams) validateMinSize(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("minSize", "query", *o.MinSize, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOrder binds and validates parameter Order from query.
+func (o *ListFilesParams) bindOrder(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListFilesParams()
+		return nil
+	}
+	o.Order = &raw
+
+	if err := o.validateOrder(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrder carries on validations for parameter Order
+func (o *ListFilesParams) validateOrder(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("order", "query", *o.Order, []interface{}{"asc", "desc"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSortBy binds and validates parameter SortBy from query.
+func (o *ListFilesParams) bindSortBy(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListFilesParams()
+		return nil
+	}
+	o.SortBy = &raw
+
+	if err := o.validateSortBy(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSortBy carries on validations for parameter SortBy
+func (o *ListFilesParams) validateSortBy(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("sortBy", "query", *o.SortBy, []interface{}{"createdAt", "size"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
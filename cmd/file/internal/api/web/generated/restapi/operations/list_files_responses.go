@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// ListFilesOKCode is the HTTP code returned for type ListFilesOK
+const ListFilesOKCode int = 200
+
+/*ListFilesOK Page of files.
+
+swagger:response listFilesOK
+*/
+type ListFilesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.FileList `json:"body,omitempty"`
+}
+
+// NewListFilesOK creates ListFilesOK with default headers values
+func NewListFilesOK() *ListFilesOK {
+
+	return &ListFilesOK{}
+}
+
+// WithPayload adds the payload to the list files o k response
+func (o *ListFilesOK) WithPayload(payload *models.FileList) *ListFilesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list files o k response
+func (o *ListFilesOK) SetPayload(payload *models.FileList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListFilesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *ListFilesOK) ListFilesResponder() {}
+
+/*ListFilesDefault Generic error response.
+
+swagger:response listFilesDefault
+*/
+type ListFilesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListFilesDefault creates ListFilesDefault with default headers values
+func NewListFilesDefault(code int) *ListFilesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListFilesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list files default response
+func (o *ListFilesDefault) WithStatusCode(code int) *ListFilesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list files default response
+func (o *ListFilesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list files default response
+func (o *ListFilesDefault) WithPayload(payload *models.Error) *ListFilesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list files default response
+func (o *ListFilesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListFilesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *ListFilesDefault) ListFilesResponder() {}
+
+type ListFilesNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*ListFilesNotImplementedResponder) ListFilesResponder() {}
+
+func ListFilesNotImplemented() ListFilesResponder {
+	return &ListFilesNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.ListFiles has not yet been implemented",
+		),
+	}
+}
+
+type ListFilesResponder interface {
+	middleware.Responder
+	ListFilesResponder()
+}
@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ListFilesURL generates an URL for the list files operation
+type ListFilesURL struct {
+	ContentType   *string
+	CreatedAfter  *strfmt.DateTime
+	CreatedBefore *strfmt.DateTime
+	Cursor        *string
+	Limit         *int32
+	MaxSize       *int64
+	Metadata      *string
+	MinSize       *int64
+	Order         *string
+	SortBy        *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListFilesURL) WithBasePath(bp string) *ListFilesURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListFilesURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *ListFilesURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/files"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/file/api/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var contentTypeQ string
+	if o.ContentType != nil {
+		contentTypeQ = *o.ContentType
+	}
+	if contentTypeQ != "" {
+		qs.Set("contentType", contentTypeQ)
+	}
+
+	var createdAfterQ string
+	if o.CreatedAfter != nil {
+		createdAfterQ = o.CreatedAfter.String()
+	}
+	if createdAfterQ != "" {
+		qs.Set("createdAfter", createdAfterQ)
+	}
+
+	var createdBeforeQ string
+	if o.CreatedBefore != nil {
+		createdBeforeQ = o.CreatedBefore.String()
+	}
+	if createdBeforeQ != "" {
+		qs.Set("createdBefore", createdBeforeQ)
+	}
+
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt32(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var maxSizeQ string
+	if o.MaxSize != nil {
+		maxSizeQ = swag.FormatInt64(*o.MaxSize)
+	}
+	if maxSizeQ != "" {
+		qs.Set("maxSize", maxSizeQ)
+	}
+
+	var metadataQ string
+	if o.Metadata != nil {
+		metadataQ = *o.Metadata
+	}
+	if metadataQ != "" {
+		qs.Set("metadata", metadataQ)
+	}
+
+	var minSizeQ string
+	if o.MinSize != nil {
+		minSizeQ = swag.FormatInt64(*o.MinSize)
+	}
+	if minSizeQ != "" {
+		qs.Set("minSize", minSizeQ)
+	}
+
+	var orderQ string
+	if o.Order != nil {
+		orderQ = *o.Order
+	}
+	if orderQ != "" {
+		qs.Set("order", orderQ)
+	}
+
+	var sortByQ string
+	if o.SortBy != nil {
+		sortByQ = *o.SortBy
+	}
+	if sortByQ != "" {
+		qs.Set("sortBy", sortByQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *ListFilesURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *ListFilesURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *ListFilesURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on ListFilesURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on ListFilesURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *ListFilesURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// SetFileAccessHandlerFunc turns a function with the right signature into a set file access handler
+type SetFileAccessHandlerFunc func(SetFileAccessParams, *app.Session) SetFileAccessResponder
+
+// Handle executing the request and returning a response
+func (fn SetFileAccessHandlerFunc) Handle(params SetFileAccessParams, principal *app.Session) SetFileAccessResponder {
+	return fn(params, principal)
+}
+
+// SetFileAccessHandler interface for that can handle valid set file access params
+type SetFileAccessHandler interface {
+	Handle(SetFileAccessParams, *app.Session) SetFileAccessResponder
+}
+
+// NewSetFileAccess creates a new http.Handler for the set file access operation
+func NewSetFileAccess(ctx *middleware.Context, handler SetFileAccessHandler) *SetFileAccess {
+	return &SetFileAccess{Context: ctx, Handler: handler}
+}
+
+/* SetFileAccess swagger:route PUT /file/access setFileAccess
+
+Set file visibility and users it is shared with, only owner can set it.
+
+*/
+type SetFileAccess struct {
+	Context *middleware.Context
+	Handler SetFileAccessHandler
+}
+
+func (o *SetFileAccess) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSetFileAccessParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,120 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// SetFileAccessNoContentCode is the HTTP code returned for type SetFileAccessNoContent
+const SetFileAccessNoContentCode int = 204
+
+/*SetFileAccessNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response setFileAccessNoContent
+*/
+type SetFileAccessNoContent struct {
+}
+
+// NewSetFileAccessNoContent creates SetFileAccessNoContent with default headers values
+func NewSetFileAccessNoContent() *SetFileAccessNoContent {
+
+	return &SetFileAccessNoContent{}
+}
+
+// WriteResponse to the client
+func (o *SetFileAccessNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+func (o *SetFileAccessNoContent) SetFileAccessResponder() {}
+
+/*SetFileAccessDefault Generic error response.
+
+swagger:response setFileAccessDefault
+*/
+type SetFileAccessDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetFileAccessDefault creates SetFileAccessDefault with default headers values
+func NewSetFileAccessDefault(code int) *SetFileAccessDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetFileAccessDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set file access default response
+func (o *SetFileAccessDefault) WithStatusCode(code int) *SetFileAccessDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set file access default response
+func (o *SetFileAccessDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set file access default response
+func (o *SetFileAccessDefault) WithPayload(payload *models.Error) *SetFileAccessDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set file access default response
+func (o *SetFileAccessDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetFileAccessDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *SetFileAccessDefault) SetFileAccessResponder() {}
+
+type SetFileAccessNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*SetFileAccessNotImplementedResponder) SetFileAccessResponder() {}
+
+func SetFileAccessNotImplemented() SetFileAccessResponder {
+	return &SetFileAccessNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.SetFileAccess has not yet been implemented",
+		),
+	}
+}
+
+type SetFileAccessResponder interface {
+	middleware.Responder
+	SetFileAccessResponder()
+}
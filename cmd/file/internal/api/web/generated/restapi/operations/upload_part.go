@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// UploadPartHandlerFunc turns a function with the right signature into a upload part handler
+type UploadPartHandlerFunc func(UploadPartParams, *app.Session) UploadPartResponder
+
+// Handle executing the request and returning a response
+func (fn UploadPartHandlerFunc) Handle(params UploadPartParams, principal *app.Session) UploadPartResponder {
+	return fn(params, principal)
+}
+
+// UploadPartHandler interface for that can handle valid upload part params
+type UploadPartHandler interface {
+	Handle(UploadPartParams, *app.Session) UploadPartResponder
+}
+
+// NewUploadPart creates a new http.Handler for the upload part operation
+func NewUploadPart(ctx *middleware.Context, handler UploadPartHandler) *UploadPart {
+	return &UploadPart{Context: ctx, Handler: handler}
+}
+
+/* UploadPart swagger:route PUT /uploads/{id}/parts/{number} uploadPart
+
+Upload part of upload session, re-uploading part replaces its content.
+
+*/
+type UploadPart struct {
+	Context *middleware.Context
+	Handler UploadPartHandler
+}
+
+func (o *UploadPart) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUploadPartParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
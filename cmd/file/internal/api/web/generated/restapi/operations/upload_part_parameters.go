@@ -0,0 +1,154 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewUploadPartParams creates a new UploadPartParams object
+//
+// There are no default values defined in the spec.
+func NewUploadPartParams() UploadPartParams {
+
+	return UploadPartParams{}
+}
+
+// UploadPartParams contains all the bound params for the upload part operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters uploadPart
+type UploadPartParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	ID strfmt.UUID
+	/*
+	  Required: true
+	  Maximum: 10000
+	  Minimum: 1
+	  In: path
+	*/
+	Number int32
+	/*
+	  Required: true
+	  In: body
+	*/
+	Part io.ReadCloser
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewUploadPartParams() beforehand.
+func (o *UploadPartParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rNumber, rhkNumber, _ := route.Params.GetOK("number")
+	if err := o.bindNumber(rNumber, rhkNumber, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if runtime.HasBody(r) {
+		o.Part = r.Body
+	} else {
+		res = append(res, errors.Required("part", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *UploadPartParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("id", "path", "strfmt.UUID", raw)
+	}
+	o.ID = *(value.(*strfmt.UUID))
+
+	if err := o.validateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateID carries on validations for parameter ID
+func (o *UploadPartParams) validateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("id", "path", "uuid", o.ID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindNumber binds and validates parameter Number from path.
+func (o *UploadPartParams) bindNumber(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("number", "path", "int32", raw)
+	}
+	o.Number = value
+
+	if err := o.validateNumber(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateNumber carries on validations for parameter Number
+func (o *UploadPartParams) validateNumber(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("number", "path", int64(o.Number), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("number", "path", int64(o.Number), 10000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+)
+
+// UploadPartOKCode is the HTTP code returned for type UploadPartOK
+const UploadPartOKCode int = 200
+
+/*UploadPartOK Received part.
+
+swagger:response uploadPartOK
+*/
+type UploadPartOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Part `json:"body,omitempty"`
+}
+
+// NewUploadPartOK creates UploadPartOK with default headers values
+func NewUploadPartOK() *UploadPartOK {
+
+	return &UploadPartOK{}
+}
+
+// WithPayload adds the payload to the upload part o k response
+func (o *UploadPartOK) WithPayload(payload *models.Part) *UploadPartOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the upload part o k response
+func (o *UploadPartOK) SetPayload(payload *models.Part) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UploadPartOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *UploadPartOK) UploadPartResponder() {}
+
+/*UploadPartDefault Generic error response.
+
+swagger:response uploadPartDefault
+*/
+type UploadPartDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUploadPartDefault creates UploadPartDefault with default headers values
+func NewUploadPartDefault(code int) *UploadPartDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UploadPartDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the upload part default response
+func (o *UploadPartDefault) WithStatusCode(code int) *UploadPartDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the upload part default response
+func (o *UploadPartDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the upload part default response
+func (o *UploadPartDefault) WithPayload(payload *models.Error) *UploadPartDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the upload part default response
+func (o *UploadPartDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UploadPartDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *UploadPartDefault) UploadPartResponder() {}
+
+type UploadPartNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*UploadPartNotImplementedResponder) UploadPartResponder() {}
+
+func UploadPartNotImplemented() UploadPartResponder {
+	return &UploadPartNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.UploadPart has not yet been implemented",
+		),
+	}
+}
+
+type UploadPartResponder interface {
+	middleware.Responder
+	UploadPartResponder()
+}
@@ -1,8 +1,11 @@
 package web
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
+	"path"
+	"time"
 
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/restapi/operations"
@@ -12,23 +15,254 @@ import (
 	"github.com/rs/zerolog"
 )
 
-func (svc *service) getFile(params operations.GetFileParams) operations.GetFileResponder {
-	ctx, log, _ := fromRequest(params.HTTPRequest)
+func (svc *service) getFile(params operations.GetFileParams, session *app.Session) operations.GetFileResponder {
+	ctx, log, principal := fromRequest(params.HTTPRequest, session)
 
 	fID, err := uuid.FromString(params.ID.String())
 	if err != nil {
-		return operations.NewGetFileDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotValidID.Error()))
+		return fileError(http.StatusBadRequest, app.ErrNotValidID.Error())
 	}
 
-	file, err := svc.app.GetFile(ctx, fID)
+	var (
+		file        *app.File
+		disposition string
+	)
+	switch {
+	case params.Sig != nil:
+		disposition = swag.StringValue(params.Disposition)
+		file, err = svc.app.GetSignedFile(ctx, app.SignedURL{
+			FileID:      fID,
+			ExpiresAt:   time.Unix(swag.Int64Value(params.Expires), 0),
+			Disposition: disposition,
+			Signature:   *params.Sig,
+		}, remoteIP(params.HTTPRequest))
+	case params.W != nil:
+		file, err = svc.app.GetVariant(ctx, principal, fID, int(*params.W))
+	default:
+		file, err = svc.app.GetFile(ctx, principal, fID)
+	}
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return &fileResponder{file: file, req: params.HTTPRequest, disposition: disposition}
+	case errors.Is(err, app.ErrNotFound):
+		return fileError(http.StatusNotFound, app.ErrNotFound.Error())
+	case errors.Is(err, app.ErrAccessDenied):
+		return fileError(http.StatusForbidden, app.ErrAccessDenied.Error())
+	case errors.Is(err, app.ErrNotValidSignature):
+		return fileError(http.StatusForbidden, app.ErrNotValidSignature.Error())
+	case errors.Is(err, app.ErrURLExpired):
+		return fileError(http.StatusForbidden, app.ErrURLExpired.Error())
+	case errors.Is(err, app.ErrNotImage):
+		return fileError(http.StatusBadRequest, app.ErrNotImage.Error())
+	case errors.Is(err, app.ErrImageTooLarge):
+		return fileError(http.StatusBadRequest, app.ErrImageTooLarge.Error())
+	case errors.Is(err, app.ErrQuotaExceeded):
+		return fileError(http.StatusInsufficientStorage, app.ErrQuotaExceeded.Error())
+	case errors.Is(err, app.ErrNotValidWidth):
+		return fileError(http.StatusBadRequest, app.ErrNotValidWidth.Error())
+	case errors.Is(err, app.ErrQuarantined):
+		return fileError(http.StatusLocked, app.ErrQuarantined.Error())
+	case errors.Is(err, app.ErrInfected):
+		return fileError(http.StatusForbidden, app.ErrInfected.Error())
+	default:
+		return fileError(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+	}
+}
+
+func (svc *service) setFileAccess(params operations.SetFileAccessParams, session *app.Session) operations.SetFileAccessResponder {
+	ctx, log, principal := fromRequest(params.HTTPRequest, session)
+
+	fID, err := uuid.FromString(params.ID.String())
+	if err != nil {
+		return operations.NewSetFileAccessDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotValidID.Error()))
+	}
+
+	err = svc.app.SetAccess(ctx, principal, fID, appAccess(params.Access))
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return operations.NewSetFileAccessNoContent()
+	case errors.Is(err, app.ErrNotFound):
+		return operations.NewSetFileAccessDefault(http.StatusNotFound).WithPayload(apiError(app.ErrNotFound.Error()))
+	case errors.Is(err, app.ErrAccessDenied):
+		return operations.NewSetFileAccessDefault(http.StatusForbidden).WithPayload(apiError(app.ErrAccessDenied.Error()))
+	case errors.Is(err, app.ErrNotValidAccess):
+		return operations.NewSetFileAccessDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotValidAccess.Error()))
+	default:
+		return operations.NewSetFileAccessDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
+func (svc *service) listFiles(params operations.ListFilesParams, session *app.Session) operations.ListFilesResponder {
+	ctx, log, principal := fromRequest(params.HTTPRequest, session)
+
+	listParams := app.ListParams{
+		Cursor:      swag.StringValue(params.Cursor),
+		Limit:       int(swag.Int32Value(params.Limit)),
+		MinSize:     swag.Int64Value(params.MinSize),
+		MaxSize:     swag.Int64Value(params.MaxSize),
+		ContentType: swag.StringValue(params.ContentType),
+		Metadata:    json.RawMessage(swag.StringValue(params.Metadata)),
+		SortBy:      app.SortByCreatedAt,
+		Desc:        swag.StringValue(params.Order) == "desc",
+	}
+	if swag.StringValue(params.SortBy) == "size" {
+		listParams.SortBy = app.SortBySize
+	}
+	if params.CreatedAfter != nil {
+		listParams.CreatedAfter = time.Time(*params.CreatedAfter)
+	}
+	if params.CreatedBefore != nil {
+		listParams.CreatedBefore = time.Time(*params.CreatedBefore)
+	}
+
+	files, next, err := svc.app.List(ctx, principal, listParams)
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return operations.NewListFilesOK().WithPayload(&models.FileList{
+			Files:      Files(files),
+			NextCursor: next,
+		})
+	case errors.Is(err, app.ErrNotValidCursor), errors.Is(err, app.ErrNotValidLimit),
+		errors.Is(err, app.ErrNotValidSort), errors.Is(err, app.ErrNotValidMetadata):
+		return operations.NewListFilesDefault(http.StatusBadRequest).WithPayload(apiError(err.Error()))
+	case errors.Is(err, app.ErrAccessDenied):
+		return operations.NewListFilesDefault(http.StatusForbidden).WithPayload(apiError(app.ErrAccessDenied.Error()))
+	default:
+		return operations.NewListFilesDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
+func (svc *service) createUpload(params operations.CreateUploadParams, session *app.Session) operations.CreateUploadResponder {
+	ctx, log, principal := fromRequest(params.HTTPRequest, session)
+
+	upload, err := svc.app.CreateUpload(ctx, principal, swag.Int64Value(params.Args.Size), app.Policy{})
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return operations.NewCreateUploadCreated().
+			WithLocation(path.Join(params.HTTPRequest.URL.Path, upload.ID.String())).
+			WithUploadOffset(upload.Offset()).
+			WithPayload(UploadSession(upload))
+	case errors.Is(err, app.ErrNotValidSize):
+		return operations.NewCreateUploadDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotValidSize.Error()))
+	case errors.Is(err, app.ErrAccessDenied):
+		return operations.NewCreateUploadDefault(http.StatusForbidden).WithPayload(apiError(app.ErrAccessDenied.Error()))
+	default:
+		return operations.NewCreateUploadDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
+func (svc *service) getUpload(params operations.GetUploadParams, session *app.Session) operations.GetUploadResponder {
+	ctx, log, principal := fromRequest(params.HTTPRequest, session)
+
+	sessionID, err := uuid.FromString(params.ID.String())
+	if err != nil {
+		return operations.NewGetUploadDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotValidID.Error()))
+	}
+
+	upload, err := svc.app.GetUpload(ctx, principal, sessionID)
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return operations.NewGetUploadOK().
+			WithUploadOffset(upload.Offset()).
+			WithUploadLength(upload.Size).
+			WithPayload(UploadSession(upload))
+	case errors.Is(err, app.ErrNotFound):
+		return operations.NewGetUploadDefault(http.StatusNotFound).WithPayload(apiError(app.ErrNotFound.Error()))
+	case errors.Is(err, app.ErrAccessDenied):
+		return operations.NewGetUploadDefault(http.StatusForbidden).WithPayload(apiError(app.ErrAccessDenied.Error()))
+	default:
+		return operations.NewGetUploadDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
+func (svc *service) uploadPart(params operations.UploadPartParams, session *app.Session) operations.UploadPartResponder {
+	ctx, log, principal := fromRequest(params.HTTPRequest, session)
+
+	sessionID, err := uuid.FromString(params.ID.String())
+	if err != nil {
+		return operations.NewUploadPartDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotValidID.Error()))
+	}
+
+	part, err := svc.app.UploadPart(ctx, principal, sessionID, int(params.Number), params.Part)
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return operations.NewUploadPartOK().WithPayload(Part(*part))
+	case errors.Is(err, app.ErrNotFound):
+		return operations.NewUploadPartDefault(http.StatusNotFound).WithPayload(apiError(app.ErrNotFound.Error()))
+	case errors.Is(err, app.ErrAccessDenied):
+		return operations.NewUploadPartDefault(http.StatusForbidden).WithPayload(apiError(app.ErrAccessDenied.Error()))
+	case errors.Is(err, app.ErrPartTooLarge):
+		return operations.NewUploadPartDefault(http.StatusRequestEntityTooLarge).WithPayload(apiError(app.ErrPartTooLarge.Error()))
+	case errors.Is(err, app.ErrQuotaExceeded):
+		return operations.NewUploadPartDefault(http.StatusRequestEntityTooLarge).WithPayload(apiError(app.ErrQuotaExceeded.Error()))
+	case errors.Is(err, app.ErrNotValidPart):
+		return operations.NewUploadPartDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotValidPart.Error()))
+	case errors.Is(err, app.ErrEmptyPart):
+		return operations.NewUploadPartDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrEmptyPart.Error()))
+	default:
+		return operations.NewUploadPartDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
+func (svc *service) completeUpload(params operations.CompleteUploadParams, session *app.Session) operations.CompleteUploadResponder {
+	ctx, log, principal := fromRequest(params.HTTPRequest, session)
+
+	sessionID, err := uuid.FromString(params.ID.String())
+	if err != nil {
+		return operations.NewCompleteUploadDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotValidID.Error()))
+	}
+
+	file, err := svc.app.CompleteUpload(ctx, principal, sessionID, app.Access{Visibility: app.VisibilityPrivate})
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return operations.NewCompleteUploadOK().WithPayload(UploadedFile(file))
+	case errors.Is(err, app.ErrNotFound):
+		return operations.NewCompleteUploadDefault(http.StatusNotFound).WithPayload(apiError(app.ErrNotFound.Error()))
+	case errors.Is(err, app.ErrAccessDenied):
+		return operations.NewCompleteUploadDefault(http.StatusForbidden).WithPayload(apiError(app.ErrAccessDenied.Error()))
+	case errors.Is(err, app.ErrUploadIncomplete):
+		return operations.NewCompleteUploadDefault(http.StatusConflict).WithPayload(apiError(app.ErrUploadIncomplete.Error()))
+	case errors.Is(err, app.ErrQuotaExceeded):
+		return operations.NewCompleteUploadDefault(http.StatusRequestEntityTooLarge).WithPayload(apiError(app.ErrQuotaExceeded.Error()))
+	case errors.Is(err, app.ErrInfected):
+		return operations.NewCompleteUploadDefault(http.StatusUnprocessableEntity).WithPayload(apiError(app.ErrInfected.Error()))
+	default:
+		return operations.NewCompleteUploadDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
+func (svc *service) abortUpload(params operations.AbortUploadParams, session *app.Session) operations.AbortUploadResponder {
+	ctx, log, principal := fromRequest(params.HTTPRequest, session)
+
+	sessionID, err := uuid.FromString(params.ID.String())
+	if err != nil {
+		return operations.NewAbortUploadDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotValidID.Error()))
+	}
+
+	err = svc.app.AbortUpload(ctx, principal, sessionID)
 	defer logs(log, err)
 	switch {
 	case err == nil:
-		return operations.NewGetFileOK().WithPayload(file)
+		return operations.NewAbortUploadNoContent()
 	case errors.Is(err, app.ErrNotFound):
-		return operations.NewGetFileDefault(http.StatusNotFound).WithPayload(apiError(app.ErrNotFound.Error()))
+		return operations.NewAbortUploadDefault(http.StatusNotFound).WithPayload(apiError(app.ErrNotFound.Error()))
+	case errors.Is(err, app.ErrAccessDenied):
+		return operations.NewAbortUploadDefault(http.StatusForbidden).WithPayload(apiError(app.ErrAccessDenied.Error()))
 	default:
-		return operations.NewGetFileDefault(http.StatusInternalServerError).
+		return operations.NewAbortUploadDefault(http.StatusInternalServerError).
 			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
 	}
 }
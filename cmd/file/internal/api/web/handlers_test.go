@@ -2,15 +2,30 @@ package web_test
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/client"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/client/operations"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
@@ -21,12 +36,20 @@ func TestService_GetFile(t *testing.T) {
 	assert := require.New(t)
 
 	testCases := []struct {
-		name     string
-		filePath string
-		appErr   error
-		wantErr  *models.Error
+		name            string
+		filePath        string
+		contentType     string
+		wantContentType string
+		anonymous       bool
+		appErr          error
+		wantErr         *models.Error
 	}{
-		{"success", testFile, nil, nil},
+		{"success", testFile, "image/webp", "image/webp", false, nil, nil},
+		{"success_sniffed", testFile, "", "image/jpeg", false, nil, nil},
+		{"success_anonymous", testFile, "image/webp", "image/webp", true, nil, nil},
+		{"err_access_denied", testFile, "", "", true, app.ErrAccessDenied, APIError(app.ErrAccessDenied.Error())},
+		{"err_quarantined", testFile, "", "", false, app.ErrQuarantined, APIError(app.ErrQuarantined.Error())},
+		{"err_infected", testFile, "", "", false, app.ErrInfected, APIError(app.ErrInfected.Error())},
 	}
 
 	for _, tc := range testCases {
@@ -48,25 +71,494 @@ func TestService_GetFile(t *testing.T) {
 				ReadSeekCloser: file,
 				ID:             uuid.Must(uuid.NewV4()),
 				Size:           stat.Size(),
+				ContentType:    tc.contentType,
 				Metadata:       nil,
 			}
 
-			_, mockApp, client, assert := start(t)
+			_, mockApp, client, assert, apiKeyAuth := start(t)
 
-			mockApp.EXPECT().GetFile(gomock.Any(), appFile.ID).Return(appFile, tc.appErr)
+			principal := user
+			if tc.anonymous {
+				principal, apiKeyAuth = app.Anonymous, nil
+			} else {
+				mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
+			}
+
+			var resFile *app.File
+			if tc.appErr == nil {
+				resFile = appFile
+			}
+			mockApp.EXPECT().GetFile(gomock.Any(), principal, appFile.ID).Return(resFile, tc.appErr)
 
 			b := &bytes.Buffer{}
 			params := operations.NewGetFileParams().
 				WithID(strfmt.UUID(appFile.ID.String()))
 
-			res, err := client.Operations.GetFile(params, b)
+			res, _, err := client.Operations.GetFile(params, apiKeyAuth, b)
 			if tc.wantErr == nil {
 				assert.NoError(err)
 				assert.Equal(fileBuf, b.Bytes())
+				assert.Equal(tc.wantContentType, res.ContentType)
 			} else {
-				assert.NoError(res)
+				assert.Nil(res)
 				assert.Equal(tc.wantErr, errPayload(err))
 			}
 		})
 	}
 }
+
+func TestService_GetSignedFile(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	fileBuf, err := os.ReadFile(testFile)
+	assert.NoError(err)
+
+	var (
+		fileID      = uuid.Must(uuid.NewV4())
+		expiresAt   = time.Now().Add(time.Hour).Truncate(time.Second)
+		disposition = "attachment; filename=test.jpg"
+		signed      = app.SignedURL{
+			FileID:      fileID,
+			ExpiresAt:   expiresAt,
+			Disposition: disposition,
+			Signature:   "sig",
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		appErr  error
+		want    []byte
+		wantErr *models.Error
+	}{
+		{"success", nil, fileBuf, nil},
+		{"err_not_valid_signature", app.ErrNotValidSignature, nil, APIError(app.ErrNotValidSignature.Error())},
+		{"err_url_expired", app.ErrURLExpired, nil, APIError(app.ErrURLExpired.Error())},
+		{"err_not_found", app.ErrNotFound, nil, APIError(app.ErrNotFound.Error())},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, _ := start(t)
+
+			var appFile *app.File
+			if tc.appErr == nil {
+				file, err := os.Open(testFile)
+				assert.NoError(err)
+
+				appFile = &app.File{
+					ReadSeekCloser: file,
+					ID:             fileID,
+					Size:           int64(len(fileBuf)),
+					ContentType:    "image/jpeg",
+				}
+			}
+
+			mockApp.EXPECT().GetSignedFile(gomock.Any(), signed, net.IPv4(127, 0, 0, 1).To16()).Return(appFile, tc.appErr)
+
+			b := &bytes.Buffer{}
+			params := operations.NewGetFileParams().
+				WithID(strfmt.UUID(fileID.String())).
+				WithExpires(swag.Int64(expiresAt.Unix())).
+				WithDisposition(swag.String(disposition)).
+				WithSig(swag.String("sig"))
+
+			res, _, err := client.Operations.GetFile(params, nil, b)
+			assert.Equal(tc.wantErr, errPayload(err))
+			assert.Equal(tc.want, b.Bytes())
+			if tc.wantErr == nil {
+				assert.Equal(disposition, res.ContentDisposition)
+			}
+		})
+	}
+}
+
+func TestService_GetFileVariant(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	fileBuf, err := os.ReadFile(testFile)
+	assert.NoError(err)
+
+	fileID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name    string
+		appErr  error
+		want    []byte
+		wantErr *models.Error
+	}{
+		{"success", nil, fileBuf, nil},
+		{"err_not_found", app.ErrNotFound, nil, APIError(app.ErrNotFound.Error())},
+		{"err_not_image", app.ErrNotImage, nil, APIError(app.ErrNotImage.Error())},
+		{"err_image_too_large", app.ErrImageTooLarge, nil, APIError(app.ErrImageTooLarge.Error())},
+		{"err_quota_exceeded", app.ErrQuotaExceeded, nil, APIError(app.ErrQuotaExceeded.Error())},
+		{"err_access_denied", app.ErrAccessDenied, nil, APIError(app.ErrAccessDenied.Error())},
+		{"err_any", errAny, nil, APIError(http.StatusText(http.StatusInternalServerError))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			var appFile *app.File
+			if tc.appErr == nil {
+				file, err := os.Open(testFile)
+				assert.NoError(err)
+
+				appFile = &app.File{
+					ReadSeekCloser: file,
+					ID:             uuid.Must(uuid.NewV4()),
+					Size:           int64(len(fileBuf)),
+					ContentType:    "image/jpeg",
+				}
+			}
+
+			gomock.InOrder(
+				mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil),
+				mockApp.EXPECT().GetVariant(gomock.Any(), user, fileID, 256).Return(appFile, tc.appErr),
+			)
+
+			b := &bytes.Buffer{}
+			params := operations.NewGetFileParams().
+				WithID(strfmt.UUID(fileID.String())).
+				WithW(swag.Int32(256))
+
+			_, _, err := client.Operations.GetFile(params, apiKeyAuth, b)
+			assert.Equal(tc.wantErr, errPayload(err))
+			assert.Equal(tc.want, b.Bytes())
+		})
+	}
+}
+
+func TestService_SetFileAccess(t *testing.T) {
+	t.Parallel()
+
+	var (
+		fileID   = uuid.Must(uuid.NewV4())
+		friendID = uuid.Must(uuid.NewV4())
+		access   = app.Access{
+			Visibility: app.VisibilityShared,
+			SharedWith: []app.Principal{app.UserPrincipal(friendID)},
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		appErr  error
+		wantErr *models.Error
+	}{
+		{"success", nil, nil},
+		{"err_not_found", app.ErrNotFound, APIError(app.ErrNotFound.Error())},
+		{"err_access_denied", app.ErrAccessDenied, APIError(app.ErrAccessDenied.Error())},
+		{"err_not_valid_access", app.ErrNotValidAccess, APIError(app.ErrNotValidAccess.Error())},
+		{"err_any", errAny, APIError(http.StatusText(http.StatusInternalServerError))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			gomock.InOrder(
+				mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil),
+				mockApp.EXPECT().SetAccess(gomock.Any(), user, fileID, access).Return(tc.appErr),
+			)
+
+			params := operations.NewSetFileAccessParams().
+				WithID(strfmt.UUID(fileID.String())).
+				WithAccess(web.Access(access))
+
+			_, err := client.Operations.SetFileAccess(params, apiKeyAuth)
+			assert.Equal(tc.wantErr, errPayload(err))
+		})
+	}
+}
+
+func TestService_ListFiles(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	file := app.File{
+		ID:          uuid.Must(uuid.NewV4()),
+		Owner:       user,
+		Access:      app.Access{Visibility: app.VisibilityShared, SharedWith: []app.Principal{user}},
+		Size:        100,
+		Digest:      "digest",
+		ContentType: "image/png",
+		Metadata:    json.RawMessage(`{"key":"value"}`),
+		Scan:        app.Scan{Status: app.ScanInfected, Threat: "Eicar-Signature"},
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+	want := web.FileInfo(file)
+	want.Metadata = map[string]interface{}{"key": "value"}
+
+	params := app.ListParams{
+		Cursor:      "cursor",
+		Limit:       10,
+		MinSize:     1,
+		ContentType: "image/*",
+		Metadata:    json.RawMessage(`{"key":"value"}`),
+		SortBy:      app.SortBySize,
+		Desc:        true,
+	}
+
+	testCases := []struct {
+		name    string
+		appRes  []app.File
+		appErr  error
+		want    *models.FileList
+		wantErr *models.Error
+	}{
+		{"success", []app.File{file}, nil, &models.FileList{Files: []*models.FileInfo{want}, NextCursor: "next"}, nil},
+		{"err_not_valid_cursor", nil, app.ErrNotValidCursor, nil, APIError(app.ErrNotValidCursor.Error())},
+		{"err_any", nil, errAny, nil, APIError(http.StatusText(http.StatusInternalServerError))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			gomock.InOrder(
+				mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil),
+				mockApp.EXPECT().List(gomock.Any(), user, params).Return(tc.appRes, "next", tc.appErr),
+			)
+
+			res, err := client.Operations.ListFiles(operations.NewListFilesParams().
+				WithCursor(swag.String("cursor")).
+				WithLimit(swag.Int32(10)).
+				WithMinSize(swag.Int64(1)).
+				WithContentType(swag.String("image/*")).
+				WithMetadata(swag.String(`{"key":"value"}`)).
+				WithSortBy(swag.String("size")).
+				WithOrder(swag.String("desc")), apiKeyAuth)
+			assert.Equal(tc.wantErr, errPayload(err))
+			if tc.wantErr == nil {
+				assert.Equal(tc.want, res.Payload)
+			}
+		})
+	}
+}
+
+func TestService_GetFileRange(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	fileBuf, err := os.ReadFile(testFile)
+	assert.NoError(err)
+
+	var (
+		fileID    = uuid.Must(uuid.NewV4())
+		updatedAt = time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+		etag      = fmt.Sprintf(`"%s-%x"`, fileID, updatedAt.UnixNano())
+		size      = len(fileBuf)
+		sum       = sha256.Sum256(fileBuf)
+		digest    = "sha-256=" + base64.StdEncoding.EncodeToString(sum[:])
+	)
+
+	testCases := []struct {
+		name       string
+		headers    map[string]string
+		wantStatus int
+		want       []byte
+	}{
+		{"full", nil, http.StatusOK, fileBuf},
+		{"range", map[string]string{"Range": "bytes=10-99"}, http.StatusPartialContent, fileBuf[10:100]},
+		{"range_suffix", map[string]string{"Range": "bytes=-100"}, http.StatusPartialContent, fileBuf[size-100:]},
+		{"if_range_match", map[string]string{"Range": "bytes=0-9", "If-Range": etag}, http.StatusPartialContent, fileBuf[:10]},
+		{"if_range_mismatch", map[string]string{"Range": "bytes=0-9", "If-Range": `"other"`}, http.StatusOK, fileBuf},
+		{"if_none_match", map[string]string{"If-None-Match": etag}, http.StatusNotModified, []byte{}},
+		{"if_modified_since", map[string]string{"If-Modified-Since": updatedAt.Format(http.TimeFormat)}, http.StatusNotModified, []byte{}},
+		{"not_satisfiable", map[string]string{"Range": fmt.Sprintf("bytes=%d-", size)}, http.StatusRequestedRangeNotSatisfiable, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			file, err := os.Open(testFile)
+			assert.NoError(err)
+
+			appFile := &app.File{
+				ReadSeekCloser: file,
+				ID:             fileID,
+				Size:           int64(size),
+				Digest:         hex.EncodeToString(sum[:]),
+				UpdatedAt:      updatedAt,
+			}
+
+			url, mockApp, _, assert, _ := start(t)
+
+			mockApp.EXPECT().GetFile(gomock.Any(), app.Anonymous, fileID).Return(appFile, nil)
+
+			resp := getFile(t, url, fileID, tc.headers)
+			defer resp.Body.Close()
+
+			assert.Equal(tc.wantStatus, resp.StatusCode)
+			if tc.wantStatus == http.StatusRequestedRangeNotSatisfiable {
+				return
+			}
+
+			assert.Equal(etag, resp.Header.Get("ETag"))
+			assert.Equal(digest, resp.Header.Get("Digest"))
+			if tc.wantStatus != http.StatusNotModified {
+				assert.Equal(updatedAt.Format(http.TimeFormat), resp.Header.Get("Last-Modified"))
+			}
+
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(err)
+			assert.Equal(tc.want, body)
+		})
+	}
+
+	t.Run("multi_range", func(t *testing.T) {
+		t.Parallel()
+
+		file, err := os.Open(testFile)
+		assert.NoError(err)
+
+		appFile := &app.File{
+			ReadSeekCloser: file,
+			ID:             fileID,
+			Size:           int64(size),
+			UpdatedAt:      updatedAt,
+		}
+
+		url, mockApp, _, assert, _ := start(t)
+
+		mockApp.EXPECT().GetFile(gomock.Any(), app.Anonymous, fileID).Return(appFile, nil)
+
+		resp := getFile(t, url, fileID, map[string]string{"Range": "bytes=0-9,100-199"})
+		defer resp.Body.Close()
+
+		assert.Equal(http.StatusPartialContent, resp.StatusCode)
+
+		mediaType, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		assert.NoError(err)
+		assert.Equal("multipart/byteranges", mediaType)
+
+		want := [][]byte{fileBuf[0:10], fileBuf[100:200]}
+		r := multipart.NewReader(resp.Body, params["boundary"])
+		for i := range want {
+			part, err := r.NextPart()
+			assert.NoError(err)
+
+			body, err := io.ReadAll(part)
+			assert.NoError(err)
+			assert.Equal(want[i], body)
+		}
+
+		_, err = r.NextPart()
+		assert.ErrorIs(err, io.EOF)
+	})
+}
+
+// encodedFile is file content stored in gzip.
+type encodedFile struct {
+	*bytes.Reader
+	gzipped []byte
+}
+
+func (encodedFile) Close() error { return nil }
+
+func (f encodedFile) Encoded(encoding string) (io.Reader, bool) {
+	return bytes.NewReader(f.gzipped), encoding == "gzip"
+}
+
+func TestService_GetFileEncoded(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	content := bytes.Repeat([]byte("compressible text "), 100)
+	gzipped := &bytes.Buffer{}
+	w := gzip.NewWriter(gzipped)
+	_, err := w.Write(content)
+	assert.NoError(err)
+	assert.NoError(w.Close())
+
+	var (
+		fileID      = uuid.Must(uuid.NewV4())
+		updatedAt   = time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+		etag        = fmt.Sprintf(`"%s-%x"`, fileID, updatedAt.UnixNano())
+		etagEncoded = fmt.Sprintf(`"%s-%x-gzip"`, fileID, updatedAt.UnixNano())
+	)
+
+	testCases := []struct {
+		name         string
+		headers      map[string]string
+		wantStatus   int
+		wantEncoding string
+		wantETag     string
+		want         []byte
+	}{
+		{"gzip", map[string]string{"Accept-Encoding": "br, gzip"}, http.StatusOK, "gzip", etagEncoded, gzipped.Bytes()},
+		{"gzip_any", map[string]string{"Accept-Encoding": "*"}, http.StatusOK, "gzip", etagEncoded, gzipped.Bytes()},
+		{"gzip_not_modified", map[string]string{"Accept-Encoding": "gzip", "If-None-Match": etagEncoded}, http.StatusNotModified, "", etagEncoded, []byte{}},
+		{"gzip_modified", map[string]string{"Accept-Encoding": "gzip", "If-None-Match": etag}, http.StatusOK, "gzip", etagEncoded, gzipped.Bytes()},
+		{"identity", map[string]string{"Accept-Encoding": "identity"}, http.StatusOK, "", etag, content},
+		{"gzip_refused", map[string]string{"Accept-Encoding": "gzip;q=0"}, http.StatusOK, "", etag, content},
+		{"range", map[string]string{"Accept-Encoding": "gzip", "Range": "bytes=0-9"}, http.StatusPartialContent, "", etag, content[:10]},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			appFile := &app.File{
+				ReadSeekCloser: encodedFile{Reader: bytes.NewReader(content), gzipped: gzipped.Bytes()},
+				ID:             fileID,
+				Size:           int64(len(content)),
+				ContentType:    "text/plain; charset=utf-8",
+				UpdatedAt:      updatedAt,
+			}
+
+			url, mockApp, _, assert, _ := start(t)
+
+			mockApp.EXPECT().GetFile(gomock.Any(), app.Anonymous, fileID).Return(appFile, nil)
+
+			resp := getFile(t, url, fileID, tc.headers)
+			defer resp.Body.Close()
+
+			assert.Equal(tc.wantStatus, resp.StatusCode)
+			assert.Equal(tc.wantEncoding, resp.Header.Get("Content-Encoding"))
+			assert.Equal(tc.wantETag, resp.Header.Get("ETag"))
+			assert.Equal("Accept-Encoding", resp.Header.Get("Vary"))
+
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(err)
+			assert.Equal(tc.want, body)
+		})
+	}
+}
+
+func getFile(t *testing.T, url string, fileID uuid.UUID, headers map[string]string) *http.Response {
+	t.Helper()
+
+	path := fmt.Sprintf("http://%s%s/file?id=%s", url, client.DefaultBasePath, fileID)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, path, nil)
+	require.NoError(t, err)
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return resp
+}
@@ -1,8 +1,11 @@
 package web_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"testing"
@@ -10,6 +13,7 @@ import (
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/swag"
+	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
@@ -20,15 +24,27 @@ import (
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/client/operations"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
 	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/restapi"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
 	"github.com/Meat-Hook/back-template/libs/metrics"
 	libweb "github.com/Meat-Hook/back-template/libs/web"
 )
 
-var (
-	reg = prometheus.NewPedanticRegistry()
+const (
+	testFile = `test.jpg`
+	token    = "token"
 )
 
-const testFile = `test.jpg`
+var (
+	reg    = prometheus.NewPedanticRegistry()
+	errAny = errors.New("any error")
+
+	session = app.Session{
+		ID:     uuid.Must(uuid.NewV4()),
+		UserID: uuid.Must(uuid.NewV4()),
+	}
+	user    = app.UserPrincipal(session.UserID)
+	private = app.Access{Visibility: app.VisibilityPrivate}
+)
 
 func TestMain(m *testing.M) {
 	metrics.InitMetrics(reg)
@@ -36,7 +52,7 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
-func start(t *testing.T) (string, *Mockapplication, *client.FileService, *require.Assertions) {
+func start(t *testing.T) (string, *Mockapplication, *client.FileService, *require.Assertions, runtime.ClientAuthInfoWriter) {
 	t.Helper()
 
 	ctrl := gomock.NewController(t)
@@ -63,9 +79,28 @@ func start(t *testing.T) (string, *Mockapplication, *client.FileService, *requir
 	transport := httptransport.New(url, client.DefaultBasePath, client.DefaultSchemes)
 	transport.Consumers["image/jpeg"] = runtime.ByteStreamConsumer()
 	transport.Consumers["image/png"] = runtime.ByteStreamConsumer()
+	transport.Consumers["image/webp"] = runtime.ByteStreamConsumer()
 	c := client.New(transport, nil)
 
-	return url, mockApp, c, require.New(t)
+	return url, mockApp, c, require.New(t), httptransport.APIKeyAuth("Cookie", "header", "authKey="+token)
+}
+
+var _ gomock.Matcher = &fileMatcher{}
+
+type fileMatcher struct {
+	file   []byte
+	assert *require.Assertions
+}
+
+func (f fileMatcher) Matches(x interface{}) bool {
+	argFile, err := io.ReadAll(x.(io.Reader))
+	f.assert.NoError(err)
+
+	return bytes.Equal(argFile, f.file)
+}
+
+func (f fileMatcher) String() string {
+	return "fileMatcher"
 }
 
 // APIError returns model.Error with given msg.
@@ -79,6 +114,20 @@ func errPayload(err interface{}) *models.Error {
 	switch err := err.(type) {
 	case *operations.GetFileDefault:
 		return err.Payload
+	case *operations.SetFileAccessDefault:
+		return err.Payload
+	case *operations.ListFilesDefault:
+		return err.Payload
+	case *operations.CreateUploadDefault:
+		return err.Payload
+	case *operations.GetUploadDefault:
+		return err.Payload
+	case *operations.UploadPartDefault:
+		return err.Payload
+	case *operations.CompleteUploadDefault:
+		return err.Payload
+	case *operations.AbortUploadDefault:
+		return err.Payload
 	default:
 		return nil
 	}
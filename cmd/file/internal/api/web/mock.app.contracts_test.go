@@ -6,6 +6,8 @@ package web_test
 
 import (
 	context "context"
+	io "io"
+	net "net"
 	reflect "reflect"
 
 	app "github.com/Meat-Hook/back-template/cmd/file/internal/app"
@@ -36,17 +38,166 @@ func (m *Mockapplication) EXPECT() *MockapplicationMockRecorder {
 	return m.recorder
 }
 
+// AbortUpload mocks base method.
+func (m *Mockapplication) AbortUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortUpload", ctx, principal, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortUpload indicates an expected call of AbortUpload.
+func (mr *MockapplicationMockRecorder) AbortUpload(ctx, principal, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortUpload", reflect.TypeOf((*Mockapplication)(nil).AbortUpload), ctx, principal, sessionID)
+}
+
+// Auth mocks base method.
+func (m *Mockapplication) Auth(ctx context.Context, token string, origin app.Origin) (*app.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth", ctx, token, origin)
+	ret0, _ := ret[0].(*app.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Auth indicates an expected call of Auth.
+func (mr *MockapplicationMockRecorder) Auth(ctx, token, origin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*Mockapplication)(nil).Auth), ctx, token, origin)
+}
+
+// CompleteUpload mocks base method.
+func (m *Mockapplication) CompleteUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID, access app.Access) (*app.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", ctx, principal, sessionID, access)
+	ret0, _ := ret[0].(*app.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockapplicationMockRecorder) CompleteUpload(ctx, principal, sessionID, access interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*Mockapplication)(nil).CompleteUpload), ctx, principal, sessionID, access)
+}
+
+// CreateUpload mocks base method.
+func (m *Mockapplication) CreateUpload(ctx context.Context, owner app.Principal, size int64, policy app.Policy) (*app.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpload", ctx, owner, size, policy)
+	ret0, _ := ret[0].(*app.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUpload indicates an expected call of CreateUpload.
+func (mr *MockapplicationMockRecorder) CreateUpload(ctx, owner, size, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpload", reflect.TypeOf((*Mockapplication)(nil).CreateUpload), ctx, owner, size, policy)
+}
+
 // GetFile mocks base method.
-func (m *Mockapplication) GetFile(ctx context.Context, fileID uuid.UUID) (*app.File, error) {
+func (m *Mockapplication) GetFile(ctx context.Context, principal app.Principal, fileID uuid.UUID) (*app.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFile", ctx, fileID)
+	ret := m.ctrl.Call(m, "GetFile", ctx, principal, fileID)
 	ret0, _ := ret[0].(*app.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFile indicates an expected call of GetFile.
-func (mr *MockapplicationMockRecorder) GetFile(ctx, fileID interface{}) *gomock.Call {
+func (mr *MockapplicationMockRecorder) GetFile(ctx, principal, fileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFile", reflect.TypeOf((*Mockapplication)(nil).GetFile), ctx, principal, fileID)
+}
+
+// GetSignedFile mocks base method.
+func (m *Mockapplication) GetSignedFile(ctx context.Context, u app.SignedURL, ip net.IP) (*app.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignedFile", ctx, u, ip)
+	ret0, _ := ret[0].(*app.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignedFile indicates an expected call of GetSignedFile.
+func (mr *MockapplicationMockRecorder) GetSignedFile(ctx, u, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignedFile", reflect.TypeOf((*Mockapplication)(nil).GetSignedFile), ctx, u, ip)
+}
+
+// GetUpload mocks base method.
+func (m *Mockapplication) GetUpload(ctx context.Context, principal app.Principal, sessionID uuid.UUID) (*app.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpload", ctx, principal, sessionID)
+	ret0, _ := ret[0].(*app.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpload indicates an expected call of GetUpload.
+func (mr *MockapplicationMockRecorder) GetUpload(ctx, principal, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpload", reflect.TypeOf((*Mockapplication)(nil).GetUpload), ctx, principal, sessionID)
+}
+
+// GetVariant mocks base method.
+func (m *Mockapplication) GetVariant(ctx context.Context, principal app.Principal, fileID uuid.UUID, width int) (*app.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariant", ctx, principal, fileID, width)
+	ret0, _ := ret[0].(*app.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariant indicates an expected call of GetVariant.
+func (mr *MockapplicationMockRecorder) GetVariant(ctx, principal, fileID, width interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariant", reflect.TypeOf((*Mockapplication)(nil).GetVariant), ctx, principal, fileID, width)
+}
+
+// List mocks base method.
+func (m *Mockapplication) List(ctx context.Context, principal app.Principal, params app.ListParams) ([]app.File, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, principal, params)
+	ret0, _ := ret[0].([]app.File)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockapplicationMockRecorder) List(ctx, principal, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*Mockapplication)(nil).List), ctx, principal, params)
+}
+
+// SetAccess mocks base method.
+func (m *Mockapplication) SetAccess(ctx context.Context, principal app.Principal, fileID uuid.UUID, access app.Access) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccess", ctx, principal, fileID, access)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccess indicates an expected call of SetAccess.
+func (mr *MockapplicationMockRecorder) SetAccess(ctx, principal, fileID, access interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccess", reflect.TypeOf((*Mockapplication)(nil).SetAccess), ctx, principal, fileID, access)
+}
+
+// UploadPart mocks base method.
+func (m *Mockapplication) UploadPart(ctx context.Context, principal app.Principal, sessionID uuid.UUID, number int, part io.Reader) (*app.Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPart", ctx, principal, sessionID, number, part)
+	ret0, _ := ret[0].(*app.Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadPart indicates an expected call of UploadPart.
+func (mr *MockapplicationMockRecorder) UploadPart(ctx, principal, sessionID, number, part interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFile", reflect.TypeOf((*Mockapplication)(nil).GetFile), ctx, fileID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPart", reflect.TypeOf((*Mockapplication)(nil).UploadPart), ctx, principal, sessionID, number, part)
 }
@@ -0,0 +1,197 @@
+package web
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/rs/zerolog"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/restapi/operations"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/libs/log"
+)
+
+// encodingGzip is content encoding which can be written without decoding.
+const encodingGzip = "gzip"
+
+var _ operations.GetFileResponder = &fileResponder{}
+
+// fileResponder writes file content using the seekable file reader.
+// Handles Range, If-Range, If-None-Match and If-Modified-Since headers
+// and answers with 200, 206, 304 or 416 codes.
+// Content stored in gzip is written without decoding if client accepts it.
+type fileResponder struct {
+	file *app.File
+	req  *http.Request
+	// disposition is Content-Disposition header value, not set if empty.
+	disposition string
+}
+
+// GetFileResponder implements operations.GetFileResponder.
+func (*fileResponder) GetFileResponder() {}
+
+// WriteResponse implements middleware.Responder.
+func (r *fileResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	defer log.WarnIfFail(*zerolog.Ctx(r.req.Context()), r.file.Close)
+
+	// Replaces content type negotiated by middleware, ServeContent sniffs
+	// it by itself for files uploaded before content type was detected.
+	rw.Header().Del(runtime.HeaderContentType)
+	if r.file.ContentType != "" {
+		rw.Header().Set(runtime.HeaderContentType, r.file.ContentType)
+	}
+	rw.Header().Set("ETag", etag(r.file))
+	if d := digest(r.file); d != "" {
+		rw.Header().Set("Digest", d)
+	}
+	if r.disposition != "" {
+		rw.Header().Set("Content-Disposition", r.disposition)
+	}
+
+	if encoded, ok := r.encoded(rw); ok {
+		r.serveEncoded(rw, encoded)
+		return
+	}
+
+	http.ServeContent(rw, r.req, "", r.file.UpdatedAt, r.file)
+}
+
+// encoded returns gzipped content if it is stored in gzip,
+// client accepts gzip and doesn't request byte ranges.
+func (r *fileResponder) encoded(rw http.ResponseWriter) (io.Reader, bool) {
+	file, ok := r.file.ReadSeekCloser.(app.EncodedReader)
+	if !ok {
+		return nil, false
+	}
+
+	encoded, ok := file.Encoded(encodingGzip)
+	if !ok {
+		return nil, false
+	}
+
+	rw.Header().Add("Vary", "Accept-Encoding")
+	if r.req.Header.Get("Range") != "" || !acceptsGzip(r.req.Header.Get("Accept-Encoding")) {
+		return nil, false
+	}
+
+	return encoded, true
+}
+
+// serveEncoded writes gzipped content, which has own ETag,
+// handles If-None-Match and If-Modified-Since headers.
+func (r *fileResponder) serveEncoded(rw http.ResponseWriter, encoded io.Reader) {
+	tag := etagEncoded(r.file, encodingGzip)
+	rw.Header().Set("ETag", tag)
+	// Digest is calculated on decoded content.
+	rw.Header().Del("Digest")
+	if !r.file.UpdatedAt.IsZero() {
+		rw.Header().Set("Last-Modified", r.file.UpdatedAt.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(r.req, tag, r.file.UpdatedAt) {
+		rw.Header().Del(runtime.HeaderContentType)
+		rw.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	rw.Header().Set("Content-Encoding", encodingGzip)
+	rw.WriteHeader(http.StatusOK)
+	if r.req.Method == http.MethodHead {
+		return
+	}
+
+	_, err := io.Copy(rw, encoded)
+	if err != nil {
+		zerolog.Ctx(r.req.Context()).Warn().Err(err).Msg("write encoded file")
+	}
+}
+
+var _ operations.GetFileResponder = &fileErrorResponder{}
+
+// fileErrorResponder writes error as JSON, because content type negotiated
+// by middleware for file endpoint can be one of the file types.
+type fileErrorResponder struct {
+	*operations.GetFileDefault
+}
+
+// WriteResponse implements middleware.Responder.
+func (r *fileErrorResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+	r.GetFileDefault.WriteResponse(rw, runtime.JSONProducer())
+}
+
+func fileError(code int, msg string) *fileErrorResponder {
+	return &fileErrorResponder{operations.NewGetFileDefault(code).WithPayload(apiError(msg))}
+}
+
+func etag(f *app.File) string {
+	return fmt.Sprintf(`"%s-%x"`, f.ID, f.UpdatedAt.UnixNano())
+}
+
+func etagEncoded(f *app.File, encoding string) string {
+	return fmt.Sprintf(`"%s-%x-%s"`, f.ID, f.UpdatedAt.UnixNano(), encoding)
+}
+
+// acceptsGzip returns true if Accept-Encoding header value allows gzip.
+func acceptsGzip(header string) bool {
+	for _, coding := range strings.Split(header, ",") {
+		name, params := coding, ""
+		if i := strings.Index(coding, ";"); i >= 0 {
+			name, params = coding[:i], coding[i+1:]
+		}
+
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != encodingGzip && name != "*" {
+			continue
+		}
+
+		q := strings.TrimSpace(params)
+		if !strings.HasPrefix(q, "q=") {
+			return true
+		}
+
+		weight, err := strconv.ParseFloat(strings.TrimPrefix(q, "q="), 64)
+		return err == nil && weight > 0
+	}
+
+	return false
+}
+
+// notModified returns true if If-None-Match or, without it, If-Modified-Since header
+// is matched by file (RFC 7232).
+func notModified(req *http.Request, tag string, modTime time.Time) bool {
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		for _, t := range strings.Split(inm, ",") {
+			t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+			if t == "*" || t == strings.TrimPrefix(tag, "W/") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	ims, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil || modTime.IsZero() {
+		return false
+	}
+
+	return !modTime.Truncate(time.Second).After(ims)
+}
+
+// digest returns value for Digest header (RFC 3230) of the full file content.
+func digest(f *app.File) string {
+	sum, err := hex.DecodeString(f.Digest)
+	if err != nil || len(sum) == 0 {
+		return ""
+	}
+
+	return "sha-256=" + base64.StdEncoding.EncodeToString(sum)
+}
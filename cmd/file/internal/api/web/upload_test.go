@@ -0,0 +1,258 @@
+package web_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/client/operations"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/api/web/generated/models"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+func TestService_CreateUpload(t *testing.T) {
+	t.Parallel()
+
+	upload := &app.UploadSession{ID: uuid.Must(uuid.NewV4()), Size: 100}
+
+	testCases := []struct {
+		name    string
+		appRes  *app.UploadSession
+		appErr  error
+		want    *models.UploadSession
+		wantErr *models.Error
+	}{
+		{"success", upload, nil, web.UploadSession(upload), nil},
+		{"err_not_valid_size", nil, app.ErrNotValidSize, nil, APIError(app.ErrNotValidSize.Error())},
+		{"err_access_denied", nil, app.ErrAccessDenied, nil, APIError(app.ErrAccessDenied.Error())},
+		{"err_any", nil, errAny, nil, APIError(http.StatusText(http.StatusInternalServerError))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			gomock.InOrder(
+				mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil),
+				mockApp.EXPECT().CreateUpload(gomock.Any(), user, int64(100), app.Policy{}).Return(tc.appRes, tc.appErr),
+			)
+
+			params := operations.NewCreateUploadParams().
+				WithArgs(operations.CreateUploadBody{Size: swag.Int64(100)})
+
+			res, err := client.Operations.CreateUpload(params, apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.NoError(err)
+				assert.Equal(tc.want, res.Payload)
+				assert.Equal("/file/api/v1/uploads/"+upload.ID.String(), res.Location)
+			} else {
+				assert.Nil(res)
+				assert.Equal(tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestService_GetUpload(t *testing.T) {
+	t.Parallel()
+
+	upload := &app.UploadSession{
+		ID:    uuid.Must(uuid.NewV4()),
+		Size:  100,
+		Parts: []app.Part{{Number: 1, Size: 10}, {Number: 2, Size: 20}, {Number: 4, Size: 10}},
+	}
+
+	testCases := []struct {
+		name    string
+		appRes  *app.UploadSession
+		appErr  error
+		want    *models.UploadSession
+		wantErr *models.Error
+	}{
+		{"success", upload, nil, web.UploadSession(upload), nil},
+		{"err_not_found", nil, app.ErrNotFound, nil, APIError(app.ErrNotFound.Error())},
+		{"err_access_denied", nil, app.ErrAccessDenied, nil, APIError(app.ErrAccessDenied.Error())},
+		{"err_any", nil, errAny, nil, APIError(http.StatusText(http.StatusInternalServerError))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			gomock.InOrder(
+				mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil),
+				mockApp.EXPECT().GetUpload(gomock.Any(), user, upload.ID).Return(tc.appRes, tc.appErr),
+			)
+
+			params := operations.NewGetUploadParams().WithID(strfmt.UUID(upload.ID.String()))
+
+			res, err := client.Operations.GetUpload(params, apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.NoError(err)
+				assert.Equal(tc.want, res.Payload)
+				assert.Equal(int64(30), res.UploadOffset)
+				assert.Equal(int64(100), res.UploadLength)
+			} else {
+				assert.Nil(res)
+				assert.Equal(tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestService_UploadPart(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sessionID = uuid.Must(uuid.NewV4())
+		content   = []byte("part content")
+		part      = &app.Part{Number: 3, Size: int64(len(content))}
+	)
+
+	testCases := []struct {
+		name    string
+		appRes  *app.Part
+		appErr  error
+		want    *models.Part
+		wantErr *models.Error
+	}{
+		{"success", part, nil, web.Part(*part), nil},
+		{"err_not_found", nil, app.ErrNotFound, nil, APIError(app.ErrNotFound.Error())},
+		{"err_too_large", nil, app.ErrPartTooLarge, nil, APIError(app.ErrPartTooLarge.Error())},
+		{"err_empty", nil, app.ErrEmptyPart, nil, APIError(app.ErrEmptyPart.Error())},
+		{"err_quota_exceeded", nil, app.ErrQuotaExceeded, nil, APIError(app.ErrQuotaExceeded.Error())},
+		{"err_any", nil, errAny, nil, APIError(http.StatusText(http.StatusInternalServerError))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			gomock.InOrder(
+				mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil),
+				mockApp.EXPECT().UploadPart(gomock.Any(), user, sessionID, 3, fileMatcher{content, assert}).Return(tc.appRes, tc.appErr),
+			)
+
+			params := operations.NewUploadPartParams().
+				WithID(strfmt.UUID(sessionID.String())).
+				WithNumber(3).
+				WithPart(io.NopCloser(bytes.NewReader(content)))
+
+			res, err := client.Operations.UploadPart(params, apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.NoError(err)
+				assert.Equal(tc.want, res.Payload)
+			} else {
+				assert.Nil(res)
+				assert.Equal(tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestService_CompleteUpload(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sessionID = uuid.Must(uuid.NewV4())
+		file      = &app.File{ID: uuid.Must(uuid.NewV4()), Size: 100, Digest: "digest", Scan: app.Scan{Status: app.ScanPending}}
+	)
+
+	testCases := []struct {
+		name    string
+		appRes  *app.File
+		appErr  error
+		want    *models.UploadedFile
+		wantErr *models.Error
+	}{
+		{"success", file, nil, web.UploadedFile(file), nil},
+		{"err_not_found", nil, app.ErrNotFound, nil, APIError(app.ErrNotFound.Error())},
+		{"err_incomplete", nil, app.ErrUploadIncomplete, nil, APIError(app.ErrUploadIncomplete.Error())},
+		{"err_quota_exceeded", nil, app.ErrQuotaExceeded, nil, APIError(app.ErrQuotaExceeded.Error())},
+		{"err_infected", nil, app.ErrInfected, nil, APIError(app.ErrInfected.Error())},
+		{"err_any", nil, errAny, nil, APIError(http.StatusText(http.StatusInternalServerError))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			gomock.InOrder(
+				mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil),
+				mockApp.EXPECT().CompleteUpload(gomock.Any(), user, sessionID, private).Return(tc.appRes, tc.appErr),
+			)
+
+			params := operations.NewCompleteUploadParams().WithID(strfmt.UUID(sessionID.String()))
+
+			res, err := client.Operations.CompleteUpload(params, apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.NoError(err)
+				assert.Equal(tc.want, res.Payload)
+			} else {
+				assert.Nil(res)
+				assert.Equal(tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
+
+func TestService_AbortUpload(t *testing.T) {
+	t.Parallel()
+
+	sessionID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name    string
+		appErr  error
+		wantErr *models.Error
+	}{
+		{"success", nil, nil},
+		{"err_not_found", app.ErrNotFound, APIError(app.ErrNotFound.Error())},
+		{"err_access_denied", app.ErrAccessDenied, APIError(app.ErrAccessDenied.Error())},
+		{"err_any", errAny, APIError(http.StatusText(http.StatusInternalServerError))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			gomock.InOrder(
+				mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil),
+				mockApp.EXPECT().AbortUpload(gomock.Any(), user, sessionID).Return(tc.appErr),
+			)
+
+			params := operations.NewAbortUploadParams().WithID(strfmt.UUID(sessionID.String()))
+
+			res, err := client.Operations.AbortUpload(params, apiKeyAuth)
+			if tc.wantErr == nil {
+				assert.NoError(err)
+				assert.NotNil(res)
+			} else {
+				assert.Nil(res)
+				assert.Equal(tc.wantErr, errPayload(err))
+			}
+		})
+	}
+}
@@ -1,16 +1,85 @@
 package app
 
-// MaxChunkSize max size of file chunk.
-const MaxChunkSize = 4096
+import (
+	"time"
+)
+
+const (
+	// MaxChunkSize max size of file chunk.
+	MaxChunkSize = 4096
+	// MaxPartSize max size of upload session part.
+	MaxPartSize = 64 << 20
+	// MaxParts max amount of upload session parts.
+	MaxParts = 10000
+	// MaxVariantSize max size of resized image variant.
+	MaxVariantSize = 4 << 20
+)
+
+// Sort fields.
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortBySize      SortField = "size"
+)
+
+// List limits.
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// Visibilities.
+const (
+	VisibilityPrivate Visibility = "private"
+	VisibilityPublic  Visibility = "public"
+	VisibilityShared  Visibility = "shared"
+)
+
+// Scan statuses.
+const (
+	// ScanSkipped file was uploaded without scanning.
+	ScanSkipped ScanStatus = "skipped"
+	// ScanPending file is quarantined until it is scanned.
+	ScanPending  ScanStatus = "pending"
+	ScanClean    ScanStatus = "clean"
+	ScanInfected ScanStatus = "infected"
+)
+
+// MaxSignTTL max lifetime of signed download URL.
+const MaxSignTTL = 7 * 24 * time.Hour
+
+// MaxRefLen max length of file reference.
+const MaxRefLen = 255
+
+// Anonymous principal of caller without identity.
+const Anonymous Principal = ""
+
+// AnyOwner matches files of all owners in list params.
+const AnyOwner Principal = "*"
+
+// Widths contains allowed widths of resized image variants.
+var Widths = []int{64, 256, 1024}
 
 // Module contains business logic for file methods.
 type Module struct {
-	file Repo
+	file    Repo
+	blob    BlobStore
+	upload  UploadRepo
+	thumb   Thumbnailer
+	auth    AuthSvc
+	signer  Signer
+	scanner Scanner
 }
 
-// New build and returns new session module.
-func New(r Repo) *Module {
+// New build and returns new file module.
+// Scanner is optional, uploaded files aren't scanned if it is nil.
+func New(r Repo, b BlobStore, u UploadRepo, t Thumbnailer, a AuthSvc, s Signer, sc Scanner) *Module {
 	return &Module{
-		file: r,
+		file:    r,
+		blob:    b,
+		upload:  u,
+		thumb:   t,
+		auth:    a,
+		signer:  s,
+		scanner: sc,
 	}
 }
@@ -4,24 +4,157 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"time"
 
 	"github.com/gofrs/uuid"
 )
 
 type (
-	// Repo interface for session data repository.
+	// Repo interface for file info data repository.
 	Repo interface {
-		// Save saves the new file to database.
-		// Errors: unknown.
-		Save(context.Context, io.Reader) (uuid.UUID, error)
-		// Read returns file by id.
+		// Create adds the new empty file info with owner and access to database and returns its id.
+		// File is charged to account quota in same transaction.
+		// Errors: ErrQuotaExceeded, unknown.
+		Create(ctx context.Context, owner, account Principal, access Access) (uuid.UUID, error)
+		// SetContent set the file content size, digest, content type and scan status.
+		// Content size is charged to file account quota in same transaction.
+		// Errors: ErrNotFound, ErrQuotaExceeded, unknown.
+		SetContent(context.Context, *File) error
+		// ByID returns file info by id.
+		// Errors: ErrNotFound, unknown.
+		ByID(context.Context, uuid.UUID) (*File, error)
+		// SetAccess replaces the file visibility and principals it is shared with.
 		// Errors: ErrNotFound, unknown.
-		Read(context.Context, uuid.UUID) (*File, error)
+		SetAccess(ctx context.Context, fileID uuid.UUID, access Access) error
 		// SetMetadata set the file metadata.
 		// Errors: ErrNotFound, unknown.
 		SetMetadata(context.Context, uuid.UUID, json.RawMessage) error
-		// Delete removes file with metadata from database.
+		// Delete removes file info with metadata from database.
+		// File and its size are returned to account quota in same transaction.
 		// Errors: unknown.
 		Delete(context.Context, uuid.UUID) error
+		// SaveVariant links derived file with resized image to original file.
+		// Errors: ErrVariantExist, unknown.
+		SaveVariant(ctx context.Context, fileID uuid.UUID, width int, variantID uuid.UUID) error
+		// Variant returns id of derived file with resized image.
+		// Errors: ErrNotFound, unknown.
+		Variant(ctx context.Context, fileID uuid.UUID, width int) (uuid.UUID, error)
+		// Variants returns ids of all derived files of original file.
+		// Errors: unknown.
+		Variants(context.Context, uuid.UUID) ([]uuid.UUID, error)
+		// List returns files of owner, of all owners for AnyOwner, matched by params filters
+		// and sorted by params sort field, starting after cursor position if it isn't nil.
+		// Derived files are skipped.
+		// Errors: unknown.
+		List(ctx context.Context, owner Principal, params ListParams, after *Cursor) ([]File, error)
+		// Claim adds reference to file, adding existing reference does nothing.
+		// Errors: ErrNotFound, unknown.
+		Claim(ctx context.Context, fileID uuid.UUID, ref string) error
+		// Release removes reference to file.
+		// Errors: ErrNotFound, unknown.
+		Release(ctx context.Context, fileID uuid.UUID, ref string) error
+		// Orphans returns up to limit ids of files uploaded before createdBefore
+		// which have no references. Derived files are skipped.
+		// Errors: unknown.
+		Orphans(ctx context.Context, createdBefore time.Time, limit int) ([]uuid.UUID, error)
+		// Quota returns limits and usage of owner, owner without files has default limits.
+		// Errors: unknown.
+		Quota(ctx context.Context, owner Principal) (*Quota, error)
+		// SetQuota replaces limits of owner and returns quota with usage.
+		// Errors: unknown.
+		SetQuota(ctx context.Context, owner Principal, maxBytes, maxFiles int64) (*Quota, error)
+		// SetScan saves result of scanning file content, scan time is set to current time.
+		// Errors: ErrNotFound, unknown.
+		SetScan(ctx context.Context, fileID uuid.UUID, status ScanStatus, threat string) error
+		// Quarantined returns up to limit ids of files with ScanPending status, oldest first.
+		// Errors: unknown.
+		Quarantined(ctx context.Context, limit int) ([]uuid.UUID, error)
+	}
+
+	// BlobStore interface for file content storage.
+	BlobStore interface {
+		// Put saves the file content and returns its size.
+		// Content type allows storage to choose how content is compressed.
+		// Errors: unknown.
+		Put(ctx context.Context, fileID uuid.UUID, contentType string, r io.Reader) (int64, error)
+		// Get returns the file content.
+		// Content can implement EncodedReader.
+		// Errors: ErrNotFound, unknown.
+		Get(context.Context, uuid.UUID) (io.ReadSeekCloser, error)
+		// Delete removes the file content.
+		// Must be called before removing file info.
+		// Errors: unknown.
+		Delete(context.Context, uuid.UUID) error
+	}
+
+	// ChunkReaper is implemented by BlobStore which can keep content chunks
+	// after removing file content, e.g. when saving was interrupted.
+	ChunkReaper interface {
+		// DeleteDangling removes up to limit chunks which don't belong to any file
+		// and returns amount of them, chunks are only counted if dryRun.
+		// Errors: unknown.
+		DeleteDangling(ctx context.Context, limit int, dryRun bool) (int64, error)
+	}
+
+	// EncodedReader is implemented by file content which is stored compressed
+	// and can be read without decoding.
+	EncodedReader interface {
+		// Encoded returns whole content in encoding, e.g. "gzip",
+		// returns false if content can't be read in this encoding.
+		Encoded(encoding string) (io.Reader, bool)
+	}
+
+	// Thumbnailer interface for image resizing.
+	Thumbnailer interface {
+		// Resize decodes image, scales it down to width keeping aspect ratio
+		// and writes encoded result.
+		// Errors: ErrNotImage, ErrImageTooLarge, unknown.
+		Resize(w io.Writer, r io.Reader, width int) error
+	}
+
+	// Scanner interface for scanning file content for malware.
+	Scanner interface {
+		// Scan reads whole content and returns name of found threat,
+		// empty name if content is clean.
+		// Errors: unknown.
+		Scan(ctx context.Context, r io.Reader) (threat string, err error)
+	}
+
+	// AuthSvc interface for checking user session.
+	AuthSvc interface {
+		// Session returns user session by his token, session usage from origin
+		// rejected by session service policy is treated as unknown session.
+		// Errors: ErrNotFound, unknown.
+		Session(ctx context.Context, token string, origin Origin) (*Session, error)
+	}
+
+	// Signer interface for signing download URLs.
+	Signer interface {
+		// Sign returns signature of data, same data always has same signature.
+		Sign(data []byte) []byte
+	}
+
+	// UploadRepo interface for resumable upload sessions data repository.
+	UploadRepo interface {
+		// CreateSession adds the new upload session of owner with declared file size and upload policy.
+		// Errors: unknown.
+		CreateSession(ctx context.Context, owner Principal, size int64, policy Policy) (*UploadSession, error)
+		// Session returns upload session with received parts by id.
+		// Errors: ErrNotFound, unknown.
+		Session(context.Context, uuid.UUID) (*UploadSession, error)
+		// SavePart saves part content, replacing previous content with same number,
+		// and returns part size.
+		// Errors: ErrNotFound, unknown.
+		SavePart(ctx context.Context, sessionID uuid.UUID, number int, r io.Reader) (int64, error)
+		// Content returns content of all session parts in number order.
+		// Errors: unknown.
+		Content(context.Context, uuid.UUID) (io.ReadCloser, error)
+		// DeleteSession removes upload session with all parts.
+		// Errors: unknown.
+		DeleteSession(context.Context, uuid.UUID) error
+		// DeleteStale removes upload sessions which have not been updated since
+		// given time and returns amount of removed sessions.
+		// Errors: unknown.
+		DeleteStale(ctx context.Context, updatedBefore time.Time) (int64, error)
 	}
 )
@@ -3,6 +3,10 @@ package app
 import (
 	"encoding/json"
 	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
 )
@@ -15,7 +19,349 @@ type (
 		ID uuid.UUID
 		// Size contains file size.
 		Size int64
+		// Digest contains hex encoded SHA-256 of file content.
+		// Empty for files uploaded before digest was calculated.
+		Digest string
+		// ContentType contains MIME type detected by file content.
+		ContentType string
+		// Owner contains principal who uploaded file.
+		Owner Principal
+		// Account contains principal charged for file in quotas.
+		Account Principal
+		// Access contains who can read file besides owner.
+		Access Access
 		// Metadata contains file meta info.
 		Metadata json.RawMessage
+		// Scan contains result of scanning file content.
+		Scan Scan
+		// CreatedAt contains time of file upload.
+		CreatedAt time.Time
+		// UpdatedAt contains time of last file update.
+		UpdatedAt time.Time
 	}
+
+	// ScanStatus contains state of scanning file content for malware.
+	ScanStatus string
+
+	// Scan contains result of scanning file content.
+	Scan struct {
+		// Status of scanning, quarantined and infected files can't be downloaded.
+		Status ScanStatus
+		// Threat contains name of malware found in infected file.
+		Threat string
+		// ScannedAt contains time of scanning, zero if file isn't scanned yet.
+		ScannedAt time.Time
+	}
+
+	// Principal contains identity of caller, e.g. "user:<id>" or "service:<name>".
+	// Empty principal is anonymous caller.
+	Principal string
+
+	// Visibility contains who can read file besides owner.
+	Visibility string
+
+	// Access contains file read permissions.
+	Access struct {
+		// Visibility of file.
+		Visibility Visibility
+		// SharedWith contains principals who can read file with VisibilityShared.
+		SharedWith []Principal
+	}
+
+	// Session contains user session information.
+	Session struct {
+		ID     uuid.UUID
+		UserID uuid.UUID
+	}
+
+	// Origin information about req user.
+	Origin struct {
+		IP        net.IP
+		UserAgent string
+	}
+
+	// Policy contains caller restrictions for uploaded file.
+	Policy struct {
+		// AllowedTypes contains allowed MIME types, e.g. "image/png" or "image/*".
+		// Any type is allowed if empty.
+		AllowedTypes []string
+		// MaxSize contains max file size, unlimited if 0.
+		MaxSize int64
+		// Account contains principal charged for file in quotas, e.g. user who uploads
+		// file through service. File owner is charged if empty.
+		// Only internal service can charge other principal, it can charge users only.
+		Account Principal
+	}
+
+	// Quota contains limits and usage of storage by files charged to owner.
+	Quota struct {
+		// Owner contains principal charged for files.
+		Owner Principal
+		// MaxBytes contains max size of all files, unlimited if 0.
+		MaxBytes int64
+		// MaxFiles contains max amount of files, unlimited if 0.
+		MaxFiles int64
+		// UsedBytes contains size of all files.
+		UsedBytes int64
+		// UsedFiles contains amount of files.
+		UsedFiles int64
+	}
+
+	// SortField contains file field for sorting file list.
+	SortField string
+
+	// ListParams contains params for search files.
+	ListParams struct {
+		// Owner filters files by owner, caller's files are listed if empty.
+		// Files of other owners or of AnyOwner are listed for internal services only.
+		Owner Principal
+		// Cursor contains position returned with previous page, empty for first page.
+		Cursor string
+		// Limit contains max amount of files in page, DefaultListLimit if 0.
+		Limit int
+		// MinSize and MaxSize filter files by size, not applied if 0.
+		MinSize int64
+		MaxSize int64
+		// CreatedAfter and CreatedBefore filter files by creation time, not applied if zero.
+		CreatedAfter  time.Time
+		CreatedBefore time.Time
+		// ContentType filters files by MIME type, e.g. "image/png" or "image/*".
+		ContentType string
+		// Metadata filters files which metadata contains given JSON object.
+		Metadata json.RawMessage
+		// SortBy contains field for sorting, SortByCreatedAt if empty.
+		SortBy SortField
+		// Desc sets descending sort order.
+		Desc bool
+	}
+
+	// Cursor contains position of last file in page.
+	// Files are ordered by sort field, then by id.
+	Cursor struct {
+		ID        uuid.UUID `json:"id"`
+		CreatedAt time.Time `json:"created_at"`
+		Size      int64     `json:"size"`
+		SortBy    SortField `json:"sort_by"`
+		Desc      bool      `json:"desc"`
+	}
+
+	// UploadSession contains info about resumable upload.
+	UploadSession struct {
+		// ID session id.
+		ID uuid.UUID
+		// Owner contains principal who started upload.
+		Owner Principal
+		// Size contains declared size of whole file, 0 if unknown.
+		Size int64
+		// Policy contains restrictions for file built from parts.
+		Policy Policy
+		// Parts contains received parts ordered by number.
+		Parts []Part
+		// CreatedAt contains time of session creation.
+		CreatedAt time.Time
+		// UpdatedAt contains time of last received part.
+		UpdatedAt time.Time
+	}
+
+	// SignedURL contains params of download URL signed by file service.
+	SignedURL struct {
+		// FileID contains id of downloaded file.
+		FileID uuid.UUID
+		// ExpiresAt contains time after which URL isn't valid.
+		ExpiresAt time.Time
+		// Disposition contains Content-Disposition header value, e.g. "attachment; filename=a.png".
+		// Header isn't set if empty.
+		Disposition string
+		// IP binds URL to client address, URL is valid for any address if nil.
+		IP net.IP
+		// Signature contains base64url encoded signature of all other fields.
+		Signature string
+	}
+
+	// ReapParams contains params for removing unused files and chunks.
+	ReapParams struct {
+		// TTL contains time during which file can be claimed after upload,
+		// released files are removed not earlier than TTL after upload too.
+		TTL time.Duration
+		// Limit contains max amount of removed files and max amount of removed chunks.
+		Limit int
+		// DryRun only finds files and counts chunks without removing.
+		DryRun bool
+	}
+
+	// ReapResult contains files and chunks removed by reaper.
+	ReapResult struct {
+		// Files contains ids of removed files without derived ones.
+		Files []uuid.UUID
+		// Chunks contains amount of removed chunks.
+		Chunks int64
+	}
+
+	// Part contains info about received part of upload session.
+	Part struct {
+		// Number contains part number starting at 1.
+		Number int
+		// Size contains part size.
+		Size int64
+	}
+)
+
+// Principal kinds.
+const (
+	userPrefix    = "user:"
+	servicePrefix = "service:"
 )
+
+// UserPrincipal returns principal of user.
+func UserPrincipal(userID uuid.UUID) Principal {
+	return Principal(userPrefix + userID.String())
+}
+
+// ServicePrincipal returns principal of internal service.
+func ServicePrincipal(name string) Principal {
+	return Principal(servicePrefix + name)
+}
+
+// UserID returns id of user principal, uuid.Nil for other principals.
+func (p Principal) UserID() uuid.UUID {
+	if !strings.HasPrefix(string(p), userPrefix) {
+		return uuid.Nil
+	}
+
+	return uuid.FromStringOrNil(strings.TrimPrefix(string(p), userPrefix))
+}
+
+// service returns true if principal is internal service.
+func (p Principal) service() bool {
+	return strings.HasPrefix(string(p), servicePrefix)
+}
+
+// readable returns true if principal can read file.
+func (f *File) readable(principal Principal) bool {
+	switch {
+	case f.Access.Visibility == VisibilityPublic:
+		return true
+	case principal == Anonymous:
+		return false
+	case f.Owner == principal:
+		return true
+	case f.Access.Visibility == VisibilityShared:
+		for _, p := range f.Access.SharedWith {
+			if p == principal {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// available returns error if file content can't be downloaded until it is scanned clean.
+func (f *File) available() error {
+	switch f.Scan.Status {
+	case ScanPending:
+		return ErrQuarantined
+	case ScanInfected:
+		return ErrInfected
+	default:
+		return nil
+	}
+}
+
+func (q *Quota) valid() bool {
+	return q.Owner != Anonymous && q.MaxBytes >= 0 && q.MaxFiles >= 0
+}
+
+// fileAllowed returns true if one more file can be charged to quota.
+func (q *Quota) fileAllowed() bool {
+	return q.MaxFiles == 0 || q.UsedFiles < q.MaxFiles
+}
+
+// limit returns reader which returns ErrQuotaExceeded after remaining bytes of quota.
+func (q *Quota) limit(file io.Reader) io.Reader {
+	if q.MaxBytes == 0 {
+		return file
+	}
+
+	return &limitedReader{r: file, n: q.MaxBytes - q.UsedBytes, err: ErrQuotaExceeded}
+}
+
+func (a *Access) valid() bool {
+	switch a.Visibility {
+	case VisibilityPrivate, VisibilityPublic:
+		return len(a.SharedWith) == 0
+	case VisibilityShared:
+		for _, p := range a.SharedWith {
+			if p == Anonymous {
+				return false
+			}
+		}
+
+		return len(a.SharedWith) > 0
+	default:
+		return false
+	}
+}
+
+// Offset returns amount of bytes received without gaps from the start of file.
+func (s *UploadSession) Offset() (offset int64) {
+	for i, part := range s.Parts {
+		if part.Number != i+1 {
+			break
+		}
+
+		offset += part.Size
+	}
+
+	return offset
+}
+
+// Received returns amount of all received bytes.
+func (s *UploadSession) Received() (received int64) {
+	for _, part := range s.Parts {
+		received += part.Size
+	}
+
+	return received
+}
+
+// receivedExcept returns amount of bytes received in parts other than number,
+// used for part which is going to be replaced.
+func (s *UploadSession) receivedExcept(number int) (received int64) {
+	for _, part := range s.Parts {
+		if part.Number != number {
+			received += part.Size
+		}
+	}
+
+	return received
+}
+
+func (s *UploadSession) complete() bool {
+	offset := s.Offset()
+	switch {
+	case len(s.Parts) == 0:
+		return false
+	case offset != s.Received():
+		return false
+	case s.Size != 0 && offset != s.Size:
+		return false
+	}
+
+	return true
+}
+
+// payload returns signed data of URL.
+func (u *SignedURL) payload() []byte {
+	ip := ""
+	if u.IP != nil {
+		ip = u.IP.String()
+	}
+
+	return []byte(strings.Join([]string{
+		u.FileID.String(),
+		strconv.FormatInt(u.ExpiresAt.Unix(), 10),
+		u.Disposition,
+		ip,
+	}, "\n"))
+}
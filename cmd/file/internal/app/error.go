@@ -6,6 +6,33 @@ import (
 
 // Errors.
 var (
-	ErrNotFound   = errors.New("not found")
-	ErrNotValidID = errors.New("not valid file id")
+	ErrNotFound            = errors.New("not found")
+	ErrNotValidID          = errors.New("not valid file id")
+	ErrNotValidPart        = errors.New("not valid part number")
+	ErrNotValidSize        = errors.New("not valid size")
+	ErrEmptyPart           = errors.New("empty part")
+	ErrPartTooLarge        = errors.New("part too large")
+	ErrUploadIncomplete    = errors.New("upload incomplete")
+	ErrNotAllowedType      = errors.New("content type not allowed")
+	ErrTooLarge            = errors.New("file too large")
+	ErrNotImage            = errors.New("file is not image")
+	ErrImageTooLarge       = errors.New("image too large")
+	ErrNotValidWidth       = errors.New("not valid width")
+	ErrVariantExist        = errors.New("variant exist")
+	ErrNotValidCursor      = errors.New("not valid cursor")
+	ErrNotValidLimit       = errors.New("not valid limit")
+	ErrNotValidSort        = errors.New("not valid sort field")
+	ErrNotValidMetadata    = errors.New("not valid metadata")
+	ErrNotValidAccess      = errors.New("not valid access")
+	ErrAccessDenied        = errors.New("access denied")
+	ErrNotValidExpiry      = errors.New("not valid expiry")
+	ErrNotValidDisposition = errors.New("not valid disposition")
+	ErrNotValidSignature   = errors.New("not valid signature")
+	ErrURLExpired          = errors.New("url expired")
+	ErrNotValidIP          = errors.New("not valid ip")
+	ErrNotValidRef         = errors.New("not valid reference")
+	ErrNotValidQuota       = errors.New("not valid quota")
+	ErrQuotaExceeded       = errors.New("quota exceeded")
+	ErrQuarantined         = errors.New("file quarantined")
+	ErrInfected            = errors.New("file infected")
 )
@@ -2,28 +2,217 @@ package app
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
 
 	"github.com/gofrs/uuid"
 )
 
-// UploadFile upload new file.
-func (m *Module) UploadFile(ctx context.Context, file io.Reader) (uuid.UUID, error) {
-	return m.file.Save(ctx, file)
+// UploadFile upload new file of owner and returns its info.
+// File uploaded by user is claimed by owner, file uploaded by service is removed
+// by reaper unless service claims it.
+// File content type and size are checked by caller policy.
+// Infected file is removed, file is downloaded only after it is scanned clean.
+func (m *Module) UploadFile(ctx context.Context, owner Principal, file io.Reader, policy Policy, access Access) (*File, error) {
+	if owner == Anonymous {
+		return nil, ErrAccessDenied
+	}
+
+	if !access.valid() {
+		return nil, ErrNotValidAccess
+	}
+
+	contentType, file, err := sniff(file)
+	if err != nil {
+		return nil, fmt.Errorf("sniff: %w", err)
+	}
+
+	if !policy.allowed(contentType) {
+		return nil, ErrNotAllowedType
+	}
+
+	account, err := policy.account(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := m.file.Quota(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("m.file.Quota: %w", err)
+	}
+
+	if !quota.fileAllowed() {
+		return nil, ErrQuotaExceeded
+	}
+
+	fileID, err := m.file.Create(ctx, owner, account, access)
+	if err != nil {
+		return nil, fmt.Errorf("m.file.Create: %w", err)
+	}
+
+	// Quota is checked while streaming to abort upload early,
+	// concurrent uploads are checked by repo when content is set.
+	hash := sha256.New()
+	size, err := m.blob.Put(ctx, fileID, contentType, io.TeeReader(quota.limit(policy.limit(file)), hash))
+	if err != nil {
+		return nil, fmt.Errorf("m.blob.Put: %w, m.file.Delete: %s", err, m.file.Delete(ctx, fileID))
+	}
+
+	res := &File{
+		ID:          fileID,
+		Size:        size,
+		Digest:      hex.EncodeToString(hash.Sum(nil)),
+		ContentType: contentType,
+		Owner:       owner,
+		Account:     account,
+		Access:      access,
+		Scan:        Scan{Status: ScanSkipped},
+	}
+	if m.scanner != nil {
+		res.Scan.Status = ScanPending
+	}
+
+	err = m.file.SetContent(ctx, res)
+	if err != nil {
+		return nil, fmt.Errorf("m.file.SetContent: %w, m.delete: %s", err, m.delete(ctx, fileID))
+	}
+
+	// Files uploaded by users are referenced by owner until they are deleted,
+	// services claim their files by own references.
+	if !owner.service() {
+		err = m.file.Claim(ctx, fileID, string(owner))
+		if err != nil {
+			return nil, fmt.Errorf("m.file.Claim: %w, m.delete: %s", err, m.delete(ctx, fileID))
+		}
+	}
+
+	if m.scanner == nil {
+		return res, nil
+	}
+
+	// File which wasn't scanned because of scanner failure stays quarantined
+	// until it is rescanned by ScanQuarantined.
+	err = m.scan(ctx, res)
+	switch {
+	case err != nil:
+		return res, nil
+	case res.Scan.Status == ScanInfected:
+		err = m.delete(ctx, fileID)
+		if err != nil {
+			return nil, fmt.Errorf("m.delete: %w", err)
+		}
+
+		return nil, fmt.Errorf("%w: %s", ErrInfected, res.Scan.Threat)
+	}
+
+	return res, nil
 }
 
 // GetFile file from database.
-func (m *Module) GetFile(ctx context.Context, fileID uuid.UUID) (*File, error) {
-	return m.file.Read(ctx, fileID)
+// File can be read by owner, by principals it is shared with or by anyone if it is public.
+func (m *Module) GetFile(ctx context.Context, principal Principal, fileID uuid.UUID) (*File, error) {
+	file, err := m.file.ByID(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("m.file.ByID: %w", err)
+	}
+
+	if !file.readable(principal) {
+		return nil, ErrAccessDenied
+	}
+
+	err = file.available()
+	if err != nil {
+		return nil, err
+	}
+
+	file.ReadSeekCloser, err = m.blob.Get(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("m.blob.Get: %w", err)
+	}
+
+	return file, nil
 }
 
 // SetMetadata set file metadata.
-func (m *Module) SetMetadata(ctx context.Context, fileID uuid.UUID, metadata json.RawMessage) error {
+// Only owner can set it.
+func (m *Module) SetMetadata(ctx context.Context, principal Principal, fileID uuid.UUID, metadata json.RawMessage) error {
+	err := m.checkOwner(ctx, principal, fileID)
+	if err != nil {
+		return fmt.Errorf("m.checkOwner: %w", err)
+	}
+
 	return m.file.SetMetadata(ctx, fileID, metadata)
 }
 
+// SetAccess replaces file visibility and principals it is shared with.
+// Only owner can set it, derived files are read by access of original.
+func (m *Module) SetAccess(ctx context.Context, principal Principal, fileID uuid.UUID, access Access) error {
+	if !access.valid() {
+		return ErrNotValidAccess
+	}
+
+	err := m.checkOwner(ctx, principal, fileID)
+	if err != nil {
+		return fmt.Errorf("m.checkOwner: %w", err)
+	}
+
+	return m.file.SetAccess(ctx, fileID, access)
+}
+
 // Delete file.
-func (m *Module) Delete(ctx context.Context, fileID uuid.UUID) error {
-	return m.file.Delete(ctx, fileID)
+// Only owner can delete it, derived files with resized images are deleted too.
+func (m *Module) Delete(ctx context.Context, principal Principal, fileID uuid.UUID) error {
+	err := m.checkOwner(ctx, principal, fileID)
+	if err != nil {
+		return fmt.Errorf("m.checkOwner: %w", err)
+	}
+
+	return m.delete(ctx, fileID)
+}
+
+// Auth returns user session by his token used from origin.
+func (m *Module) Auth(ctx context.Context, token string, origin Origin) (*Session, error) {
+	return m.auth.Session(ctx, token, origin)
+}
+
+func (m *Module) delete(ctx context.Context, fileID uuid.UUID) error {
+	variants, err := m.file.Variants(ctx, fileID)
+	if err != nil {
+		return fmt.Errorf("m.file.Variants: %w", err)
+	}
+
+	for _, variantID := range variants {
+		err = m.delete(ctx, variantID)
+		if err != nil {
+			return fmt.Errorf("m.delete: %w", err)
+		}
+	}
+
+	err = m.blob.Delete(ctx, fileID)
+	if err != nil {
+		return fmt.Errorf("m.blob.Delete: %w", err)
+	}
+
+	err = m.file.Delete(ctx, fileID)
+	if err != nil {
+		return fmt.Errorf("m.file.Delete: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Module) checkOwner(ctx context.Context, principal Principal, fileID uuid.UUID) error {
+	file, err := m.file.ByID(ctx, fileID)
+	if err != nil {
+		return fmt.Errorf("m.file.ByID: %w", err)
+	}
+
+	if principal == Anonymous || file.Owner != principal {
+		return ErrAccessDenied
+	}
+
+	return nil
 }
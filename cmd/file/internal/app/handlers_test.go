@@ -2,11 +2,16 @@ package app_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
+	"net"
 	"testing"
 
 	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
 
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
 )
@@ -17,25 +22,171 @@ func TestModule_UploadFile(t *testing.T) {
 	module, m, assert := start(t)
 
 	var (
-		file   = &bytes.Buffer{}
-		fileID = uuid.Must(uuid.NewV4())
+		content = []byte("file content")
+		fileID  = uuid.Must(uuid.NewV4())
+		hash    = sha256.Sum256(content)
+		digest  = hex.EncodeToString(hash[:])
+		size    = int64(len(content))
+		file    = &app.File{
+			ID:          fileID,
+			Size:        size,
+			Digest:      digest,
+			ContentType: "text/plain; charset=utf-8",
+			Owner:       owner,
+			Account:     owner,
+			Access:      private,
+			Scan:        app.Scan{Status: app.ScanClean},
+		}
+		clean         = app.Scan{Status: app.ScanClean}
+		account       = app.UserPrincipal(uuid.Must(uuid.NewV4()))
+		userOwner     = app.UserPrincipal(uuid.Must(uuid.NewV4()))
+		accountFile   = &app.File{ID: fileID, Size: size, Digest: digest, ContentType: file.ContentType, Owner: owner, Account: account, Access: private, Scan: clean}
+		userFile      = &app.File{ID: fileID, Size: size, Digest: digest, ContentType: file.ContentType, Owner: userOwner, Account: userOwner, Access: private, Scan: clean}
+		shared        = app.Access{Visibility: app.VisibilityShared, SharedWith: []app.Principal{stranger}}
+		sharedFile    = &app.File{ID: fileID, Size: size, Digest: digest, ContentType: file.ContentType, Owner: owner, Account: owner, Access: shared, Scan: clean}
+		stored        = &nopReadSeekCloser{}
+		threat        = "Eicar-Signature"
+		policy        = app.Policy{AllowedTypes: []string{"image/png", "text/*"}, MaxSize: size}
+		publicShared  = app.Access{Visibility: app.VisibilityPublic, SharedWith: []app.Principal{stranger}}
+		emptyShared   = app.Access{Visibility: app.VisibilityShared}
+		unknownAccess = app.Access{Visibility: "unknown"}
+		unlimited     = &app.Quota{Owner: owner}
+		noFilesLeft   = &app.Quota{Owner: owner, MaxFiles: 2, UsedFiles: 2}
+		noBytesLeft   = &app.Quota{Owner: owner, MaxBytes: 100, UsedBytes: 100 - size + 1}
 	)
 
+	put := func(_ context.Context, _ uuid.UUID, _ string, r io.Reader) (int64, error) {
+		return io.Copy(io.Discard, r)
+	}
+	pending := func(f *app.File) *app.File {
+		res := *f
+		res.Scan = app.Scan{Status: app.ScanPending}
+
+		return &res
+	}
+
 	testCases := []struct {
 		name    string
-		file    io.Reader
-		want    uuid.UUID
+		owner   app.Principal
+		policy  app.Policy
+		access  app.Access
+		want    *app.File
 		wantErr error
 	}{
-		{"success", file, fileID, nil},
+		{"success", owner, app.Policy{}, private, file, nil},
+		{"success_policy", owner, policy, private, file, nil},
+		{"success_shared", owner, app.Policy{}, shared, sharedFile, nil},
+		{"success_account", owner, app.Policy{Account: account}, private, accountFile, nil},
+		{"success_scan_failed", owner, app.Policy{}, private, pending(file), nil},
+		{"err_infected", owner, app.Policy{}, private, nil, app.ErrInfected},
+		{"err_anonymous", app.Anonymous, app.Policy{}, private, nil, app.ErrAccessDenied},
+		{"err_not_valid_access", owner, app.Policy{}, publicShared, nil, app.ErrNotValidAccess},
+		{"err_empty_shared", owner, app.Policy{}, emptyShared, nil, app.ErrNotValidAccess},
+		{"err_unknown_visibility", owner, app.Policy{}, unknownAccess, nil, app.ErrNotValidAccess},
+		{"err_service_account", owner, app.Policy{Account: stranger}, private, nil, app.ErrAccessDenied},
+		{"err_user_account", userOwner, app.Policy{Account: account}, private, nil, app.ErrAccessDenied},
+		{"err_not_allowed_type", owner, app.Policy{AllowedTypes: []string{"image/*"}}, private, nil, app.ErrNotAllowedType},
+		{"err_too_large", owner, app.Policy{MaxSize: size - 1}, private, nil, app.ErrTooLarge},
+		{"err_quota", owner, app.Policy{}, private, nil, errAny},
+		{"err_quota_files", owner, app.Policy{}, private, nil, app.ErrQuotaExceeded},
+		{"err_quota_bytes", owner, app.Policy{}, private, nil, app.ErrQuotaExceeded},
+		{"err_create", owner, app.Policy{}, private, nil, errAny},
+		{"err_put", owner, app.Policy{}, private, nil, errAny},
+		{"err_set_content", owner, app.Policy{}, private, nil, errAny},
+		{"success_user", userOwner, app.Policy{}, private, userFile, nil},
+		{"err_claim", userOwner, app.Policy{}, private, nil, errAny},
 	}
 
-	m.repo.EXPECT().Save(ctx, file).Return(fileID, nil)
+	gomock.InOrder(
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending(file)).Return(nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(stored, nil),
+		m.scan.EXPECT().Scan(ctx, stored).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, fileID, app.ScanClean, "").Return(nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending(file)).Return(nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(stored, nil),
+		m.scan.EXPECT().Scan(ctx, stored).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, fileID, app.ScanClean, "").Return(nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, shared).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending(sharedFile)).Return(nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(stored, nil),
+		m.scan.EXPECT().Scan(ctx, stored).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, fileID, app.ScanClean, "").Return(nil),
+		m.repo.EXPECT().Quota(ctx, account).Return(&app.Quota{Owner: account}, nil),
+		m.repo.EXPECT().Create(ctx, owner, account, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending(accountFile)).Return(nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(stored, nil),
+		m.scan.EXPECT().Scan(ctx, stored).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, fileID, app.ScanClean, "").Return(nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending(file)).Return(nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(stored, nil),
+		m.scan.EXPECT().Scan(ctx, stored).Return("", errAny),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending(file)).Return(nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(stored, nil),
+		m.scan.EXPECT().Scan(ctx, stored).Return(threat, nil),
+		m.repo.EXPECT().SetScan(ctx, fileID, app.ScanInfected, threat).Return(nil),
+		m.repo.EXPECT().Variants(ctx, fileID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(nil, errAny),
+		m.repo.EXPECT().Quota(ctx, owner).Return(noFilesLeft, nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(noBytesLeft, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(uuid.Nil, errAny),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).Return(int64(0), errAny),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending(file)).Return(errAny),
+		m.repo.EXPECT().Variants(ctx, fileID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Quota(ctx, userOwner).Return(&app.Quota{Owner: userOwner}, nil),
+		m.repo.EXPECT().Create(ctx, userOwner, userOwner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending(userFile)).Return(nil),
+		m.repo.EXPECT().Claim(ctx, fileID, string(userOwner)).Return(nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(stored, nil),
+		m.scan.EXPECT().Scan(ctx, stored).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, fileID, app.ScanClean, "").Return(nil),
+		m.repo.EXPECT().Quota(ctx, userOwner).Return(&app.Quota{Owner: userOwner}, nil),
+		m.repo.EXPECT().Create(ctx, userOwner, userOwner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending(userFile)).Return(nil),
+		m.repo.EXPECT().Claim(ctx, fileID, string(userOwner)).Return(errAny),
+		m.repo.EXPECT().Variants(ctx, fileID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(nil),
+	)
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			res, err := module.UploadFile(ctx, tc.file)
+			res, err := module.UploadFile(ctx, tc.owner, bytes.NewReader(content), tc.policy, tc.access)
 			assert.ErrorIs(err, tc.wantErr)
 			assert.Equal(tc.want, res)
 		})
@@ -48,30 +199,66 @@ func TestModule_GetFile(t *testing.T) {
 	module, m, assert := start(t)
 
 	var (
-		fileID = uuid.Must(uuid.NewV4())
-		file   = &app.File{
-			ReadSeekCloser: nil,
-			ID:             fileID,
-			Size:           100,
-			Metadata:       nil,
+		fileID  = uuid.Must(uuid.NewV4())
+		content = &nopReadSeekCloser{}
+		shared  = app.Access{Visibility: app.VisibilityShared, SharedWith: []app.Principal{stranger}}
+		public  = app.Access{Visibility: app.VisibilityPublic}
+		info    = func(access app.Access) *app.File {
+			return &app.File{ID: fileID, Size: 100, Owner: owner, Access: access}
+		}
+		withContent = func(access app.Access) *app.File {
+			file := info(access)
+			file.ReadSeekCloser = content
+
+			return file
+		}
+		scanned = func(status app.ScanStatus) *app.File {
+			file := info(private)
+			file.Scan.Status = status
+
+			return file
 		}
 	)
 
 	testCases := []struct {
-		name    string
-		fileID  uuid.UUID
-		want    *app.File
-		wantErr error
+		name      string
+		principal app.Principal
+		want      *app.File
+		wantErr   error
 	}{
-		{"success", fileID, file, nil},
+		{"success_owner", owner, withContent(private), nil},
+		{"success_shared", stranger, withContent(shared), nil},
+		{"success_public", app.Anonymous, withContent(public), nil},
+		{"err_private", stranger, nil, app.ErrAccessDenied},
+		{"err_not_shared", app.ServicePrincipal("other"), nil, app.ErrAccessDenied},
+		{"err_anonymous", app.Anonymous, nil, app.ErrAccessDenied},
+		{"err_not_found", owner, nil, app.ErrNotFound},
+		{"err_quarantined", owner, nil, app.ErrQuarantined},
+		{"err_infected", owner, nil, app.ErrInfected},
+		{"err_get", owner, nil, errAny},
 	}
 
-	m.repo.EXPECT().Read(ctx, fileID).Return(file, nil)
+	gomock.InOrder(
+		m.repo.EXPECT().ByID(ctx, fileID).Return(info(private), nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(info(shared), nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(info(public), nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(info(private), nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(info(shared), nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(info(shared), nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(nil, app.ErrNotFound),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(scanned(app.ScanPending), nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(scanned(app.ScanInfected), nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(info(private), nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(nil, errAny),
+	)
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			res, err := module.GetFile(ctx, tc.fileID)
+			res, err := module.GetFile(ctx, tc.principal, fileID)
 			assert.ErrorIs(err, tc.wantErr)
 			assert.Equal(tc.want, res)
 		})
@@ -85,53 +272,178 @@ func TestModule_SetMetadata(t *testing.T) {
 
 	var (
 		fileID   = uuid.Must(uuid.NewV4())
+		file     = &app.File{ID: fileID, Owner: owner, Access: private}
 		metadata = json.RawMessage(`{ "field": "value" }`)
 	)
 
 	testCases := []struct {
-		name     string
-		fileID   uuid.UUID
-		metadata json.RawMessage
-		want     error
+		name      string
+		principal app.Principal
+		want      error
 	}{
-		{"success", fileID, metadata, nil},
+		{"success", owner, nil},
+		{"err_not_owner", stranger, app.ErrAccessDenied},
+		{"err_not_found", owner, app.ErrNotFound},
 	}
 
-	m.repo.EXPECT().SetMetadata(ctx, fileID, metadata).Return(nil)
+	gomock.InOrder(
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().SetMetadata(ctx, fileID, metadata).Return(nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(nil, app.ErrNotFound),
+	)
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			err := module.SetMetadata(ctx, tc.fileID, tc.metadata)
+			err := module.SetMetadata(ctx, tc.principal, fileID, metadata)
 			assert.ErrorIs(err, tc.want)
 		})
 	}
 }
 
-func TestModule_Delete(t *testing.T) {
+func TestModule_SetAccess(t *testing.T) {
 	t.Parallel()
 
 	module, m, assert := start(t)
 
 	var (
 		fileID = uuid.Must(uuid.NewV4())
+		file   = &app.File{ID: fileID, Owner: owner, Access: private}
+		shared = app.Access{Visibility: app.VisibilityShared, SharedWith: []app.Principal{stranger}}
 	)
 
 	testCases := []struct {
-		name   string
-		fileID uuid.UUID
-		want   error
+		name      string
+		principal app.Principal
+		access    app.Access
+		want      error
 	}{
-		{"success", fileID, nil},
+		{"success", owner, shared, nil},
+		{"err_not_valid_access", owner, app.Access{Visibility: app.VisibilityShared}, app.ErrNotValidAccess},
+		{"err_anonymous_shared", owner, app.Access{Visibility: app.VisibilityShared, SharedWith: []app.Principal{app.Anonymous}}, app.ErrNotValidAccess},
+		{"err_not_owner", stranger, shared, app.ErrAccessDenied},
+		{"err_any", owner, shared, errAny},
 	}
 
-	m.repo.EXPECT().Delete(ctx, fileID).Return(nil)
+	gomock.InOrder(
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().SetAccess(ctx, fileID, shared).Return(nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().SetAccess(ctx, fileID, shared).Return(errAny),
+	)
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			err := module.Delete(ctx, tc.fileID)
+			err := module.SetAccess(ctx, tc.principal, fileID, tc.access)
 			assert.ErrorIs(err, tc.want)
 		})
 	}
 }
+
+func TestModule_Delete(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		fileID    = uuid.Must(uuid.NewV4())
+		variantID = uuid.Must(uuid.NewV4())
+		file      = &app.File{ID: fileID, Owner: owner, Access: private}
+	)
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		want      error
+	}{
+		{"success", owner, nil},
+		{"success_with_variants", owner, nil},
+		{"err_not_owner", stranger, app.ErrAccessDenied},
+		{"err_anonymous", app.Anonymous, app.ErrAccessDenied},
+		{"err_not_found", owner, app.ErrNotFound},
+		{"err_variants", owner, errAny},
+		{"err_variant_delete", owner, errAny},
+		{"err_blob_delete", owner, errAny},
+		{"err_delete", owner, errAny},
+	}
+
+	gomock.InOrder(
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Variants(ctx, fileID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Variants(ctx, fileID).Return([]uuid.UUID{variantID}, nil),
+		m.repo.EXPECT().Variants(ctx, variantID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, variantID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, variantID).Return(nil),
+		m.blob.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(nil, app.ErrNotFound),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Variants(ctx, fileID).Return(nil, errAny),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Variants(ctx, fileID).Return([]uuid.UUID{variantID}, nil),
+		m.repo.EXPECT().Variants(ctx, variantID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, variantID).Return(errAny),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Variants(ctx, fileID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, fileID).Return(errAny),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Variants(ctx, fileID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(errAny),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := module.Delete(ctx, tc.principal, fileID)
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestModule_Auth(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	const token = "token"
+	session := &app.Session{ID: uuid.Must(uuid.NewV4()), UserID: uuid.Must(uuid.NewV4())}
+	origin := app.Origin{IP: net.ParseIP("192.100.10.4"), UserAgent: "Mozilla/5.0"}
+
+	testCases := []struct {
+		name    string
+		want    *app.Session
+		wantErr error
+	}{
+		{"success", session, nil},
+		{"err_not_found", nil, app.ErrNotFound},
+	}
+
+	gomock.InOrder(
+		m.auth.EXPECT().Session(ctx, token, origin).Return(session, nil),
+		m.auth.EXPECT().Session(ctx, token, origin).Return(nil, app.ErrNotFound),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.Auth(ctx, token, origin)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+type nopReadSeekCloser struct {
+	bytes.Reader
+}
+
+func (*nopReadSeekCloser) Close() error { return nil }
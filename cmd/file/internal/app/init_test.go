@@ -13,10 +13,20 @@ import (
 var (
 	ctx    = context.Background()
 	errAny = errors.New("any error")
+
+	owner    = app.ServicePrincipal("owner")
+	stranger = app.ServicePrincipal("stranger")
+	private  = app.Access{Visibility: app.VisibilityPrivate}
 )
 
 type mocks struct {
-	repo *MockRepo
+	repo   *MockRepo
+	blob   *MockBlobStore
+	upload *MockUploadRepo
+	thumb  *MockThumbnailer
+	auth   *MockAuthSvc
+	signer *MockSigner
+	scan   *MockScanner
 }
 
 func start(t *testing.T) (*app.Module, *mocks, *require.Assertions) {
@@ -24,11 +34,23 @@ func start(t *testing.T) (*app.Module, *mocks, *require.Assertions) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := NewMockRepo(ctrl)
+	mockBlob := NewMockBlobStore(ctrl)
+	mockUpload := NewMockUploadRepo(ctrl)
+	mockThumb := NewMockThumbnailer(ctrl)
+	mockAuth := NewMockAuthSvc(ctrl)
+	mockSigner := NewMockSigner(ctrl)
+	mockScanner := NewMockScanner(ctrl)
 
-	module := app.New(mockRepo)
+	module := app.New(mockRepo, mockBlob, mockUpload, mockThumb, mockAuth, mockSigner, mockScanner)
 
 	mocks := &mocks{
-		repo: mockRepo,
+		repo:   mockRepo,
+		blob:   mockBlob,
+		upload: mockUpload,
+		thumb:  mockThumb,
+		auth:   mockAuth,
+		signer: mockSigner,
+		scan:   mockScanner,
 	}
 
 	return module, mocks, require.New(t)
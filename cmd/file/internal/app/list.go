@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// List returns page of files matched by params and cursor of the next page.
+// The next page cursor is empty for the last page.
+// Principal's files are listed unless internal service sets other owner.
+func (m *Module) List(ctx context.Context, principal Principal, params ListParams) ([]File, string, error) {
+	if principal == Anonymous {
+		return nil, "", ErrAccessDenied
+	}
+
+	owner := principal
+	if params.Owner != Anonymous && params.Owner != principal {
+		if !principal.service() {
+			return nil, "", ErrAccessDenied
+		}
+
+		owner = params.Owner
+	}
+
+	switch {
+	case params.Limit == 0:
+		params.Limit = DefaultListLimit
+	case params.Limit < 0 || params.Limit > MaxListLimit:
+		return nil, "", ErrNotValidLimit
+	}
+
+	switch params.SortBy {
+	case "":
+		params.SortBy = SortByCreatedAt
+	case SortByCreatedAt, SortBySize:
+	default:
+		return nil, "", ErrNotValidSort
+	}
+
+	if len(params.Metadata) > 0 {
+		var obj map[string]interface{}
+		if json.Unmarshal(params.Metadata, &obj) != nil {
+			return nil, "", ErrNotValidMetadata
+		}
+	}
+
+	after, err := decodeCursor(params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// One more file for checking if the next page exists.
+	limit := params.Limit
+	params.Limit++
+
+	files, err := m.file.List(ctx, owner, params, after)
+	if err != nil {
+		return nil, "", fmt.Errorf("m.file.List: %w", err)
+	}
+
+	if len(files) <= limit {
+		return files, "", nil
+	}
+
+	files = files[:limit]
+	last := files[limit-1]
+	next, err := json.Marshal(Cursor{
+		ID:        last.ID,
+		CreatedAt: last.CreatedAt,
+		Size:      last.Size,
+		SortBy:    params.SortBy,
+		Desc:      params.Desc,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	return files, base64.RawURLEncoding.EncodeToString(next), nil
+}
+
+// decodeCursor returns nil for the first page.
+// Cursor must be made for the same sort as params.
+func decodeCursor(params ListParams) (*Cursor, error) {
+	if params.Cursor == "" {
+		return nil, nil
+	}
+
+	buf, err := base64.RawURLEncoding.DecodeString(params.Cursor)
+	if err != nil {
+		return nil, ErrNotValidCursor
+	}
+
+	cursor := &Cursor{}
+	err = json.Unmarshal(buf, cursor)
+	if err != nil || cursor.SortBy != params.SortBy || cursor.Desc != params.Desc {
+		return nil, ErrNotValidCursor
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,85 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+func TestModule_List(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		createdAt = time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+		files     = make([]app.File, 3)
+		metadata  = json.RawMessage(`{"key":"value"}`)
+		user      = app.UserPrincipal(uuid.Must(uuid.NewV4()))
+		next      string
+	)
+	for i := range files {
+		files[i] = app.File{ID: uuid.Must(uuid.NewV4()), Size: int64(i), CreatedAt: createdAt.Add(time.Duration(i))}
+	}
+
+	after := &app.Cursor{ID: files[1].ID, CreatedAt: files[1].CreatedAt, Size: files[1].Size, SortBy: app.SortByCreatedAt}
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		params    app.ListParams
+		cursor    *string
+		want      []app.File
+		wantNext  bool
+		wantErr   error
+	}{
+		{"success_first_page", owner, app.ListParams{Limit: 2}, nil, files[:2], true, nil},
+		{"success_last_page", owner, app.ListParams{Limit: 2}, &next, files[2:], false, nil},
+		{"success_filters", owner, app.ListParams{Limit: 5, SortBy: app.SortBySize, Metadata: metadata}, nil, files, false, nil},
+		{"err_not_valid_limit", owner, app.ListParams{Limit: app.MaxListLimit + 1}, nil, nil, false, app.ErrNotValidLimit},
+		{"err_not_valid_sort", owner, app.ListParams{SortBy: "name"}, nil, nil, false, app.ErrNotValidSort},
+		{"err_not_valid_metadata", owner, app.ListParams{Metadata: json.RawMessage(`[1]`)}, nil, nil, false, app.ErrNotValidMetadata},
+		{"err_not_valid_cursor", owner, app.ListParams{Cursor: "cursor"}, nil, nil, false, app.ErrNotValidCursor},
+		{"err_cursor_other_sort", owner, app.ListParams{Limit: 2, Desc: true}, &next, nil, false, app.ErrNotValidCursor},
+		{"success_other_owner", owner, app.ListParams{Owner: stranger}, nil, files[:1], false, nil},
+		{"success_any_owner", owner, app.ListParams{Owner: app.AnyOwner}, nil, files, false, nil},
+		{"success_own_files", user, app.ListParams{Owner: user}, nil, files[:1], false, nil},
+		{"err_anonymous", app.Anonymous, app.ListParams{}, nil, nil, false, app.ErrAccessDenied},
+		{"err_user_other_owner", user, app.ListParams{Owner: owner}, nil, nil, false, app.ErrAccessDenied},
+		{"err_user_any_owner", user, app.ListParams{Owner: app.AnyOwner}, nil, nil, false, app.ErrAccessDenied},
+		{"err_any", owner, app.ListParams{}, nil, nil, false, errAny},
+	}
+
+	gomock.InOrder(
+		m.repo.EXPECT().List(ctx, owner, app.ListParams{Limit: 3, SortBy: app.SortByCreatedAt}, nil).Return(files, nil),
+		m.repo.EXPECT().List(ctx, owner, gomock.Any(), after).Return(files[2:], nil),
+		m.repo.EXPECT().List(ctx, owner, app.ListParams{Limit: 6, SortBy: app.SortBySize, Metadata: metadata}, nil).Return(files, nil),
+		m.repo.EXPECT().List(ctx, stranger, app.ListParams{Owner: stranger, Limit: app.DefaultListLimit + 1, SortBy: app.SortByCreatedAt}, nil).Return(files[:1], nil),
+		m.repo.EXPECT().List(ctx, app.AnyOwner, app.ListParams{Owner: app.AnyOwner, Limit: app.DefaultListLimit + 1, SortBy: app.SortByCreatedAt}, nil).Return(files, nil),
+		m.repo.EXPECT().List(ctx, user, app.ListParams{Owner: user, Limit: app.DefaultListLimit + 1, SortBy: app.SortByCreatedAt}, nil).Return(files[:1], nil),
+		m.repo.EXPECT().List(ctx, owner, app.ListParams{Limit: app.DefaultListLimit + 1, SortBy: app.SortByCreatedAt}, nil).Return(nil, errAny),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.cursor != nil {
+				tc.params.Cursor = *tc.cursor
+			}
+
+			res, cursor, err := module.List(ctx, tc.principal, tc.params)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+			assert.Equal(tc.wantNext, cursor != "")
+
+			if tc.wantNext {
+				next = cursor
+			}
+		})
+	}
+}
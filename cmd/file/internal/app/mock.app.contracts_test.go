@@ -9,6 +9,7 @@ import (
 	json "encoding/json"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	app "github.com/Meat-Hook/back-template/cmd/file/internal/app"
 	uuid "github.com/gofrs/uuid"
@@ -38,6 +39,50 @@ func (m *MockRepo) EXPECT() *MockRepoMockRecorder {
 	return m.recorder
 }
 
+// ByID mocks base method.
+func (m *MockRepo) ByID(arg0 context.Context, arg1 uuid.UUID) (*app.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByID", arg0, arg1)
+	ret0, _ := ret[0].(*app.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByID indicates an expected call of ByID.
+func (mr *MockRepoMockRecorder) ByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByID", reflect.TypeOf((*MockRepo)(nil).ByID), arg0, arg1)
+}
+
+// Claim mocks base method.
+func (m *MockRepo) Claim(ctx context.Context, fileID uuid.UUID, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, fileID, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockRepoMockRecorder) Claim(ctx, fileID, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockRepo)(nil).Claim), ctx, fileID, ref)
+}
+
+// Create mocks base method.
+func (m *MockRepo) Create(ctx context.Context, owner, account app.Principal, access app.Access) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, owner, account, access)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepoMockRecorder) Create(ctx, owner, account, access interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepo)(nil).Create), ctx, owner, account, access)
+}
+
 // Delete mocks base method.
 func (m *MockRepo) Delete(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -52,34 +97,120 @@ func (mr *MockRepoMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepo)(nil).Delete), arg0, arg1)
 }
 
-// Read mocks base method.
-func (m *MockRepo) Read(arg0 context.Context, arg1 uuid.UUID) (*app.File, error) {
+// List mocks base method.
+func (m *MockRepo) List(ctx context.Context, owner app.Principal, params app.ListParams, after *app.Cursor) ([]app.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Read", arg0, arg1)
-	ret0, _ := ret[0].(*app.File)
+	ret := m.ctrl.Call(m, "List", ctx, owner, params, after)
+	ret0, _ := ret[0].([]app.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Read indicates an expected call of Read.
-func (mr *MockRepoMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+// List indicates an expected call of List.
+func (mr *MockRepoMockRecorder) List(ctx, owner, params, after interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockRepo)(nil).Read), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepo)(nil).List), ctx, owner, params, after)
 }
 
-// Save mocks base method.
-func (m *MockRepo) Save(arg0 context.Context, arg1 io.Reader) (uuid.UUID, error) {
+// Orphans mocks base method.
+func (m *MockRepo) Orphans(ctx context.Context, createdBefore time.Time, limit int) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", arg0, arg1)
-	ret0, _ := ret[0].(uuid.UUID)
+	ret := m.ctrl.Call(m, "Orphans", ctx, createdBefore, limit)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Orphans indicates an expected call of Orphans.
+func (mr *MockRepoMockRecorder) Orphans(ctx, createdBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Orphans", reflect.TypeOf((*MockRepo)(nil).Orphans), ctx, createdBefore, limit)
+}
+
+// Quarantined mocks base method.
+func (m *MockRepo) Quarantined(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quarantined", ctx, limit)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quarantined indicates an expected call of Quarantined.
+func (mr *MockRepoMockRecorder) Quarantined(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quarantined", reflect.TypeOf((*MockRepo)(nil).Quarantined), ctx, limit)
+}
+
+// Quota mocks base method.
+func (m *MockRepo) Quota(ctx context.Context, owner app.Principal) (*app.Quota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quota", ctx, owner)
+	ret0, _ := ret[0].(*app.Quota)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Save indicates an expected call of Save.
-func (mr *MockRepoMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+// Quota indicates an expected call of Quota.
+func (mr *MockRepoMockRecorder) Quota(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quota", reflect.TypeOf((*MockRepo)(nil).Quota), ctx, owner)
+}
+
+// Release mocks base method.
+func (m *MockRepo) Release(ctx context.Context, fileID uuid.UUID, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, fileID, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockRepoMockRecorder) Release(ctx, fileID, ref interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRepo)(nil).Save), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockRepo)(nil).Release), ctx, fileID, ref)
+}
+
+// SaveVariant mocks base method.
+func (m *MockRepo) SaveVariant(ctx context.Context, fileID uuid.UUID, width int, variantID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveVariant", ctx, fileID, width, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveVariant indicates an expected call of SaveVariant.
+func (mr *MockRepoMockRecorder) SaveVariant(ctx, fileID, width, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVariant", reflect.TypeOf((*MockRepo)(nil).SaveVariant), ctx, fileID, width, variantID)
+}
+
+// SetAccess mocks base method.
+func (m *MockRepo) SetAccess(ctx context.Context, fileID uuid.UUID, access app.Access) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccess", ctx, fileID, access)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccess indicates an expected call of SetAccess.
+func (mr *MockRepoMockRecorder) SetAccess(ctx, fileID, access interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccess", reflect.TypeOf((*MockRepo)(nil).SetAccess), ctx, fileID, access)
+}
+
+// SetContent mocks base method.
+func (m *MockRepo) SetContent(arg0 context.Context, arg1 *app.File) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetContent indicates an expected call of SetContent.
+func (mr *MockRepoMockRecorder) SetContent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContent", reflect.TypeOf((*MockRepo)(nil).SetContent), arg0, arg1)
 }
 
 // SetMetadata mocks base method.
@@ -95,3 +226,467 @@ func (mr *MockRepoMockRecorder) SetMetadata(arg0, arg1, arg2 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMetadata", reflect.TypeOf((*MockRepo)(nil).SetMetadata), arg0, arg1, arg2)
 }
+
+// SetQuota mocks base method.
+func (m *MockRepo) SetQuota(ctx context.Context, owner app.Principal, maxBytes, maxFiles int64) (*app.Quota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuota", ctx, owner, maxBytes, maxFiles)
+	ret0, _ := ret[0].(*app.Quota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuota indicates an expected call of SetQuota.
+func (mr *MockRepoMockRecorder) SetQuota(ctx, owner, maxBytes, maxFiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuota", reflect.TypeOf((*MockRepo)(nil).SetQuota), ctx, owner, maxBytes, maxFiles)
+}
+
+// SetScan mocks base method.
+func (m *MockRepo) SetScan(ctx context.Context, fileID uuid.UUID, status app.ScanStatus, threat string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScan", ctx, fileID, status, threat)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetScan indicates an expected call of SetScan.
+func (mr *MockRepoMockRecorder) SetScan(ctx, fileID, status, threat interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScan", reflect.TypeOf((*MockRepo)(nil).SetScan), ctx, fileID, status, threat)
+}
+
+// Variant mocks base method.
+func (m *MockRepo) Variant(ctx context.Context, fileID uuid.UUID, width int) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Variant", ctx, fileID, width)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Variant indicates an expected call of Variant.
+func (mr *MockRepoMockRecorder) Variant(ctx, fileID, width interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Variant", reflect.TypeOf((*MockRepo)(nil).Variant), ctx, fileID, width)
+}
+
+// Variants mocks base method.
+func (m *MockRepo) Variants(arg0 context.Context, arg1 uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Variants", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Variants indicates an expected call of Variants.
+func (mr *MockRepoMockRecorder) Variants(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Variants", reflect.TypeOf((*MockRepo)(nil).Variants), arg0, arg1)
+}
+
+// MockBlobStore is a mock of BlobStore interface.
+type MockBlobStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStoreMockRecorder
+}
+
+// MockBlobStoreMockRecorder is the mock recorder for MockBlobStore.
+type MockBlobStoreMockRecorder struct {
+	mock *MockBlobStore
+}
+
+// NewMockBlobStore creates a new mock instance.
+func NewMockBlobStore(ctrl *gomock.Controller) *MockBlobStore {
+	mock := &MockBlobStore{ctrl: ctrl}
+	mock.recorder = &MockBlobStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobStore) EXPECT() *MockBlobStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBlobStore) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBlobStoreMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlobStore)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockBlobStore) Get(arg0 context.Context, arg1 uuid.UUID) (io.ReadSeekCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadSeekCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlobStoreMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlobStore)(nil).Get), arg0, arg1)
+}
+
+// Put mocks base method.
+func (m *MockBlobStore) Put(ctx context.Context, fileID uuid.UUID, contentType string, r io.Reader) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, fileID, contentType, r)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockBlobStoreMockRecorder) Put(ctx, fileID, contentType, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockBlobStore)(nil).Put), ctx, fileID, contentType, r)
+}
+
+// MockChunkReaper is a mock of ChunkReaper interface.
+type MockChunkReaper struct {
+	ctrl     *gomock.Controller
+	recorder *MockChunkReaperMockRecorder
+}
+
+// MockChunkReaperMockRecorder is the mock recorder for MockChunkReaper.
+type MockChunkReaperMockRecorder struct {
+	mock *MockChunkReaper
+}
+
+// NewMockChunkReaper creates a new mock instance.
+func NewMockChunkReaper(ctrl *gomock.Controller) *MockChunkReaper {
+	mock := &MockChunkReaper{ctrl: ctrl}
+	mock.recorder = &MockChunkReaperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChunkReaper) EXPECT() *MockChunkReaperMockRecorder {
+	return m.recorder
+}
+
+// DeleteDangling mocks base method.
+func (m *MockChunkReaper) DeleteDangling(ctx context.Context, limit int, dryRun bool) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDangling", ctx, limit, dryRun)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDangling indicates an expected call of DeleteDangling.
+func (mr *MockChunkReaperMockRecorder) DeleteDangling(ctx, limit, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDangling", reflect.TypeOf((*MockChunkReaper)(nil).DeleteDangling), ctx, limit, dryRun)
+}
+
+// MockEncodedReader is a mock of EncodedReader interface.
+type MockEncodedReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncodedReaderMockRecorder
+}
+
+// MockEncodedReaderMockRecorder is the mock recorder for MockEncodedReader.
+type MockEncodedReaderMockRecorder struct {
+	mock *MockEncodedReader
+}
+
+// NewMockEncodedReader creates a new mock instance.
+func NewMockEncodedReader(ctrl *gomock.Controller) *MockEncodedReader {
+	mock := &MockEncodedReader{ctrl: ctrl}
+	mock.recorder = &MockEncodedReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEncodedReader) EXPECT() *MockEncodedReaderMockRecorder {
+	return m.recorder
+}
+
+// Encoded mocks base method.
+func (m *MockEncodedReader) Encoded(encoding string) (io.Reader, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encoded", encoding)
+	ret0, _ := ret[0].(io.Reader)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Encoded indicates an expected call of Encoded.
+func (mr *MockEncodedReaderMockRecorder) Encoded(encoding interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encoded", reflect.TypeOf((*MockEncodedReader)(nil).Encoded), encoding)
+}
+
+// MockThumbnailer is a mock of Thumbnailer interface.
+type MockThumbnailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockThumbnailerMockRecorder
+}
+
+// MockThumbnailerMockRecorder is the mock recorder for MockThumbnailer.
+type MockThumbnailerMockRecorder struct {
+	mock *MockThumbnailer
+}
+
+// NewMockThumbnailer creates a new mock instance.
+func NewMockThumbnailer(ctrl *gomock.Controller) *MockThumbnailer {
+	mock := &MockThumbnailer{ctrl: ctrl}
+	mock.recorder = &MockThumbnailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockThumbnailer) EXPECT() *MockThumbnailerMockRecorder {
+	return m.recorder
+}
+
+// Resize mocks base method.
+func (m *MockThumbnailer) Resize(w io.Writer, r io.Reader, width int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", w, r, width)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockThumbnailerMockRecorder) Resize(w, r, width interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockThumbnailer)(nil).Resize), w, r, width)
+}
+
+// MockScanner is a mock of Scanner interface.
+type MockScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockScannerMockRecorder
+}
+
+// MockScannerMockRecorder is the mock recorder for MockScanner.
+type MockScannerMockRecorder struct {
+	mock *MockScanner
+}
+
+// NewMockScanner creates a new mock instance.
+func NewMockScanner(ctrl *gomock.Controller) *MockScanner {
+	mock := &MockScanner{ctrl: ctrl}
+	mock.recorder = &MockScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScanner) EXPECT() *MockScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockScanner) Scan(ctx context.Context, r io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, r)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockScannerMockRecorder) Scan(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockScanner)(nil).Scan), ctx, r)
+}
+
+// MockAuthSvc is a mock of AuthSvc interface.
+type MockAuthSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthSvcMockRecorder
+}
+
+// MockAuthSvcMockRecorder is the mock recorder for MockAuthSvc.
+type MockAuthSvcMockRecorder struct {
+	mock *MockAuthSvc
+}
+
+// NewMockAuthSvc creates a new mock instance.
+func NewMockAuthSvc(ctrl *gomock.Controller) *MockAuthSvc {
+	mock := &MockAuthSvc{ctrl: ctrl}
+	mock.recorder = &MockAuthSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthSvc) EXPECT() *MockAuthSvcMockRecorder {
+	return m.recorder
+}
+
+// Session mocks base method.
+func (m *MockAuthSvc) Session(ctx context.Context, token string, origin app.Origin) (*app.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Session", ctx, token, origin)
+	ret0, _ := ret[0].(*app.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Session indicates an expected call of Session.
+func (mr *MockAuthSvcMockRecorder) Session(ctx, token, origin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*MockAuthSvc)(nil).Session), ctx, token, origin)
+}
+
+// MockSigner is a mock of Signer interface.
+type MockSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignerMockRecorder
+}
+
+// MockSignerMockRecorder is the mock recorder for MockSigner.
+type MockSignerMockRecorder struct {
+	mock *MockSigner
+}
+
+// NewMockSigner creates a new mock instance.
+func NewMockSigner(ctrl *gomock.Controller) *MockSigner {
+	mock := &MockSigner{ctrl: ctrl}
+	mock.recorder = &MockSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigner) EXPECT() *MockSignerMockRecorder {
+	return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockSigner) Sign(data []byte) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", data)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockSignerMockRecorder) Sign(data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockSigner)(nil).Sign), data)
+}
+
+// MockUploadRepo is a mock of UploadRepo interface.
+type MockUploadRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadRepoMockRecorder
+}
+
+// MockUploadRepoMockRecorder is the mock recorder for MockUploadRepo.
+type MockUploadRepoMockRecorder struct {
+	mock *MockUploadRepo
+}
+
+// NewMockUploadRepo creates a new mock instance.
+func NewMockUploadRepo(ctrl *gomock.Controller) *MockUploadRepo {
+	mock := &MockUploadRepo{ctrl: ctrl}
+	mock.recorder = &MockUploadRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadRepo) EXPECT() *MockUploadRepoMockRecorder {
+	return m.recorder
+}
+
+// Content mocks base method.
+func (m *MockUploadRepo) Content(arg0 context.Context, arg1 uuid.UUID) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Content", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Content indicates an expected call of Content.
+func (mr *MockUploadRepoMockRecorder) Content(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Content", reflect.TypeOf((*MockUploadRepo)(nil).Content), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockUploadRepo) CreateSession(ctx context.Context, owner app.Principal, size int64, policy app.Policy) (*app.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, owner, size, policy)
+	ret0, _ := ret[0].(*app.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockUploadRepoMockRecorder) CreateSession(ctx, owner, size, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockUploadRepo)(nil).CreateSession), ctx, owner, size, policy)
+}
+
+// DeleteSession mocks base method.
+func (m *MockUploadRepo) DeleteSession(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockUploadRepoMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockUploadRepo)(nil).DeleteSession), arg0, arg1)
+}
+
+// DeleteStale mocks base method.
+func (m *MockUploadRepo) DeleteStale(ctx context.Context, updatedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStale", ctx, updatedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStale indicates an expected call of DeleteStale.
+func (mr *MockUploadRepoMockRecorder) DeleteStale(ctx, updatedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStale", reflect.TypeOf((*MockUploadRepo)(nil).DeleteStale), ctx, updatedBefore)
+}
+
+// SavePart mocks base method.
+func (m *MockUploadRepo) SavePart(ctx context.Context, sessionID uuid.UUID, number int, r io.Reader) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePart", ctx, sessionID, number, r)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SavePart indicates an expected call of SavePart.
+func (mr *MockUploadRepoMockRecorder) SavePart(ctx, sessionID, number, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePart", reflect.TypeOf((*MockUploadRepo)(nil).SavePart), ctx, sessionID, number, r)
+}
+
+// Session mocks base method.
+func (m *MockUploadRepo) Session(arg0 context.Context, arg1 uuid.UUID) (*app.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Session", arg0, arg1)
+	ret0, _ := ret[0].(*app.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Session indicates an expected call of Session.
+func (mr *MockUploadRepoMockRecorder) Session(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*MockUploadRepo)(nil).Session), arg0, arg1)
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gofrs/uuid"
+)
+
+// sniffLen is amount of bytes used for content type detection.
+const sniffLen = 512
+
+// sniff detects content type by first bytes of file.
+// Returns reader with full file content.
+func sniff(file io.Reader) (string, io.Reader, error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", nil, fmt.Errorf("io.ReadFull: %w", err)
+	}
+
+	head = head[:n]
+
+	return http.DetectContentType(head), io.MultiReader(bytes.NewReader(head), file), nil
+}
+
+func (p Policy) allowed(contentType string) bool {
+	if len(p.AllowedTypes) == 0 {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, allowed := range p.AllowedTypes {
+		switch {
+		case allowed == mediaType:
+			return true
+		case strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*")):
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Policy) limit(file io.Reader) io.Reader {
+	if p.MaxSize == 0 {
+		return file
+	}
+
+	return &limitedReader{r: file, n: p.MaxSize, err: ErrTooLarge}
+}
+
+// account returns principal charged for file uploaded by owner.
+// Internal service can charge user it uploads file on behalf of,
+// other principals can't be charged by owner, ErrAccessDenied is returned.
+func (p Policy) account(owner Principal) (Principal, error) {
+	switch {
+	case p.Account == Anonymous, p.Account == owner:
+		return owner, nil
+	case owner.service() && p.Account.UserID() != uuid.Nil:
+		return p.Account, nil
+	}
+
+	return Anonymous, ErrAccessDenied
+}
+
+// limitedReader returns err instead of io.EOF after n bytes, so
+// file storage will not save truncated file.
+type limitedReader struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+// Read for implemented io.Reader.
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n < 0 {
+		return 0, l.err
+	}
+
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return 0, l.err
+	}
+
+	return n, err
+}
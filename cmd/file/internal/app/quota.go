@@ -0,0 +1,34 @@
+package app
+
+import (
+	"context"
+)
+
+// GetQuota returns limits and usage of storage by files charged to owner.
+// Quotas are managed by internal services only.
+func (m *Module) GetQuota(ctx context.Context, principal Principal, owner Principal) (*Quota, error) {
+	if !principal.service() {
+		return nil, ErrAccessDenied
+	}
+
+	if owner == Anonymous {
+		return nil, ErrNotValidQuota
+	}
+
+	return m.file.Quota(ctx, owner)
+}
+
+// SetQuota replaces limits of storage by files charged to quota owner.
+// Usage isn't changed, files above new limits are kept.
+// Quotas are managed by internal services only.
+func (m *Module) SetQuota(ctx context.Context, principal Principal, quota Quota) (*Quota, error) {
+	if !principal.service() {
+		return nil, ErrAccessDenied
+	}
+
+	if !quota.valid() {
+		return nil, ErrNotValidQuota
+	}
+
+	return m.file.SetQuota(ctx, quota.Owner, quota.MaxBytes, quota.MaxFiles)
+}
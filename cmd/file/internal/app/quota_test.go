@@ -0,0 +1,91 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+func TestModule_GetQuota(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		user  = app.UserPrincipal(uuid.Must(uuid.NewV4()))
+		quota = &app.Quota{Owner: user, MaxBytes: 100, MaxFiles: 10, UsedBytes: 50, UsedFiles: 1}
+	)
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		owner     app.Principal
+		want      *app.Quota
+		wantErr   error
+	}{
+		{"success", owner, user, quota, nil},
+		{"err_anonymous", app.Anonymous, user, nil, app.ErrAccessDenied},
+		{"err_user", user, user, nil, app.ErrAccessDenied},
+		{"err_empty_owner", owner, app.Anonymous, nil, app.ErrNotValidQuota},
+		{"err_any", owner, user, nil, errAny},
+	}
+
+	gomock.InOrder(
+		m.repo.EXPECT().Quota(ctx, user).Return(quota, nil),
+		m.repo.EXPECT().Quota(ctx, user).Return(nil, errAny),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.GetQuota(ctx, tc.principal, tc.owner)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_SetQuota(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		user  = app.UserPrincipal(uuid.Must(uuid.NewV4()))
+		limit = app.Quota{Owner: user, MaxBytes: 100, MaxFiles: 10}
+		quota = &app.Quota{Owner: user, MaxBytes: 100, MaxFiles: 10, UsedBytes: 500, UsedFiles: 1}
+	)
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		quota     app.Quota
+		want      *app.Quota
+		wantErr   error
+	}{
+		{"success", owner, limit, quota, nil},
+		{"err_anonymous", app.Anonymous, limit, nil, app.ErrAccessDenied},
+		{"err_user", user, limit, nil, app.ErrAccessDenied},
+		{"err_empty_owner", owner, app.Quota{MaxBytes: 100}, nil, app.ErrNotValidQuota},
+		{"err_negative_bytes", owner, app.Quota{Owner: user, MaxBytes: -1}, nil, app.ErrNotValidQuota},
+		{"err_negative_files", owner, app.Quota{Owner: user, MaxFiles: -1}, nil, app.ErrNotValidQuota},
+		{"err_any", owner, limit, nil, errAny},
+	}
+
+	gomock.InOrder(
+		m.repo.EXPECT().SetQuota(ctx, user, limit.MaxBytes, limit.MaxFiles).Return(quota, nil),
+		m.repo.EXPECT().SetQuota(ctx, user, limit.MaxBytes, limit.MaxFiles).Return(nil, errAny),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.SetQuota(ctx, tc.principal, tc.quota)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
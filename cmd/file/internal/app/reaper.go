@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// ClaimFile adds reference to file, e.g. "user:<id>:avatar".
+// Only owner can claim it. Files are kept only while they are claimed.
+func (m *Module) ClaimFile(ctx context.Context, principal Principal, fileID uuid.UUID, ref string) error {
+	if ref == "" || len(ref) > MaxRefLen {
+		return ErrNotValidRef
+	}
+
+	err := m.checkOwner(ctx, principal, fileID)
+	if err != nil {
+		return fmt.Errorf("m.checkOwner: %w", err)
+	}
+
+	return m.file.Claim(ctx, fileID, ref)
+}
+
+// ReleaseFile removes reference to file.
+// Only owner can release it, file without references is removed by reaper.
+func (m *Module) ReleaseFile(ctx context.Context, principal Principal, fileID uuid.UUID, ref string) error {
+	if ref == "" || len(ref) > MaxRefLen {
+		return ErrNotValidRef
+	}
+
+	err := m.checkOwner(ctx, principal, fileID)
+	if err != nil {
+		return fmt.Errorf("m.checkOwner: %w", err)
+	}
+
+	return m.file.Release(ctx, fileID, ref)
+}
+
+// Reap removes files which have no references after params.TTL
+// and content chunks which don't belong to any file.
+func (m *Module) Reap(ctx context.Context, params ReapParams) (*ReapResult, error) {
+	orphans, err := m.file.Orphans(ctx, time.Now().Add(-params.TTL), params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("m.file.Orphans: %w", err)
+	}
+
+	res := &ReapResult{Files: make([]uuid.UUID, 0, len(orphans))}
+	for _, fileID := range orphans {
+		if !params.DryRun {
+			err = m.delete(ctx, fileID)
+			if err != nil {
+				return res, fmt.Errorf("m.delete: %w", err)
+			}
+		}
+
+		res.Files = append(res.Files, fileID)
+	}
+
+	reaper, ok := m.blob.(ChunkReaper)
+	if !ok {
+		return res, nil
+	}
+
+	res.Chunks, err = reaper.DeleteDangling(ctx, params.Limit, params.DryRun)
+	if err != nil {
+		return res, fmt.Errorf("reaper.DeleteDangling: %w", err)
+	}
+
+	return res, nil
+}
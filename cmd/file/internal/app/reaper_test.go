@@ -0,0 +1,194 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+func TestModule_ClaimFile(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		fileID = uuid.Must(uuid.NewV4())
+		file   = &app.File{ID: fileID, Owner: owner, Access: private}
+		ref    = "user:1:avatar"
+	)
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		ref       string
+		want      error
+	}{
+		{"success", owner, ref, nil},
+		{"err_empty_ref", owner, "", app.ErrNotValidRef},
+		{"err_long_ref", owner, strings.Repeat("a", app.MaxRefLen+1), app.ErrNotValidRef},
+		{"err_not_found", owner, ref, app.ErrNotFound},
+		{"err_not_owner", stranger, ref, app.ErrAccessDenied},
+		{"err_any", owner, ref, errAny},
+	}
+
+	gomock.InOrder(
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Claim(ctx, fileID, ref).Return(nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(nil, app.ErrNotFound),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Claim(ctx, fileID, ref).Return(errAny),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := module.ClaimFile(ctx, tc.principal, fileID, tc.ref)
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestModule_ReleaseFile(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		fileID = uuid.Must(uuid.NewV4())
+		file   = &app.File{ID: fileID, Owner: owner, Access: private}
+		ref    = "user:1:avatar"
+	)
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		ref       string
+		want      error
+	}{
+		{"success", owner, ref, nil},
+		{"err_empty_ref", owner, "", app.ErrNotValidRef},
+		{"err_not_owner", stranger, ref, app.ErrAccessDenied},
+		{"err_not_claimed", owner, ref, app.ErrNotFound},
+	}
+
+	gomock.InOrder(
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Release(ctx, fileID, ref).Return(nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.repo.EXPECT().Release(ctx, fileID, ref).Return(app.ErrNotFound),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := module.ReleaseFile(ctx, tc.principal, fileID, tc.ref)
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestModule_Reap(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		fileID    = uuid.Must(uuid.NewV4())
+		otherID   = uuid.Must(uuid.NewV4())
+		variantID = uuid.Must(uuid.NewV4())
+		params    = app.ReapParams{TTL: time.Hour, Limit: 10}
+		dryRun    = app.ReapParams{TTL: time.Hour, Limit: 10, DryRun: true}
+	)
+
+	createdBefore := gomock.AssignableToTypeOf(time.Time{})
+
+	testCases := []struct {
+		name    string
+		params  app.ReapParams
+		want    *app.ReapResult
+		wantErr error
+	}{
+		{"success", params, &app.ReapResult{Files: []uuid.UUID{fileID, otherID}}, nil},
+		{"success_dry_run", dryRun, &app.ReapResult{Files: []uuid.UUID{fileID, otherID}}, nil},
+		{"err_orphans", params, nil, errAny},
+		{"err_delete", params, &app.ReapResult{Files: []uuid.UUID{}}, errAny},
+	}
+
+	gomock.InOrder(
+		m.repo.EXPECT().Orphans(ctx, createdBefore, 10).Return([]uuid.UUID{fileID, otherID}, nil),
+		m.repo.EXPECT().Variants(ctx, fileID).Return([]uuid.UUID{variantID}, nil),
+		m.repo.EXPECT().Variants(ctx, variantID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, variantID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, variantID).Return(nil),
+		m.blob.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, fileID).Return(nil),
+		m.repo.EXPECT().Variants(ctx, otherID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, otherID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, otherID).Return(nil),
+		m.repo.EXPECT().Orphans(ctx, createdBefore, 10).Return([]uuid.UUID{fileID, otherID}, nil),
+		m.repo.EXPECT().Orphans(ctx, createdBefore, 10).Return(nil, errAny),
+		m.repo.EXPECT().Orphans(ctx, createdBefore, 10).Return([]uuid.UUID{fileID}, nil),
+		m.repo.EXPECT().Variants(ctx, fileID).Return(nil, errAny),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.Reap(ctx, tc.params)
+			assert.Equal(tc.want, res)
+			assert.ErrorIs(err, tc.wantErr)
+		})
+	}
+}
+
+// reapingBlobStore is BlobStore which keeps chunks.
+type reapingBlobStore struct {
+	*MockBlobStore
+	*MockChunkReaper
+}
+
+func TestModule_ReapChunks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockRepo, mockBlob, mockReaper := NewMockRepo(ctrl), NewMockBlobStore(ctrl), NewMockChunkReaper(ctrl)
+	module := app.New(mockRepo, reapingBlobStore{mockBlob, mockReaper}, nil, nil, nil, nil, nil)
+	assert := require.New(t)
+
+	testCases := []struct {
+		name    string
+		dryRun  bool
+		want    *app.ReapResult
+		wantErr error
+	}{
+		{"success", false, &app.ReapResult{Files: []uuid.UUID{}, Chunks: 3}, nil},
+		{"success_dry_run", true, &app.ReapResult{Files: []uuid.UUID{}, Chunks: 5}, nil},
+		{"err_any", false, &app.ReapResult{Files: []uuid.UUID{}}, errAny},
+	}
+
+	gomock.InOrder(
+		mockRepo.EXPECT().Orphans(ctx, gomock.Any(), 10).Return(nil, nil),
+		mockReaper.EXPECT().DeleteDangling(ctx, 10, false).Return(int64(3), nil),
+		mockRepo.EXPECT().Orphans(ctx, gomock.Any(), 10).Return(nil, nil),
+		mockReaper.EXPECT().DeleteDangling(ctx, 10, true).Return(int64(5), nil),
+		mockRepo.EXPECT().Orphans(ctx, gomock.Any(), 10).Return(nil, nil),
+		mockReaper.EXPECT().DeleteDangling(ctx, 10, false).Return(int64(0), errAny),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.Reap(ctx, app.ReapParams{TTL: time.Hour, Limit: 10, DryRun: tc.dryRun})
+			assert.Equal(tc.want, res)
+			assert.ErrorIs(err, tc.wantErr)
+		})
+	}
+}
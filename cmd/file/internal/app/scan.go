@@ -0,0 +1,68 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ScanQuarantined scans up to limit quarantined files, e.g. left after scanner failures,
+// and returns amount of scanned files. Infected files are kept to be removed by owner.
+func (m *Module) ScanQuarantined(ctx context.Context, limit int) (int, error) {
+	if m.scanner == nil {
+		return 0, nil
+	}
+
+	quarantined, err := m.file.Quarantined(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("m.file.Quarantined: %w", err)
+	}
+
+	scanned := 0
+	for _, fileID := range quarantined {
+		err = m.scan(ctx, &File{ID: fileID})
+		switch {
+		// File was removed after listing.
+		case errors.Is(err, ErrNotFound):
+			continue
+		case err != nil:
+			return scanned, fmt.Errorf("m.scan: %w", err)
+		}
+
+		scanned++
+	}
+
+	return scanned, nil
+}
+
+// scan scans stored file content and saves result to file.
+func (m *Module) scan(ctx context.Context, file *File) error {
+	content, err := m.blob.Get(ctx, file.ID)
+	if err != nil {
+		return fmt.Errorf("m.blob.Get: %w", err)
+	}
+
+	threat, err := m.scanner.Scan(ctx, content)
+	if err != nil {
+		return fmt.Errorf("m.scanner.Scan: %w, content.Close: %s", err, content.Close())
+	}
+
+	err = content.Close()
+	if err != nil {
+		return fmt.Errorf("content.Close: %w", err)
+	}
+
+	scan := Scan{Status: ScanClean, Threat: threat}
+	if threat != "" {
+		scan.Status = ScanInfected
+	}
+
+	err = m.file.SetScan(ctx, file.ID, scan.Status, scan.Threat)
+	if err != nil {
+		return fmt.Errorf("m.file.SetScan: %w", err)
+	}
+
+	file.Scan = scan
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+func TestModule_ScanQuarantined(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		cleanID    = uuid.Must(uuid.NewV4())
+		infectedID = uuid.Must(uuid.NewV4())
+		removedID  = uuid.Must(uuid.NewV4())
+		content    = &nopReadSeekCloser{}
+		threat     = "Eicar-Signature"
+		limit      = 10
+	)
+
+	testCases := []struct {
+		name    string
+		want    int
+		wantErr error
+	}{
+		{"success", 2, nil},
+		{"err_quarantined", 0, errAny},
+		{"err_scan", 1, errAny},
+	}
+
+	gomock.InOrder(
+		m.repo.EXPECT().Quarantined(ctx, limit).Return([]uuid.UUID{cleanID, removedID, infectedID}, nil),
+		m.blob.EXPECT().Get(ctx, cleanID).Return(content, nil),
+		m.scan.EXPECT().Scan(ctx, content).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, cleanID, app.ScanClean, "").Return(nil),
+		m.blob.EXPECT().Get(ctx, removedID).Return(nil, app.ErrNotFound),
+		m.blob.EXPECT().Get(ctx, infectedID).Return(content, nil),
+		m.scan.EXPECT().Scan(ctx, content).Return(threat, nil),
+		m.repo.EXPECT().SetScan(ctx, infectedID, app.ScanInfected, threat).Return(nil),
+		m.repo.EXPECT().Quarantined(ctx, limit).Return(nil, errAny),
+		m.repo.EXPECT().Quarantined(ctx, limit).Return([]uuid.UUID{cleanID, infectedID}, nil),
+		m.blob.EXPECT().Get(ctx, cleanID).Return(content, nil),
+		m.scan.EXPECT().Scan(ctx, content).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, cleanID, app.ScanClean, "").Return(nil),
+		m.blob.EXPECT().Get(ctx, infectedID).Return(content, nil),
+		m.scan.EXPECT().Scan(ctx, content).Return("", errAny),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.ScanQuarantined(ctx, limit)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_WithoutScanner(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockRepo, mockBlob := NewMockRepo(ctrl), NewMockBlobStore(ctrl)
+	module := app.New(mockRepo, mockBlob, nil, nil, nil, nil, nil)
+	assert := require.New(t)
+
+	var (
+		content = []byte("file content")
+		fileID  = uuid.Must(uuid.NewV4())
+		hash    = sha256.Sum256(content)
+		file    = &app.File{
+			ID:          fileID,
+			Size:        int64(len(content)),
+			Digest:      hex.EncodeToString(hash[:]),
+			ContentType: "text/plain; charset=utf-8",
+			Owner:       owner,
+			Account:     owner,
+			Access:      private,
+			Scan:        app.Scan{Status: app.ScanSkipped},
+		}
+	)
+
+	put := func(_ context.Context, _ uuid.UUID, _ string, r io.Reader) (int64, error) {
+		return io.Copy(io.Discard, r)
+	}
+
+	gomock.InOrder(
+		mockRepo.EXPECT().Quota(ctx, owner).Return(&app.Quota{Owner: owner}, nil),
+		mockRepo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		mockBlob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		mockRepo.EXPECT().SetContent(ctx, file).Return(nil),
+	)
+
+	res, err := module.UploadFile(ctx, owner, bytes.NewReader(content), app.Policy{}, private)
+	assert.NoError(err)
+	assert.Equal(file, res)
+
+	scanned, err := module.ScanQuarantined(ctx, 10)
+	assert.NoError(err)
+	assert.Zero(scanned)
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"time"
+)
+
+// SignURL returns signed download URL of file for principal who can read file.
+// The signed URL gives access to file for anyone until it expires.
+func (m *Module) SignURL(ctx context.Context, principal Principal, u SignedURL) (*SignedURL, error) {
+	now := time.Now()
+	if !u.ExpiresAt.After(now) || u.ExpiresAt.After(now.Add(MaxSignTTL)) {
+		return nil, ErrNotValidExpiry
+	}
+
+	if u.Disposition != "" {
+		disposition, _, err := mime.ParseMediaType(u.Disposition)
+		if err != nil || (disposition != "inline" && disposition != "attachment") {
+			return nil, ErrNotValidDisposition
+		}
+	}
+
+	file, err := m.file.ByID(ctx, u.FileID)
+	if err != nil {
+		return nil, fmt.Errorf("m.file.ByID: %w", err)
+	}
+
+	if !file.readable(principal) {
+		return nil, ErrAccessDenied
+	}
+
+	u.Signature = base64.RawURLEncoding.EncodeToString(m.signer.Sign(u.payload()))
+
+	return &u, nil
+}
+
+// GetSignedFile returns file by signed download URL requested from ip.
+// If the URL is bound to another address it isn't valid.
+func (m *Module) GetSignedFile(ctx context.Context, u SignedURL, ip net.IP) (*File, error) {
+	signature, err := base64.RawURLEncoding.DecodeString(u.Signature)
+	if err != nil {
+		return nil, ErrNotValidSignature
+	}
+
+	// Bound URL is signed with client address, which isn't passed in URL.
+	u.IP = nil
+	valid := hmac.Equal(signature, m.signer.Sign(u.payload()))
+	if !valid && ip != nil {
+		u.IP = ip
+		valid = hmac.Equal(signature, m.signer.Sign(u.payload()))
+	}
+
+	switch {
+	case !valid:
+		return nil, ErrNotValidSignature
+	case !time.Now().Before(u.ExpiresAt):
+		return nil, ErrURLExpired
+	}
+
+	return m.open(ctx, u.FileID)
+}
@@ -0,0 +1,160 @@
+package app_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// sign is fake signature, data is signed by itself.
+func sign(data []byte) []byte {
+	return data
+}
+
+func signature(u app.SignedURL) string {
+	ip := ""
+	if u.IP != nil {
+		ip = u.IP.String()
+	}
+	payload := fmt.Sprintf("%s\n%d\n%s\n%s", u.FileID, u.ExpiresAt.Unix(), u.Disposition, ip)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload))
+}
+
+func TestModule_SignURL(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		fileID    = uuid.Must(uuid.NewV4())
+		expiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+		file      = &app.File{ID: fileID, Owner: owner, Access: private}
+		u         = app.SignedURL{FileID: fileID, ExpiresAt: expiresAt}
+		bound     = app.SignedURL{FileID: fileID, ExpiresAt: expiresAt, Disposition: "attachment; filename=a.png", IP: net.IPv4(127, 0, 0, 1)}
+	)
+
+	withSignature := func(u app.SignedURL) *app.SignedURL {
+		u.Signature = signature(u)
+		return &u
+	}
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		u         app.SignedURL
+		want      *app.SignedURL
+		wantErr   error
+	}{
+		{"success", owner, u, withSignature(u), nil},
+		{"success_bound", owner, bound, withSignature(bound), nil},
+		{"err_expired", owner, app.SignedURL{FileID: fileID, ExpiresAt: time.Now().Add(-time.Second)}, nil, app.ErrNotValidExpiry},
+		{"err_too_long", owner, app.SignedURL{FileID: fileID, ExpiresAt: time.Now().Add(app.MaxSignTTL + time.Hour)}, nil, app.ErrNotValidExpiry},
+		{"err_not_valid_disposition", owner, app.SignedURL{FileID: fileID, ExpiresAt: expiresAt, Disposition: "form-data"}, nil, app.ErrNotValidDisposition},
+		{"err_not_found", owner, u, nil, app.ErrNotFound},
+		{"err_access_denied", stranger, u, nil, app.ErrAccessDenied},
+	}
+
+	gomock.InOrder(
+		// success
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.signer.EXPECT().Sign(gomock.Any()).DoAndReturn(sign),
+		// success_bound
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.signer.EXPECT().Sign(gomock.Any()).DoAndReturn(sign),
+		// err_not_found
+		m.repo.EXPECT().ByID(ctx, fileID).Return(nil, app.ErrNotFound),
+		// err_access_denied
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.SignURL(ctx, tc.principal, tc.u)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_GetSignedFile(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		fileID    = uuid.Must(uuid.NewV4())
+		clientIP  = net.IPv4(127, 0, 0, 1)
+		expiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+		content   = &nopReadSeekCloser{}
+		file      = &app.File{ID: fileID, Owner: owner, Access: private}
+		u         = app.SignedURL{FileID: fileID, ExpiresAt: expiresAt}
+		bound     = app.SignedURL{FileID: fileID, ExpiresAt: expiresAt, IP: clientIP}
+		expired   = app.SignedURL{FileID: fileID, ExpiresAt: time.Now().Add(-time.Hour).Truncate(time.Second)}
+	)
+
+	withSignature := func(u app.SignedURL) app.SignedURL {
+		u.Signature = signature(u)
+		u.IP = nil
+		return u
+	}
+	withContent := func(f *app.File) *app.File {
+		res := *f
+		res.ReadSeekCloser = content
+
+		return &res
+	}
+
+	testCases := []struct {
+		name    string
+		u       app.SignedURL
+		ip      net.IP
+		want    *app.File
+		wantErr error
+	}{
+		{"success", withSignature(u), clientIP, withContent(file), nil},
+		{"success_bound", withSignature(bound), clientIP, withContent(file), nil},
+		{"err_other_ip", withSignature(bound), net.IPv4(127, 0, 0, 2), nil, app.ErrNotValidSignature},
+		{"err_not_valid_encoding", app.SignedURL{FileID: fileID, ExpiresAt: expiresAt, Signature: "!"}, clientIP, nil, app.ErrNotValidSignature},
+		{"err_not_valid_signature", app.SignedURL{FileID: fileID, ExpiresAt: expiresAt, Signature: "sig"}, nil, nil, app.ErrNotValidSignature},
+		{"err_expired", withSignature(expired), clientIP, nil, app.ErrURLExpired},
+		{"err_not_found", withSignature(u), clientIP, nil, app.ErrNotFound},
+	}
+
+	gomock.InOrder(
+		// success
+		m.signer.EXPECT().Sign(gomock.Any()).DoAndReturn(sign),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		// success_bound
+		m.signer.EXPECT().Sign(gomock.Any()).DoAndReturn(sign).Times(2),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(file, nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		// err_other_ip
+		m.signer.EXPECT().Sign(gomock.Any()).DoAndReturn(sign).Times(2),
+		// err_not_valid_signature
+		m.signer.EXPECT().Sign(gomock.Any()).DoAndReturn(sign),
+		// err_expired
+		m.signer.EXPECT().Sign(gomock.Any()).DoAndReturn(sign),
+		// err_not_found
+		m.signer.EXPECT().Sign(gomock.Any()).DoAndReturn(sign),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(nil, app.ErrNotFound),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.GetSignedFile(ctx, tc.u, tc.ip)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
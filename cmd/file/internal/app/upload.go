@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// CreateUpload starts new resumable upload session of owner.
+// Size is declared size of whole file, 0 if unknown.
+// Policy is applied to file built from parts same as to file uploaded at once.
+func (m *Module) CreateUpload(ctx context.Context, owner Principal, size int64, policy Policy) (*UploadSession, error) {
+	if owner == Anonymous {
+		return nil, ErrAccessDenied
+	}
+
+	switch {
+	case size < 0:
+		return nil, ErrNotValidSize
+	case policy.MaxSize != 0 && size > policy.MaxSize:
+		return nil, ErrTooLarge
+	}
+
+	_, err := policy.account(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.upload.CreateSession(ctx, owner, size, policy)
+}
+
+// GetUpload returns upload session with received parts.
+// Only owner can get it.
+func (m *Module) GetUpload(ctx context.Context, principal Principal, sessionID uuid.UUID) (*UploadSession, error) {
+	return m.ownSession(ctx, principal, sessionID)
+}
+
+// UploadPart saves part of upload session.
+// Re-uploading part with same number replaces its content.
+// Part is rejected with ErrQuotaExceeded once all received parts
+// don't fit in remaining quota of charged account.
+func (m *Module) UploadPart(ctx context.Context, principal Principal, sessionID uuid.UUID, number int, part io.Reader) (*Part, error) {
+	if number < 1 || number > MaxParts {
+		return nil, ErrNotValidPart
+	}
+
+	session, err := m.ownSession(ctx, principal, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("m.ownSession: %w", err)
+	}
+
+	account, err := session.Policy.account(session.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := m.file.Quota(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("m.file.Quota: %w", err)
+	}
+
+	if !quota.fileAllowed() {
+		return nil, ErrQuotaExceeded
+	}
+
+	// Other parts are counted as used, so part is limited by what is left of quota.
+	quota.UsedBytes += session.receivedExcept(number)
+	limited := &io.LimitedReader{R: quota.limit(part), N: MaxPartSize + 1}
+	size, err := m.upload.SavePart(ctx, sessionID, number, limited)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("m.upload.SavePart: %w", err)
+	case size > MaxPartSize:
+		return nil, ErrPartTooLarge
+	case size == 0:
+		return nil, ErrEmptyPart
+	}
+
+	return &Part{
+		Number: number,
+		Size:   size,
+	}, nil
+}
+
+// CompleteUpload builds file from all parts of upload session and removes session.
+// File is owned by session owner and has given access.
+func (m *Module) CompleteUpload(ctx context.Context, principal Principal, sessionID uuid.UUID, access Access) (*File, error) {
+	session, err := m.ownSession(ctx, principal, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("m.ownSession: %w", err)
+	}
+
+	if !session.complete() {
+		return nil, ErrUploadIncomplete
+	}
+
+	content, err := m.upload.Content(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("m.upload.Content: %w", err)
+	}
+
+	file, err := m.UploadFile(ctx, session.Owner, content, session.Policy, access)
+	if err != nil {
+		return nil, fmt.Errorf("m.UploadFile: %w, content.Close: %s", err, content.Close())
+	}
+
+	err = content.Close()
+	if err != nil {
+		return nil, fmt.Errorf("content.Close: %w", err)
+	}
+
+	err = m.upload.DeleteSession(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("m.upload.DeleteSession: %w", err)
+	}
+
+	return file, nil
+}
+
+// AbortUpload removes upload session with all received parts.
+// Only owner can abort it.
+func (m *Module) AbortUpload(ctx context.Context, principal Principal, sessionID uuid.UUID) error {
+	_, err := m.ownSession(ctx, principal, sessionID)
+	if err != nil {
+		return fmt.Errorf("m.ownSession: %w", err)
+	}
+
+	return m.upload.DeleteSession(ctx, sessionID)
+}
+
+// DeleteStaleUploads removes upload sessions without new parts during ttl.
+func (m *Module) DeleteStaleUploads(ctx context.Context, ttl time.Duration) (int64, error) {
+	return m.upload.DeleteStale(ctx, time.Now().Add(-ttl))
+}
+
+func (m *Module) ownSession(ctx context.Context, principal Principal, sessionID uuid.UUID) (*UploadSession, error) {
+	session, err := m.upload.Session(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("m.upload.Session: %w", err)
+	}
+
+	if principal == Anonymous || session.Owner != principal {
+		return nil, ErrAccessDenied
+	}
+
+	return session, nil
+}
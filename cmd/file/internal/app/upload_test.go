@@ -0,0 +1,331 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+func TestModule_CreateUpload(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		policy  = app.Policy{AllowedTypes: []string{"image/*"}, MaxSize: 100}
+		session = &app.UploadSession{ID: uuid.Must(uuid.NewV4()), Owner: owner, Size: 100, Policy: policy}
+		denied  = app.Policy{Account: stranger}
+	)
+
+	testCases := []struct {
+		name    string
+		owner   app.Principal
+		size    int64
+		policy  app.Policy
+		want    *app.UploadSession
+		wantErr error
+	}{
+		{"success", owner, 100, policy, session, nil},
+		{"err_any", owner, 100, policy, nil, errAny},
+		{"err_not_valid_size", owner, -1, policy, nil, app.ErrNotValidSize},
+		{"err_too_large", owner, 101, policy, nil, app.ErrTooLarge},
+		{"err_account_denied", owner, 100, denied, nil, app.ErrAccessDenied},
+		{"err_anonymous", app.Anonymous, 100, policy, nil, app.ErrAccessDenied},
+	}
+
+	gomock.InOrder(
+		m.upload.EXPECT().CreateSession(ctx, owner, int64(100), policy).Return(session, nil),
+		m.upload.EXPECT().CreateSession(ctx, owner, int64(100), policy).Return(nil, errAny),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.CreateUpload(ctx, tc.owner, tc.size, tc.policy)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_UploadPart(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		sessionID = uuid.Must(uuid.NewV4())
+		session   = &app.UploadSession{ID: sessionID, Owner: owner}
+		content   = []byte("part content")
+		size      = int64(len(content))
+		parts     = []app.Part{{Number: 1, Size: size}, {Number: 2, Size: size}}
+		received  = &app.UploadSession{ID: sessionID, Owner: owner, Parts: parts}
+		account   = app.UserPrincipal(uuid.Must(uuid.NewV4()))
+		charged   = &app.UploadSession{ID: sessionID, Owner: owner, Policy: app.Policy{Account: account}}
+		denied    = &app.UploadSession{ID: sessionID, Owner: owner, Policy: app.Policy{Account: stranger}}
+		unlimited = &app.Quota{Owner: owner}
+		// Fits two parts, one of them is replaced.
+		twoParts    = &app.Quota{Owner: owner, MaxBytes: 2 * size}
+		noFilesLeft = &app.Quota{Owner: owner, MaxFiles: 1, UsedFiles: 1}
+	)
+
+	save := func(_ context.Context, _ uuid.UUID, _ int, r io.Reader) (int64, error) {
+		return io.Copy(io.Discard, r)
+	}
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		number    int
+		want      *app.Part
+		wantErr   error
+	}{
+		{"success", owner, 1, &app.Part{Number: 1, Size: size}, nil},
+		{"success_replace", owner, 2, &app.Part{Number: 2, Size: size}, nil},
+		{"success_account", owner, 1, &app.Part{Number: 1, Size: size}, nil},
+		{"err_not_found", owner, 1, nil, app.ErrNotFound},
+		{"err_not_owner", stranger, 1, nil, app.ErrAccessDenied},
+		{"err_account_denied", owner, 1, nil, app.ErrAccessDenied},
+		{"err_quota", owner, 1, nil, errAny},
+		{"err_quota_files", owner, 1, nil, app.ErrQuotaExceeded},
+		{"err_quota_bytes", owner, 3, nil, app.ErrQuotaExceeded},
+		{"err_too_large", owner, 1, nil, app.ErrPartTooLarge},
+		{"err_empty", owner, 1, nil, app.ErrEmptyPart},
+		{"err_zero_number", owner, 0, nil, app.ErrNotValidPart},
+		{"err_big_number", owner, app.MaxParts + 1, nil, app.ErrNotValidPart},
+	}
+
+	gomock.InOrder(
+		// success
+		m.upload.EXPECT().Session(ctx, sessionID).Return(session, nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.upload.EXPECT().SavePart(ctx, sessionID, 1, gomock.Any()).DoAndReturn(save),
+		// success_replace
+		m.upload.EXPECT().Session(ctx, sessionID).Return(received, nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(twoParts, nil),
+		m.upload.EXPECT().SavePart(ctx, sessionID, 2, gomock.Any()).DoAndReturn(save),
+		// success_account
+		m.upload.EXPECT().Session(ctx, sessionID).Return(charged, nil),
+		m.repo.EXPECT().Quota(ctx, account).Return(&app.Quota{Owner: account}, nil),
+		m.upload.EXPECT().SavePart(ctx, sessionID, 1, gomock.Any()).DoAndReturn(save),
+		// err_not_found
+		m.upload.EXPECT().Session(ctx, sessionID).Return(nil, app.ErrNotFound),
+		// err_not_owner
+		m.upload.EXPECT().Session(ctx, sessionID).Return(session, nil),
+		// err_account_denied
+		m.upload.EXPECT().Session(ctx, sessionID).Return(denied, nil),
+		// err_quota
+		m.upload.EXPECT().Session(ctx, sessionID).Return(session, nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(nil, errAny),
+		// err_quota_files
+		m.upload.EXPECT().Session(ctx, sessionID).Return(session, nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(noFilesLeft, nil),
+		// err_quota_bytes
+		m.upload.EXPECT().Session(ctx, sessionID).Return(received, nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(&app.Quota{Owner: owner, MaxBytes: 2 * size}, nil),
+		m.upload.EXPECT().SavePart(ctx, sessionID, 3, gomock.Any()).DoAndReturn(save),
+		// err_too_large
+		m.upload.EXPECT().Session(ctx, sessionID).Return(session, nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.upload.EXPECT().SavePart(ctx, sessionID, 1, gomock.Any()).Return(int64(app.MaxPartSize+1), nil),
+		// err_empty
+		m.upload.EXPECT().Session(ctx, sessionID).Return(session, nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(unlimited, nil),
+		m.upload.EXPECT().SavePart(ctx, sessionID, 1, gomock.Any()).Return(int64(0), nil),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.UploadPart(ctx, tc.principal, sessionID, tc.number, bytes.NewReader(content))
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_CompleteUpload(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		sessionID = uuid.Must(uuid.NewV4())
+		fileID    = uuid.Must(uuid.NewV4())
+		content   = []byte("first part, second part")
+		hash      = sha256.Sum256(content)
+		digest    = hex.EncodeToString(hash[:])
+		size      = int64(len(content))
+		parts     = []app.Part{{Number: 1, Size: 12}, {Number: 2, Size: size - 12}}
+		complete  = &app.UploadSession{ID: sessionID, Owner: owner, Size: size, Parts: parts}
+		withGap   = &app.UploadSession{ID: sessionID, Owner: owner, Parts: parts[1:]}
+		wrongSize = &app.UploadSession{ID: sessionID, Owner: owner, Size: size + 1, Parts: parts}
+		noParts   = &app.UploadSession{ID: sessionID, Owner: owner}
+		images    = &app.UploadSession{ID: sessionID, Owner: owner, Size: size, Parts: parts, Policy: app.Policy{AllowedTypes: []string{"image/*"}}}
+		file      = &app.File{
+			ID:          fileID,
+			Size:        size,
+			Digest:      digest,
+			ContentType: "text/plain; charset=utf-8",
+			Owner:       owner,
+			Account:     owner,
+			Access:      private,
+			Scan:        app.Scan{Status: app.ScanClean},
+		}
+		pending = &app.File{
+			ID:          fileID,
+			Size:        size,
+			Digest:      digest,
+			ContentType: file.ContentType,
+			Owner:       owner,
+			Account:     owner,
+			Access:      private,
+			Scan:        app.Scan{Status: app.ScanPending},
+		}
+		stored = &nopReadSeekCloser{}
+	)
+
+	put := func(_ context.Context, _ uuid.UUID, _ string, r io.Reader) (int64, error) {
+		return io.Copy(io.Discard, r)
+	}
+
+	testCases := []struct {
+		name    string
+		want    *app.File
+		wantErr error
+	}{
+		{"success", file, nil},
+		{"err_not_found", nil, app.ErrNotFound},
+		{"err_not_owner", nil, app.ErrAccessDenied},
+		{"err_gap", nil, app.ErrUploadIncomplete},
+		{"err_size", nil, app.ErrUploadIncomplete},
+		{"err_no_parts", nil, app.ErrUploadIncomplete},
+		{"err_content", nil, errAny},
+		{"err_not_allowed_type", nil, app.ErrNotAllowedType},
+	}
+
+	gomock.InOrder(
+		m.upload.EXPECT().Session(ctx, sessionID).Return(complete, nil),
+		m.upload.EXPECT().Content(ctx, sessionID).Return(io.NopCloser(bytes.NewReader(content)), nil),
+		m.repo.EXPECT().Quota(ctx, owner).Return(&app.Quota{Owner: owner}, nil),
+		m.repo.EXPECT().Create(ctx, owner, owner, private).Return(fileID, nil),
+		m.blob.EXPECT().Put(ctx, fileID, file.ContentType, gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, pending).Return(nil),
+		m.blob.EXPECT().Get(ctx, fileID).Return(stored, nil),
+		m.scan.EXPECT().Scan(ctx, stored).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, fileID, app.ScanClean, "").Return(nil),
+		m.upload.EXPECT().DeleteSession(ctx, sessionID).Return(nil),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(nil, app.ErrNotFound),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(&app.UploadSession{ID: sessionID, Owner: stranger, Parts: parts}, nil),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(withGap, nil),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(wrongSize, nil),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(noParts, nil),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(complete, nil),
+		m.upload.EXPECT().Content(ctx, sessionID).Return(nil, errAny),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(images, nil),
+		m.upload.EXPECT().Content(ctx, sessionID).Return(io.NopCloser(bytes.NewReader(content)), nil),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.CompleteUpload(ctx, owner, sessionID, private)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_AbortUpload(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		sessionID = uuid.Must(uuid.NewV4())
+		session   = &app.UploadSession{ID: sessionID, Owner: owner}
+	)
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		want      error
+	}{
+		{"success", owner, nil},
+		{"err_not_owner", stranger, app.ErrAccessDenied},
+		{"err_anonymous", app.Anonymous, app.ErrAccessDenied},
+		{"err_not_found", owner, app.ErrNotFound},
+	}
+
+	gomock.InOrder(
+		m.upload.EXPECT().Session(ctx, sessionID).Return(session, nil),
+		m.upload.EXPECT().DeleteSession(ctx, sessionID).Return(nil),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(session, nil),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(session, nil),
+		m.upload.EXPECT().Session(ctx, sessionID).Return(nil, app.ErrNotFound),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := module.AbortUpload(ctx, tc.principal, sessionID)
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestModule_DeleteStaleUploads(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	const ttl = time.Hour
+
+	m.upload.EXPECT().DeleteStale(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, updatedBefore time.Time) (int64, error) {
+		assert.WithinDuration(time.Now().Add(-ttl), updatedBefore, time.Minute)
+
+		return 2, nil
+	})
+
+	res, err := module.DeleteStaleUploads(ctx, ttl)
+	assert.NoError(err)
+	assert.Equal(int64(2), res)
+}
+
+func TestUploadSession_Offset(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		parts        []app.Part
+		wantOffset   int64
+		wantReceived int64
+	}{
+		{"empty", nil, 0, 0},
+		{"contiguous", []app.Part{{Number: 1, Size: 10}, {Number: 2, Size: 5}}, 15, 15},
+		{"gap", []app.Part{{Number: 1, Size: 10}, {Number: 3, Size: 5}}, 10, 15},
+		{"no_first", []app.Part{{Number: 2, Size: 10}}, 0, 10},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			session := &app.UploadSession{Parts: tc.parts}
+			assert := require.New(t)
+			assert.Equal(tc.wantOffset, session.Offset())
+			assert.Equal(tc.wantReceived, session.Received())
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+)
+
+// GetVariant returns resized image variant of the file.
+// Variant is made on first request and saved as derived file of the original,
+// it can be read by principals who can read the original.
+// Variant is charged to account of the original, so the charge is bounded
+// by len(Widths) variants of MaxVariantSize per original file.
+// After use, the file must be closed.
+func (m *Module) GetVariant(ctx context.Context, principal Principal, fileID uuid.UUID, width int) (*File, error) {
+	if !validWidth(width) {
+		return nil, ErrNotValidWidth
+	}
+
+	original, err := m.file.ByID(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("m.file.ByID: %w", err)
+	}
+
+	if !original.readable(principal) {
+		return nil, ErrAccessDenied
+	}
+
+	err = original.available()
+	if err != nil {
+		return nil, err
+	}
+
+	variantID, err := m.file.Variant(ctx, fileID, width)
+	switch {
+	case err == nil:
+		return m.open(ctx, variantID)
+	case !errors.Is(err, ErrNotFound):
+		return nil, fmt.Errorf("m.file.Variant: %w", err)
+	}
+
+	if !strings.HasPrefix(original.ContentType, "image/") {
+		return nil, ErrNotImage
+	}
+
+	content, err := m.blob.Get(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("m.blob.Get: %w", err)
+	}
+
+	buf := &bytes.Buffer{}
+	err = m.thumb.Resize(buf, content, width)
+	if err != nil {
+		return nil, fmt.Errorf("m.thumb.Resize: %w, content.Close: %s", err, content.Close())
+	}
+
+	err = content.Close()
+	if err != nil {
+		return nil, fmt.Errorf("content.Close: %w", err)
+	}
+
+	policy := Policy{
+		AllowedTypes: []string{"image/jpeg", "image/png"},
+		MaxSize:      MaxVariantSize,
+		Account:      original.Account,
+	}
+	variant, err := m.UploadFile(ctx, original.Owner, buf, policy, Access{Visibility: VisibilityPrivate})
+	if err != nil {
+		return nil, fmt.Errorf("m.UploadFile: %w", err)
+	}
+
+	err = m.file.SaveVariant(ctx, fileID, width, variant.ID)
+	switch {
+	// Variant was made by concurrent request.
+	case errors.Is(err, ErrVariantExist):
+		err = m.delete(ctx, variant.ID)
+		if err != nil {
+			return nil, fmt.Errorf("m.delete: %w", err)
+		}
+
+		return m.GetVariant(ctx, principal, fileID, width)
+	case err != nil:
+		return nil, fmt.Errorf("m.file.SaveVariant: %w, m.delete: %s", err, m.delete(ctx, variant.ID))
+	}
+
+	return m.open(ctx, variant.ID)
+}
+
+// open returns file with content without access check.
+// Content of not scanned clean file isn't returned.
+func (m *Module) open(ctx context.Context, fileID uuid.UUID) (*File, error) {
+	file, err := m.file.ByID(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("m.file.ByID: %w", err)
+	}
+
+	err = file.available()
+	if err != nil {
+		return nil, err
+	}
+
+	file.ReadSeekCloser, err = m.blob.Get(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("m.blob.Get: %w", err)
+	}
+
+	return file, nil
+}
+
+func validWidth(width int) bool {
+	for _, w := range Widths {
+		if w == width {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,156 @@
+package app_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+func TestModule_GetVariant(t *testing.T) {
+	t.Parallel()
+
+	module, m, assert := start(t)
+
+	var (
+		fileID      = uuid.Must(uuid.NewV4())
+		variantID   = uuid.Must(uuid.NewV4())
+		otherID     = uuid.Must(uuid.NewV4())
+		content     = &nopReadSeekCloser{}
+		account     = app.UserPrincipal(uuid.Must(uuid.NewV4()))
+		image       = &app.File{ID: fileID, ContentType: "image/png", Owner: owner, Account: account, Access: private}
+		public      = &app.File{ID: fileID, ContentType: "image/png", Owner: owner, Access: app.Access{Visibility: app.VisibilityPublic}}
+		text        = &app.File{ID: fileID, ContentType: "text/plain; charset=utf-8", Owner: owner, Access: private}
+		variant     = &app.File{ID: variantID, ContentType: "image/png", Owner: owner, Access: private}
+		other       = &app.File{ID: otherID, ContentType: "image/png", Owner: owner, Access: private}
+		quarantined = &app.File{ID: fileID, ContentType: "image/png", Owner: owner, Access: private, Scan: app.Scan{Status: app.ScanPending}}
+	)
+
+	resize := func(w io.Writer, _ io.Reader, _ int) error {
+		_, err := w.Write([]byte("\x89PNG\x0D\x0A\x1A\x0A"))
+		return err
+	}
+	resizeLarge := func(w io.Writer, _ io.Reader, _ int) error {
+		_, err := w.Write(append([]byte("\x89PNG\x0D\x0A\x1A\x0A"), make([]byte, app.MaxVariantSize)...))
+		return err
+	}
+	put := func(_ context.Context, _ uuid.UUID, _ string, r io.Reader) (int64, error) {
+		return io.Copy(io.Discard, r)
+	}
+	withContent := func(f *app.File) *app.File {
+		res := *f
+		res.ReadSeekCloser = content
+
+		return &res
+	}
+
+	testCases := []struct {
+		name      string
+		principal app.Principal
+		width     int
+		want      *app.File
+		wantErr   error
+	}{
+		{"success_cached", owner, 256, withContent(variant), nil},
+		{"success_public", app.Anonymous, 256, withContent(variant), nil},
+		{"success_made", owner, 256, withContent(variant), nil},
+		{"success_concurrent", owner, 256, withContent(other), nil},
+		{"err_not_valid_width", owner, 100, nil, app.ErrNotValidWidth},
+		{"err_access_denied", stranger, 256, nil, app.ErrAccessDenied},
+		{"err_not_found", owner, 256, nil, app.ErrNotFound},
+		{"err_quarantined", owner, 256, nil, app.ErrQuarantined},
+		{"err_not_image", owner, 256, nil, app.ErrNotImage},
+		{"err_resize", owner, 256, nil, errAny},
+		{"err_image_too_large", owner, 256, nil, app.ErrImageTooLarge},
+		{"err_variant_too_large", owner, 256, nil, app.ErrTooLarge},
+	}
+
+	gomock.InOrder(
+		// success_cached
+		m.repo.EXPECT().ByID(ctx, fileID).Return(image, nil),
+		m.repo.EXPECT().Variant(ctx, fileID, 256).Return(variantID, nil),
+		m.repo.EXPECT().ByID(ctx, variantID).Return(variant, nil),
+		m.blob.EXPECT().Get(ctx, variantID).Return(content, nil),
+		// success_public
+		m.repo.EXPECT().ByID(ctx, fileID).Return(public, nil),
+		m.repo.EXPECT().Variant(ctx, fileID, 256).Return(variantID, nil),
+		m.repo.EXPECT().ByID(ctx, variantID).Return(variant, nil),
+		m.blob.EXPECT().Get(ctx, variantID).Return(content, nil),
+		// success_made
+		m.repo.EXPECT().ByID(ctx, fileID).Return(image, nil),
+		m.repo.EXPECT().Variant(ctx, fileID, 256).Return(uuid.Nil, app.ErrNotFound),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		m.thumb.EXPECT().Resize(gomock.Any(), content, 256).DoAndReturn(resize),
+		m.repo.EXPECT().Quota(ctx, account).Return(&app.Quota{Owner: account}, nil),
+		m.repo.EXPECT().Create(ctx, owner, account, private).Return(variantID, nil),
+		m.blob.EXPECT().Put(ctx, variantID, "image/png", gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, gomock.Any()).Return(nil),
+		m.blob.EXPECT().Get(ctx, variantID).Return(content, nil),
+		m.scan.EXPECT().Scan(ctx, content).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, variantID, app.ScanClean, "").Return(nil),
+		m.repo.EXPECT().SaveVariant(ctx, fileID, 256, variantID).Return(nil),
+		m.repo.EXPECT().ByID(ctx, variantID).Return(variant, nil),
+		m.blob.EXPECT().Get(ctx, variantID).Return(content, nil),
+		// success_concurrent
+		m.repo.EXPECT().ByID(ctx, fileID).Return(image, nil),
+		m.repo.EXPECT().Variant(ctx, fileID, 256).Return(uuid.Nil, app.ErrNotFound),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		m.thumb.EXPECT().Resize(gomock.Any(), content, 256).DoAndReturn(resize),
+		m.repo.EXPECT().Quota(ctx, account).Return(&app.Quota{Owner: account}, nil),
+		m.repo.EXPECT().Create(ctx, owner, account, private).Return(variantID, nil),
+		m.blob.EXPECT().Put(ctx, variantID, "image/png", gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().SetContent(ctx, gomock.Any()).Return(nil),
+		m.blob.EXPECT().Get(ctx, variantID).Return(content, nil),
+		m.scan.EXPECT().Scan(ctx, content).Return("", nil),
+		m.repo.EXPECT().SetScan(ctx, variantID, app.ScanClean, "").Return(nil),
+		m.repo.EXPECT().SaveVariant(ctx, fileID, 256, variantID).Return(app.ErrVariantExist),
+		m.repo.EXPECT().Variants(ctx, variantID).Return(nil, nil),
+		m.blob.EXPECT().Delete(ctx, variantID).Return(nil),
+		m.repo.EXPECT().Delete(ctx, variantID).Return(nil),
+		m.repo.EXPECT().ByID(ctx, fileID).Return(image, nil),
+		m.repo.EXPECT().Variant(ctx, fileID, 256).Return(otherID, nil),
+		m.repo.EXPECT().ByID(ctx, otherID).Return(other, nil),
+		m.blob.EXPECT().Get(ctx, otherID).Return(content, nil),
+		// err_access_denied
+		m.repo.EXPECT().ByID(ctx, fileID).Return(image, nil),
+		// err_not_found
+		m.repo.EXPECT().ByID(ctx, fileID).Return(nil, app.ErrNotFound),
+		// err_quarantined
+		m.repo.EXPECT().ByID(ctx, fileID).Return(quarantined, nil),
+		// err_not_image
+		m.repo.EXPECT().ByID(ctx, fileID).Return(text, nil),
+		m.repo.EXPECT().Variant(ctx, fileID, 256).Return(uuid.Nil, app.ErrNotFound),
+		// err_resize
+		m.repo.EXPECT().ByID(ctx, fileID).Return(image, nil),
+		m.repo.EXPECT().Variant(ctx, fileID, 256).Return(uuid.Nil, app.ErrNotFound),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		m.thumb.EXPECT().Resize(gomock.Any(), content, 256).Return(errAny),
+		// err_image_too_large
+		m.repo.EXPECT().ByID(ctx, fileID).Return(image, nil),
+		m.repo.EXPECT().Variant(ctx, fileID, 256).Return(uuid.Nil, app.ErrNotFound),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		m.thumb.EXPECT().Resize(gomock.Any(), content, 256).Return(app.ErrImageTooLarge),
+		// err_variant_too_large
+		m.repo.EXPECT().ByID(ctx, fileID).Return(image, nil),
+		m.repo.EXPECT().Variant(ctx, fileID, 256).Return(uuid.Nil, app.ErrNotFound),
+		m.blob.EXPECT().Get(ctx, fileID).Return(content, nil),
+		m.thumb.EXPECT().Resize(gomock.Any(), content, 256).DoAndReturn(resizeLarge),
+		m.repo.EXPECT().Quota(ctx, account).Return(&app.Quota{Owner: account}, nil),
+		m.repo.EXPECT().Create(ctx, owner, account, private).Return(variantID, nil),
+		m.blob.EXPECT().Put(ctx, variantID, "image/png", gomock.Any()).DoAndReturn(put),
+		m.repo.EXPECT().Delete(ctx, variantID).Return(nil),
+	)
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.GetVariant(ctx, tc.principal, fileID, tc.width)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Package clamd contains implements for app.Scanner.
+// Scan file content by ClamAV daemon using INSTREAM command.
+package clamd
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+var _ app.Scanner = &Client{}
+
+// chunkSize max size of content chunk sent to daemon.
+const chunkSize = 32 << 10
+
+// Daemon replies.
+const (
+	replyPrefix = "stream: "
+	replyOK     = "OK"
+	replyFound  = " FOUND"
+)
+
+// ErrScan is returned when daemon can't scan content, e.g. content exceeds daemon limits.
+var ErrScan = errors.New("scan failed")
+
+type (
+	// Config for connecting to daemon.
+	Config struct {
+		// Addr contains TCP address "host:port" or path of unix socket.
+		Addr string
+		// Timeout contains max duration of scanning one file, unlimited if 0.
+		Timeout time.Duration
+	}
+
+	// Client scans content by ClamAV daemon, new connection is used for every file.
+	Client struct {
+		network string
+		addr    string
+		timeout time.Duration
+	}
+)
+
+// New build and returns new Client.
+func New(cfg Config) *Client {
+	network := "tcp"
+	if strings.HasPrefix(cfg.Addr, "/") {
+		network = "unix"
+	}
+
+	return &Client{
+		network: network,
+		addr:    cfg.Addr,
+		timeout: cfg.Timeout,
+	}
+}
+
+// Scan for implements app.Scanner.
+func (c *Client) Scan(ctx context.Context, r io.Reader) (threat string, err error) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, c.network, c.addr)
+	if err != nil {
+		return "", fmt.Errorf("dialer.DialContext: %w", err)
+	}
+	defer func() {
+		errClose := conn.Close()
+		if err == nil && errClose != nil {
+			err = fmt.Errorf("conn.Close: %w", errClose)
+		}
+	}()
+
+	deadline, ok := ctx.Deadline()
+	if ok {
+		err = conn.SetDeadline(deadline)
+		if err != nil {
+			return "", fmt.Errorf("conn.SetDeadline: %w", err)
+		}
+	}
+
+	errWrite := send(conn, r)
+	var errRead *readError
+	if errors.As(errWrite, &errRead) {
+		return "", errWrite
+	}
+
+	// Daemon replies with error and closes connection if content exceeds its limits,
+	// so reply is read even if content wasn't sent.
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if errors.Is(err, io.EOF) && reply != "" {
+		err = nil
+	}
+
+	switch {
+	case err != nil && errWrite != nil:
+		return "", fmt.Errorf("send: %w", errWrite)
+	case err != nil:
+		return "", fmt.Errorf("ReadString: %w", err)
+	}
+
+	return parse(strings.TrimSuffix(reply, "\x00"))
+}
+
+// readError is returned by send when content can't be read.
+type readError struct {
+	err error
+}
+
+func (e *readError) Error() string { return "io.ReadFull: " + e.err.Error() }
+func (e *readError) Unwrap() error { return e.err }
+
+// send writes INSTREAM command with content split into chunks,
+// each chunk is prefixed by its size, zero size ends stream.
+func send(w io.Writer, r io.Reader) error {
+	_, err := io.WriteString(w, "zINSTREAM\x00")
+	if err != nil {
+		return fmt.Errorf("io.WriteString: %w", err)
+	}
+
+	buf := make([]byte, 4+chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf[4:])
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return &readError{err: err}
+		}
+
+		// Chunk of zero size ends stream.
+		binary.BigEndian.PutUint32(buf[:4], uint32(n))
+		_, err = w.Write(buf[:4+n])
+		if err != nil {
+			return fmt.Errorf("w.Write: %w", err)
+		}
+
+		if n == 0 {
+			return nil
+		}
+	}
+}
+
+// parse returns threat found by daemon, e.g. "stream: Eicar-Signature FOUND",
+// or empty threat for "stream: OK".
+func parse(reply string) (string, error) {
+	switch {
+	case reply == replyPrefix+replyOK:
+		return "", nil
+	case strings.HasPrefix(reply, replyPrefix) && strings.HasSuffix(reply, replyFound):
+		return strings.TrimSuffix(strings.TrimPrefix(reply, replyPrefix), replyFound), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrScan, reply)
+	}
+}
@@ -0,0 +1,74 @@
+package clamd_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/clamd"
+)
+
+var errAny = errors.New("any error")
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errAny }
+
+func TestClient_Scan(t *testing.T) {
+	t.Parallel()
+
+	client, daemon, assert := start(t)
+
+	var (
+		ctx     = context.Background()
+		clean   = []byte("clean content")
+		large   = bytes.Repeat([]byte("chunk"), 20000)
+		chunked = bytes.Repeat([]byte("a"), 32<<10)
+	)
+
+	testCases := []struct {
+		name     string
+		content  io.Reader
+		want     string
+		received []byte
+		wantErr  error
+	}{
+		{"success_clean", bytes.NewReader(clean), "", clean, nil},
+		{"success_infected", bytes.NewReader(eicar), "Eicar-Test-Signature", eicar, nil},
+		{"success_empty", bytes.NewReader(nil), "", []byte{}, nil},
+		{"success_chunks", bytes.NewReader(large), "", large, nil},
+		{"success_exact_chunk", bytes.NewReader(chunked), "", chunked, nil},
+		{"err_reply", bytes.NewReader(tooLarge), "", nil, clamd.ErrScan},
+		{"err_size_limit", bytes.NewReader(make([]byte, maxStream+1)), "", nil, clamd.ErrScan},
+		{"err_read", errReader{}, "", nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := client.Scan(ctx, tc.content)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+			if tc.received != nil {
+				assert.Equal(tc.received, daemon.lastReceived())
+			}
+		})
+	}
+}
+
+func TestClient_ScanUnavailable(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	client := clamd.New(clamd.Config{Addr: "127.0.0.1:1"})
+	res, err := client.Scan(ctx, bytes.NewReader([]byte("content")))
+	require.Error(t, err)
+	require.Empty(t, res)
+}
@@ -0,0 +1,112 @@
+package clamd_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/clamd"
+)
+
+// Content which makes fake daemon reply with threat or error.
+var (
+	eicar     = []byte("X5O!P%@AP[4\\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*")
+	tooLarge  = []byte("too large")
+	maxStream = 1 << 20
+)
+
+// daemon is fake ClamAV daemon which accepts only INSTREAM command.
+type daemon struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	received []byte
+}
+
+func (d *daemon) serve() {
+	for {
+		conn, err := d.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go d.handle(conn)
+	}
+}
+
+func (d *daemon) handle(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	cmd, err := r.ReadString(0)
+	if err != nil || cmd != "zINSTREAM\x00" {
+		_, _ = io.WriteString(conn, "UNKNOWN COMMAND\x00")
+		return
+	}
+
+	content := &bytes.Buffer{}
+	for {
+		var size uint32
+		err = binary.Read(r, binary.BigEndian, &size)
+		if err != nil {
+			return
+		}
+
+		if size == 0 {
+			break
+		}
+
+		if content.Len()+int(size) > maxStream {
+			_, _ = io.WriteString(conn, "INSTREAM size limit exceeded. ERROR\x00")
+			return
+		}
+
+		_, err = io.CopyN(content, r, int64(size))
+		if err != nil {
+			return
+		}
+	}
+
+	d.mu.Lock()
+	d.received = append([]byte{}, content.Bytes()...)
+	d.mu.Unlock()
+
+	switch {
+	case bytes.Equal(content.Bytes(), eicar):
+		_, _ = io.WriteString(conn, "stream: Eicar-Test-Signature FOUND\x00")
+	case bytes.Equal(content.Bytes(), tooLarge):
+		_, _ = io.WriteString(conn, "stream: Can't allocate memory ERROR\x00")
+	default:
+		_, _ = io.WriteString(conn, "stream: OK\x00")
+	}
+}
+
+func (d *daemon) lastReceived() []byte {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.received
+}
+
+func start(t *testing.T) (*clamd.Client, *daemon, *require.Assertions) {
+	t.Helper()
+	assert := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+	t.Cleanup(func() {
+		assert.NoError(listener.Close())
+	})
+
+	d := &daemon{listener: listener}
+	go d.serve()
+
+	return clamd.New(clamd.Config{Addr: listener.Addr().String(), Timeout: 5 * time.Second}), d, assert
+}
@@ -0,0 +1,111 @@
+// Package disk contains implements for app.BlobStore.
+// Provide file content to and from local file system.
+package disk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+var _ app.BlobStore = &Store{}
+
+const (
+	dirPerm  = 0o750
+	tmpDir   = ".tmp"
+	fanout   = 2
+	tmpTempl = "upload-*"
+)
+
+// Store provided file content from and to local directory.
+// Every file is kept in separate file named by file id and
+// placed in sub directory by first symbols of id.
+type Store struct {
+	dir string
+}
+
+// New build and returns disk store.
+// Directory will be created if not exists.
+func New(dir string) (*Store, error) {
+	err := os.MkdirAll(filepath.Join(dir, tmpDir), dirPerm)
+	if err != nil {
+		return nil, fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	return &Store{
+		dir: dir,
+	}, nil
+}
+
+// Put for implements app.BlobStore.
+func (s *Store) Put(_ context.Context, fileID uuid.UUID, _ string, reader io.Reader) (size int64, err error) {
+	tmp, err := os.CreateTemp(filepath.Join(s.dir, tmpDir), tmpTempl)
+	if err != nil {
+		return 0, fmt.Errorf("os.CreateTemp: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("%w, os.Remove: %s", err, os.Remove(tmp.Name()))
+		}
+	}()
+
+	size, err = io.Copy(tmp, reader)
+	if err != nil {
+		return 0, fmt.Errorf("io.Copy: %w, tmp.Close: %s", err, tmp.Close())
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return 0, fmt.Errorf("tmp.Close: %w", err)
+	}
+
+	path := s.path(fileID)
+	err = os.MkdirAll(filepath.Dir(path), dirPerm)
+	if err != nil {
+		return 0, fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return 0, fmt.Errorf("os.Rename: %w", err)
+	}
+
+	return size, nil
+}
+
+// Get for implements app.BlobStore.
+func (s *Store) Get(_ context.Context, fileID uuid.UUID) (io.ReadSeekCloser, error) {
+	f, err := os.Open(s.path(fileID))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, app.ErrNotFound
+	case err != nil:
+		return nil, fmt.Errorf("os.Open: %w", err)
+	}
+
+	return f, nil
+}
+
+// Delete for implements app.BlobStore.
+func (s *Store) Delete(_ context.Context, fileID uuid.UUID) error {
+	err := os.Remove(s.path(fileID))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("os.Remove: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) path(fileID uuid.UUID) string {
+	name := fileID.String()
+
+	return filepath.Join(s.dir, name[:fanout], name)
+}
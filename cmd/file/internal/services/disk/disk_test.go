@@ -0,0 +1,58 @@
+package disk_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+func TestStore_Smoke(t *testing.T) {
+	t.Parallel()
+
+	ctx, store, assert := start(t)
+
+	f, err := os.Open(testFile)
+	assert.NoError(err)
+	defer func() {
+		assert.NoError(f.Close())
+	}()
+
+	fileID := uuid.Must(uuid.NewV4())
+	size, err := store.Put(ctx, fileID, contentType, f)
+	assert.NoError(err)
+
+	_, err = f.Seek(0, io.SeekStart)
+	assert.NoError(err)
+
+	bufOldFile, err := io.ReadAll(f)
+	assert.NoError(err)
+	assert.EqualValues(len(bufOldFile), size)
+
+	content, err := store.Get(ctx, fileID)
+	assert.NoError(err)
+
+	bufNewFile, err := io.ReadAll(content)
+	assert.NoError(err)
+	assert.Equal(bufOldFile, bufNewFile)
+
+	_, err = content.Seek(10, io.SeekStart)
+	assert.NoError(err)
+	bufNewFile, err = io.ReadAll(content)
+	assert.NoError(err)
+	assert.Equal(bufOldFile[10:], bufNewFile)
+	assert.NoError(content.Close())
+
+	err = store.Delete(ctx, fileID)
+	assert.NoError(err)
+
+	content, err = store.Get(ctx, fileID)
+	assert.Nil(content)
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	err = store.Delete(ctx, fileID)
+	assert.NoError(err)
+}
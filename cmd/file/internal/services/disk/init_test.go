@@ -0,0 +1,34 @@
+package disk_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/disk"
+)
+
+const (
+	testFile    = `../../../testdata/test.jpg`
+	contentType = `image/jpeg`
+	timeout     = time.Second * 5
+)
+
+var logger = zerolog.New(os.Stdout)
+
+func start(t *testing.T) (context.Context, *disk.Store, *require.Assertions) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	assert := require.New(t)
+	store, err := disk.New(t.TempDir())
+	assert.NoError(err)
+
+	return logger.WithContext(ctx), store, assert
+}
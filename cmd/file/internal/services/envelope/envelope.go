@@ -0,0 +1,95 @@
+// Package envelope contains implements for repo.Wrapper.
+// Wraps file data keys by AES-GCM with master keys.
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/repo"
+)
+
+var _ repo.Wrapper = &Envelope{}
+
+// Errors.
+var (
+	ErrUnknownKey     = errors.New("unknown master key")
+	ErrNotValidKey    = errors.New("not valid master key")
+	ErrNotValidLength = errors.New("not valid wrapped key length")
+)
+
+// Envelope wraps data keys by current master key and unwraps by any known one.
+type Envelope struct {
+	keyID string
+	keys  map[string]cipher.AEAD
+}
+
+// New build and returns new Envelope.
+// Keys contains AES-128, AES-192 or AES-256 master keys by id, keyID is id of current key.
+// Previous keys are kept for unwrapping until data keys are rewrapped.
+func New(keyID string, keys map[string][]byte) (*Envelope, error) {
+	e := &Envelope{
+		keyID: keyID,
+		keys:  make(map[string]cipher.AEAD, len(keys)),
+	}
+
+	for id, key := range keys {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", ErrNotValidKey, id, err)
+		}
+
+		e.keys[id], err = cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("cipher.NewGCM: %w", err)
+		}
+	}
+
+	if _, ok := e.keys[keyID]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, keyID)
+	}
+
+	return e, nil
+}
+
+// KeyID for implements repo.Wrapper.
+func (e *Envelope) KeyID() string {
+	return e.keyID
+}
+
+// Wrap for implements repo.Wrapper.
+func (e *Envelope) Wrap(dataKey []byte) (keyID string, wrapped []byte, err error) {
+	aead := e.keys[e.keyID]
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", nil, fmt.Errorf("rand.Read: %w", err)
+	}
+
+	// Key id is authenticated, so wrapped key can't be moved to other master key.
+	return e.keyID, aead.Seal(nonce, nonce, dataKey, []byte(e.keyID)), nil
+}
+
+// Unwrap for implements repo.Wrapper.
+func (e *Envelope) Unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := e.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, keyID)
+	}
+
+	if len(wrapped) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrNotValidLength
+	}
+
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealed, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("aead.Open: %w", err)
+	}
+
+	return dataKey, nil
+}
@@ -0,0 +1,102 @@
+package envelope_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/envelope"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		keyID string
+		keys  map[string][]byte
+		want  error
+	}{
+		{"success", "old", map[string][]byte{"old": oldKey}, nil},
+		{"err_unknown_key", "new", map[string][]byte{"old": oldKey}, envelope.ErrUnknownKey},
+		{"err_not_valid_key", "old", map[string][]byte{"old": []byte("short")}, envelope.ErrNotValidKey},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := envelope.New(tc.keyID, tc.keys)
+			require.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestEnvelope_Smoke(t *testing.T) {
+	t.Parallel()
+
+	dataKey := bytes.Repeat([]byte{3}, 32)
+
+	old, assert := start(t, "old")
+	keyID, wrapped, err := old.Wrap(dataKey)
+	assert.NoError(err)
+	assert.Equal("old", keyID)
+	assert.NotContains(string(wrapped), string(dataKey))
+
+	// Rotated envelope unwraps keys of previous master key.
+	rotated, _ := start(t, "new")
+	assert.Equal("new", rotated.KeyID())
+
+	res, err := rotated.Unwrap(keyID, wrapped)
+	assert.NoError(err)
+	assert.Equal(dataKey, res)
+
+	keyID, rewrapped, err := rotated.Wrap(res)
+	assert.NoError(err)
+	assert.Equal("new", keyID)
+
+	res, err = old.Unwrap(keyID, rewrapped)
+	assert.NoError(err)
+	assert.Equal(dataKey, res)
+}
+
+func TestEnvelope_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	e, assert := start(t, "old")
+	_, wrapped, err := e.Wrap(bytes.Repeat([]byte{3}, 32))
+	assert.NoError(err)
+
+	tampered := append([]byte(nil), wrapped...)
+	tampered[len(tampered)-1] ^= 1
+
+	testCases := []struct {
+		name    string
+		keyID   string
+		wrapped []byte
+		want    error
+	}{
+		{"err_unknown_key", "unknown", wrapped, envelope.ErrUnknownKey},
+		{"err_not_valid_length", "old", wrapped[:10], envelope.ErrNotValidLength},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := e.Unwrap(tc.keyID, tc.wrapped)
+			require.Nil(t, res)
+			require.ErrorIs(t, err, tc.want)
+		})
+	}
+
+	// Wrapped key is authenticated with id of its master key.
+	_, err = e.Unwrap("new", wrapped)
+	assert.Error(err)
+
+	_, err = e.Unwrap("old", tampered)
+	assert.Error(err)
+}
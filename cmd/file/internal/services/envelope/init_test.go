@@ -0,0 +1,25 @@
+package envelope_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/envelope"
+)
+
+var (
+	oldKey = bytes.Repeat([]byte{1}, 32)
+	newKey = bytes.Repeat([]byte{2}, 16)
+)
+
+func start(t *testing.T, keyID string) (*envelope.Envelope, *require.Assertions) {
+	t.Helper()
+
+	assert := require.New(t)
+	e, err := envelope.New(keyID, map[string][]byte{"old": oldKey, "new": newKey})
+	assert.NoError(err)
+
+	return e, assert
+}
@@ -0,0 +1,481 @@
+package repo
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/libs/db"
+)
+
+var (
+	_ app.BlobStore   = &ChunkStore{}
+	_ app.ChunkReaper = &ChunkStore{}
+)
+
+type (
+	// ChunkStore provided file content from and to database.
+	// Content is split into chunks keyed by SHA-256, so identical chunks
+	// are stored once and shared between files by reference count.
+	// Chunks are keyed by hash of stored bytes and their encoding, so encrypted chunks are never shared.
+	ChunkStore struct {
+		db        *db.DB
+		metric    *Metrics
+		wrapper   Wrapper
+		chunkSize int
+		readAhead int
+		maxRatio  float64
+	}
+	// ChunkStoreOption for building ChunkStore.
+	ChunkStoreOption func(*ChunkStore)
+
+	// Wrapper wraps and unwraps file data keys by master key.
+	Wrapper interface {
+		// KeyID returns id of current master key.
+		KeyID() string
+		// Wrap encrypts data key by current master key.
+		Wrap(dataKey []byte) (keyID string, wrapped []byte, err error)
+		// Unwrap decrypts data key by master key with keyID.
+		Unwrap(keyID string, wrapped []byte) ([]byte, error)
+	}
+
+	blob struct {
+		Hash      []byte           `db:"hash"`
+		Bytes     pgtype.Bytea     `db:"bytes"`
+		Encoding  string           `db:"encoding"`
+		RefCount  int64            `db:"ref_count"`
+		CreatedAt pgtype.Timestamp `db:"created_at"`
+		UpdatedAt pgtype.Timestamp `db:"updated_at"`
+	}
+
+	chunkedFile struct {
+		Size      int64  `db:"size"`
+		ChunkSize int64  `db:"chunk_size"`
+		KeyID     string `db:"key_id"`
+		DataKey   []byte `db:"data_key"`
+	}
+
+	fileChunk struct {
+		Hash     []byte `db:"hash"`
+		Encoding string `db:"encoding"`
+	}
+
+	// blobKey identifies blob by hash of stored bytes and their encoding.
+	blobKey struct {
+		hash     string
+		encoding string
+	}
+
+	fileKey struct {
+		ID      pgtype.UUID `db:"id"`
+		KeyID   string      `db:"key_id"`
+		DataKey []byte      `db:"data_key"`
+	}
+)
+
+// ChunkSize option for sets size of new file chunks, app.MaxChunkSize by default.
+// Stored files keep size of their chunks.
+func ChunkSize(size int) ChunkStoreOption {
+	return func(c *ChunkStore) {
+		c.chunkSize = size
+	}
+}
+
+// ReadAhead option for sets amount of bytes fetched by one query while reading file,
+// next batch is prefetched while current one is read. Defaults to 1 MiB.
+func ReadAhead(size int) ChunkStoreOption {
+	return func(c *ChunkStore) {
+		c.readAhead = size
+	}
+}
+
+// Encryption option for sets wrapper of file data keys.
+// New files are encrypted by own data key, stored files keep their encryption.
+// Chunks of encrypted files are never deduplicated, because data keys of files differ.
+func Encryption(w Wrapper) ChunkStoreOption {
+	return func(c *ChunkStore) {
+		c.wrapper = w
+	}
+}
+
+// Compression option for enables compression of chunks by gzip or zstd, chosen by file content type.
+// Chunk is stored compressed only if it is compressed at least to maxRatio of its size, e.g. 0.9.
+func Compression(maxRatio float64) ChunkStoreOption {
+	return func(c *ChunkStore) {
+		c.maxRatio = maxRatio
+	}
+}
+
+// Metric option for sets metrics of reading file chunks.
+func Metric(m *Metrics) ChunkStoreOption {
+	return func(c *ChunkStore) {
+		c.metric = m
+	}
+}
+
+// NewChunkStore build and returns chunk store.
+func NewChunkStore(r *db.DB, options ...ChunkStoreOption) *ChunkStore {
+	c := &ChunkStore{
+		db:        r,
+		metric:    newMetrics(""),
+		chunkSize: app.MaxChunkSize,
+		readAhead: maxBatchSize,
+	}
+
+	for i := range options {
+		options[i](c)
+	}
+
+	return c
+}
+
+// Put for implements app.BlobStore.
+func (c *ChunkStore) Put(ctx context.Context, fileID uuid.UUID, contentType string, reader io.Reader) (size int64, err error) {
+	codec := newChunkCodec(contentType, c.maxRatio)
+
+	err = c.db.Tx(ctx, nil, func(tx *sqlx.Tx) (err error) {
+		const querySetChunks = `update files set chunk_size = $2, key_id = $3, data_key = $4 where id = $1`
+
+		var (
+			cc      *chunkCipher
+			keyID   string
+			wrapped []byte
+		)
+		if c.wrapper != nil {
+			dataKey, err := newDataKey()
+			if err != nil {
+				return fmt.Errorf("newDataKey: %w", err)
+			}
+
+			keyID, wrapped, err = c.wrapper.Wrap(dataKey)
+			if err != nil {
+				return fmt.Errorf("c.wrapper.Wrap: %w", err)
+			}
+
+			cc, err = newChunkCipher(dataKey, fileID)
+			if err != nil {
+				return fmt.Errorf("newChunkCipher: %w", err)
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, querySetChunks, fileID, c.chunkSize, keyID, wrapped)
+		if err != nil {
+			return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+		}
+
+		// Chunks must be full-sized for dedup and for offset calculation while reading.
+		size, err = readChunks(reader, c.chunkSize, func(seq int, chunks [][]byte) error {
+			return saveChunks(ctx, tx, fileID, seq, chunks, codec, cc)
+		})
+		if err != nil {
+			return fmt.Errorf("readChunks: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// Get for implements app.BlobStore.
+func (c *ChunkStore) Get(ctx context.Context, fileID uuid.UUID) (res io.ReadSeekCloser, err error) {
+	err = c.db.NoTx(func(db *sqlx.DB) error {
+		const (
+			queryFile   = `select size, chunk_size, key_id, data_key from files where id = $1`
+			queryChunks = `
+			select hash, encoding from file_chunks
+			where file_id = $1 order by seq`
+		)
+
+		var info chunkedFile
+		err := db.GetContext(ctx, &info, queryFile, fileID)
+		if err != nil {
+			return fmt.Errorf("db.GetContext: %w", convertErr(err))
+		}
+
+		var chunks []fileChunk
+		err = db.SelectContext(ctx, &chunks, queryChunks, fileID)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", convertErr(err))
+		}
+
+		hashes := make([][]byte, len(chunks))
+		encodings := make([]string, len(chunks))
+		for i := range chunks {
+			hashes[i], encodings[i] = chunks[i].Hash, chunks[i].Encoding
+		}
+
+		cc, err := c.fileCipher(fileID, info.KeyID, info.DataKey)
+		if err != nil {
+			return fmt.Errorf("c.fileCipher: %w", err)
+		}
+
+		window := c.readAhead / int(info.ChunkSize)
+		if window < 1 {
+			window = 1
+		}
+
+		// Chunks are fetched while reading, so reading is canceled with request.
+		ctx, cancel := context.WithCancel(ctx)
+		res = &file{
+			ctx:       ctx,
+			cancel:    cancel,
+			db:        db,
+			metric:    c.metric,
+			cipher:    cc,
+			chunks:    hashes,
+			encodings: encodings,
+			isClosed:  false,
+			size:      info.Size,
+			chunkSize: info.ChunkSize,
+			window:    window,
+			position:  0,
+			cached:    -1,
+			cache:     nil,
+			prefetch:  nil,
+			error:     nil,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Delete for implements app.BlobStore.
+// Chunks are removed only when no other file refers to them.
+func (c *ChunkStore) Delete(ctx context.Context, fileID uuid.UUID) error {
+	return c.db.Tx(ctx, nil, func(tx *sqlx.Tx) error {
+		const (
+			queryDeleteChunks = `delete from file_chunks where file_id = $1 returning hash, encoding`
+			queryDecRef       = `
+			update blobs set ref_count = ref_count - 1, updated_at = now()
+			where hash = $1 and encoding = $2
+			returning ref_count`
+			queryDeleteBlob = `delete from blobs where hash = $1 and encoding = $2 and ref_count <= 0`
+		)
+
+		var chunks []fileChunk
+		err := tx.SelectContext(ctx, &chunks, queryDeleteChunks, fileID)
+		if err != nil {
+			return fmt.Errorf("tx.SelectContext: %w", convertErr(err))
+		}
+
+		for _, chunk := range chunks {
+			var refCount int64
+			err = tx.GetContext(ctx, &refCount, queryDecRef, chunk.Hash, chunk.Encoding)
+			if err != nil {
+				return fmt.Errorf("tx.GetContext: %w", convertErr(err))
+			}
+
+			if refCount > 0 {
+				continue
+			}
+
+			_, err = tx.ExecContext(ctx, queryDeleteBlob, chunk.Hash, chunk.Encoding)
+			if err != nil {
+				return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+			}
+		}
+
+		return nil
+	})
+}
+
+// Rewrap rewraps data keys wrapped by previous master keys with current one,
+// keys of batchSize files are rewrapped by one transaction.
+// Returns amount of rewrapped keys.
+func (c *ChunkStore) Rewrap(ctx context.Context, batchSize int) (total int64, err error) {
+	if c.wrapper == nil {
+		return 0, nil
+	}
+
+	for ctx.Err() == nil {
+		rewrapped, err := c.rewrapBatch(ctx, batchSize)
+		if err != nil {
+			return total, err
+		}
+		total += rewrapped
+
+		if rewrapped < int64(batchSize) {
+			return total, nil
+		}
+	}
+
+	return total, ctx.Err()
+}
+
+func (c *ChunkStore) rewrapBatch(ctx context.Context, batchSize int) (total int64, err error) {
+	err = c.db.Tx(ctx, nil, func(tx *sqlx.Tx) error {
+		const (
+			querySelect = `select id, key_id, data_key from files where key_id != '' and key_id != $1 order by id limit $2`
+			queryUpdate = `update files set key_id = $2, data_key = $3 where id = $1`
+		)
+
+		var keys []fileKey
+		err := tx.SelectContext(ctx, &keys, querySelect, c.wrapper.KeyID(), batchSize)
+		if err != nil {
+			return fmt.Errorf("tx.SelectContext: %w", convertErr(err))
+		}
+
+		for _, key := range keys {
+			dataKey, err := c.wrapper.Unwrap(key.KeyID, key.DataKey)
+			if err != nil {
+				return fmt.Errorf("c.wrapper.Unwrap: %w", err)
+			}
+
+			keyID, wrapped, err := c.wrapper.Wrap(dataKey)
+			if err != nil {
+				return fmt.Errorf("c.wrapper.Wrap: %w", err)
+			}
+
+			_, err = tx.ExecContext(ctx, queryUpdate, key.ID, keyID, wrapped)
+			if err != nil {
+				return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+			}
+		}
+		total = int64(len(keys))
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// DeleteDangling for implements app.ChunkReaper.
+func (c *ChunkStore) DeleteDangling(ctx context.Context, limit int, dryRun bool) (total int64, err error) {
+	err = c.db.NoTx(func(db *sqlx.DB) error {
+		const (
+			queryCount = `
+			select count(*) from (
+				select 1 from blobs
+				where not exists (
+					select 1 from file_chunks where file_chunks.hash = blobs.hash and file_chunks.encoding = blobs.encoding
+				)
+				limit $1
+			) as dangling`
+			queryDelete = `
+			delete from blobs
+			where not exists (
+				select 1 from file_chunks where file_chunks.hash = blobs.hash and file_chunks.encoding = blobs.encoding
+			)
+			limit $1`
+		)
+
+		if dryRun {
+			err := db.GetContext(ctx, &total, queryCount, limit)
+			if err != nil {
+				return fmt.Errorf("db.GetContext: %w", convertErr(err))
+			}
+
+			return nil
+		}
+
+		result, err := db.ExecContext(ctx, queryDelete, limit)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", convertErr(err))
+		}
+
+		total, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// fileCipher returns cipher of file chunks, nil for file stored in plaintext.
+func (c *ChunkStore) fileCipher(fileID uuid.UUID, keyID string, wrapped []byte) (*chunkCipher, error) {
+	switch {
+	case keyID == "":
+		return nil, nil
+	case c.wrapper == nil:
+		return nil, ErrNoWrapper
+	}
+
+	dataKey, err := c.wrapper.Unwrap(keyID, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("c.wrapper.Unwrap: %w", err)
+	}
+
+	return newChunkCipher(dataKey, fileID)
+}
+
+// saveChunks saves batch of file chunks starting with seq,
+// blobs and file chunks are written by one statement each.
+// Chunks are compressed before encryption.
+func saveChunks(ctx context.Context, tx *sqlx.Tx, fileID uuid.UUID, seq int, chunks [][]byte, codec chunkCodec, cc *chunkCipher) error {
+	const querySaveBlobs = `
+	on conflict (hash, encoding) do update set ref_count = blobs.ref_count + excluded.ref_count, updated_at = now()`
+
+	// Row can be changed only once by statement, so repeated chunks are saved as one blob.
+	blobIdx := make(map[blobKey]int, len(chunks))
+	blobs := make([][]interface{}, 0, len(chunks))
+	refCounts := make([]int, 0, len(chunks))
+	fileChunks := make([][]interface{}, len(chunks))
+	for i, chunk := range chunks {
+		encoding, chunk, err := codec.encode(chunk)
+		if err != nil {
+			return fmt.Errorf("codec.encode: %w", err)
+		}
+
+		chunk, err = cc.seal(seq+i, chunk)
+		if err != nil {
+			return fmt.Errorf("cc.seal: %w", err)
+		}
+
+		hash := sha256.Sum256(chunk)
+		fileChunks[i] = []interface{}{fileID, seq + i, hash[:], encoding}
+
+		key := blobKey{hash: string(hash[:]), encoding: encoding}
+		idx, ok := blobIdx[key]
+		if ok {
+			refCounts[idx]++
+			continue
+		}
+
+		blobIdx[key] = len(blobs)
+		refCounts = append(refCounts, 1)
+		blobs = append(blobs, []interface{}{hash[:], pgtype.Bytea{
+			Bytes:  chunk,
+			Status: pgtype.Present,
+		}, encoding, nil})
+	}
+
+	for i := range blobs {
+		blobs[i][3] = refCounts[i]
+	}
+
+	err := insertRows(ctx, tx, "blobs (hash, bytes, encoding, ref_count)", blobs, querySaveBlobs)
+	if err != nil {
+		return fmt.Errorf("insertRows: %w", err)
+	}
+
+	err = insertRows(ctx, tx, "file_chunks (file_id, seq, hash, encoding)", fileChunks, "")
+	if err != nil {
+		return fmt.Errorf("insertRows: %w", err)
+	}
+
+	return nil
+}
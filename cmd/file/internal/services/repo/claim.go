@@ -0,0 +1,74 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// Claim for implements app.Repo.
+func (r *Repo) Claim(ctx context.Context, fileID uuid.UUID, ref string) error {
+	return r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `insert into file_claims (file_id, ref) values ($1, $2) on conflict do nothing`
+
+		_, err := db.ExecContext(ctx, query, fileID, ref)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", convertErr(err))
+		}
+
+		return nil
+	})
+}
+
+// Release for implements app.Repo.
+func (r *Repo) Release(ctx context.Context, fileID uuid.UUID, ref string) error {
+	return r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `delete from file_claims where file_id = $1 and ref = $2`
+
+		result, err := db.ExecContext(ctx, query, fileID, ref)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", convertErr(err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return app.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// Orphans for implements app.Repo.
+func (r *Repo) Orphans(ctx context.Context, createdBefore time.Time, limit int) (ids []uuid.UUID, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `
+		select id from files
+		where created_at < $1
+		and not exists (select 1 from file_claims where file_claims.file_id = files.id)
+		and not exists (select 1 from file_variants where file_variants.file_id = files.id)
+		order by created_at
+		limit $2`
+
+		err := db.SelectContext(ctx, &ids, query, createdBefore, limit)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", convertErr(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,133 @@
+package repo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Chunk encodings.
+const (
+	encodingIdentity = ""
+	encodingGzip     = "gzip"
+	encodingZstd     = "zstd"
+)
+
+// Encoder and decoder without options are built without errors,
+// both are safe for concurrent use.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// chunkCodec compresses chunks of file by encoding chosen by content type.
+// Chunk is stored compressed only if it is compressed at least to maxRatio of its size.
+type chunkCodec struct {
+	encoding string
+	maxRatio float64
+}
+
+func newChunkCodec(contentType string, maxRatio float64) chunkCodec {
+	if maxRatio <= 0 {
+		return chunkCodec{encoding: encodingIdentity}
+	}
+
+	return chunkCodec{encoding: contentEncoding(contentType), maxRatio: maxRatio}
+}
+
+// contentEncoding returns encoding for content type.
+// Types read by browsers are gzipped, so they can be served without decoding,
+// compressed formats are stored as is, other types are compressed by zstd.
+func contentEncoding(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return encodingZstd
+	}
+
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		mediaType == "application/json",
+		mediaType == "application/javascript",
+		mediaType == "application/xml",
+		mediaType == "image/svg+xml":
+		return encodingGzip
+	case strings.HasPrefix(mediaType, "image/"),
+		strings.HasPrefix(mediaType, "video/"),
+		strings.HasPrefix(mediaType, "audio/"),
+		strings.HasPrefix(mediaType, "font/"),
+		mediaType == "application/zip",
+		mediaType == "application/x-gzip",
+		mediaType == "application/gzip",
+		mediaType == "application/x-rar-compressed",
+		mediaType == "application/pdf",
+		mediaType == "application/wasm":
+		return encodingIdentity
+	default:
+		return encodingZstd
+	}
+}
+
+// encode returns chunk encoding and encoded chunk.
+func (c chunkCodec) encode(chunk []byte) (string, []byte, error) {
+	var res []byte
+	switch c.encoding {
+	case encodingGzip:
+		buf := &bytes.Buffer{}
+		w := gzip.NewWriter(buf)
+		_, err := w.Write(chunk)
+		if err != nil {
+			return "", nil, fmt.Errorf("w.Write: %w", err)
+		}
+
+		err = w.Close()
+		if err != nil {
+			return "", nil, fmt.Errorf("w.Close: %w", err)
+		}
+
+		res = buf.Bytes()
+	case encodingZstd:
+		res = zstdEncoder.EncodeAll(chunk, nil)
+	default:
+		return encodingIdentity, chunk, nil
+	}
+
+	if float64(len(res)) > float64(len(chunk))*c.maxRatio {
+		return encodingIdentity, chunk, nil
+	}
+
+	return c.encoding, res, nil
+}
+
+// decode returns decoded chunk.
+func decode(encoding string, chunk []byte) ([]byte, error) {
+	switch encoding {
+	case encodingIdentity:
+		return chunk, nil
+	case encodingGzip:
+		r, err := gzip.NewReader(bytes.NewReader(chunk))
+		if err != nil {
+			return nil, fmt.Errorf("gzip.NewReader: %w", err)
+		}
+
+		res, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("io.ReadAll: %w", err)
+		}
+
+		return res, nil
+	case encodingZstd:
+		res, err := zstdDecoder.DecodeAll(chunk, nil)
+		if err != nil {
+			return nil, fmt.Errorf("zstdDecoder.DecodeAll: %w", err)
+		}
+
+		return res, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEncoding, encoding)
+	}
+}
@@ -0,0 +1,90 @@
+package repo
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+)
+
+// dataKeySize size of file data key, AES-256.
+const dataKeySize = 32
+
+// chunkCipher encrypts file chunks by AES-GCM with file data key.
+// Each chunk is sealed separately, so file can be read from any position.
+// Nil chunkCipher keeps chunks in plaintext.
+type chunkCipher struct {
+	aead   cipher.AEAD
+	fileID uuid.UUID
+}
+
+func newDataKey() ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("rand.Read: %w", err)
+	}
+
+	return dataKey, nil
+}
+
+func newChunkCipher(dataKey []byte, fileID uuid.UUID) (*chunkCipher, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("aes.NewCipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("cipher.NewGCM: %w", err)
+	}
+
+	return &chunkCipher{aead: aead, fileID: fileID}, nil
+}
+
+// seal returns nonce with encrypted chunk.
+func (c *chunkCipher) seal(seq int, chunk []byte) ([]byte, error) {
+	if c == nil {
+		return chunk, nil
+	}
+
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(chunk)+c.aead.Overhead())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, fmt.Errorf("rand.Read: %w", err)
+	}
+
+	return c.aead.Seal(nonce, nonce, chunk, c.additionalData(seq)), nil
+}
+
+// open returns decrypted chunk.
+func (c *chunkCipher) open(seq int, sealed []byte) ([]byte, error) {
+	if c == nil {
+		return sealed, nil
+	}
+
+	if len(sealed) < c.aead.NonceSize()+c.aead.Overhead() {
+		return nil, fmt.Errorf("%w: %d", ErrNotValidChunk, seq)
+	}
+
+	nonce, sealed := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	chunk, err := c.aead.Open(nil, nonce, sealed, c.additionalData(seq))
+	if err != nil {
+		return nil, fmt.Errorf("c.aead.Open: %w", err)
+	}
+
+	return chunk, nil
+}
+
+// additionalData binds chunk to file and chunk position,
+// so chunks can't be swapped between files or inside file.
+func (c *chunkCipher) additionalData(seq int) []byte {
+	data := make([]byte, len(c.fileID)+8)
+	copy(data, c.fileID.Bytes())
+	binary.BigEndian.PutUint64(data[len(c.fileID):], uint64(seq))
+
+	return data
+}
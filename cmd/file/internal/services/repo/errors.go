@@ -6,4 +6,8 @@ import "errors"
 var (
 	ErrNegativePosition = errors.New("wrong seek position <0")
 	ErrUnexpectedWhence = errors.New("unexpected whence")
+	ErrMissingChunk     = errors.New("missing file chunk")
+	ErrNotValidChunk    = errors.New("not valid encrypted chunk")
+	ErrUnknownEncoding  = errors.New("unknown chunk encoding")
+	ErrNoWrapper        = errors.New("file is encrypted, but encryption isn't configured")
 )
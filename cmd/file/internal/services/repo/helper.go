@@ -1,12 +1,21 @@
 package repo
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
 
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
 )
 
+// maxBatchSize max size of content written by one insert statement.
+const maxBatchSize = 1 << 20
+
 func convertErr(err error) error {
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
@@ -15,3 +24,76 @@ func convertErr(err error) error {
 		return err
 	}
 }
+
+// readChunks splits content into chunks of chunkSize, only last chunk can be smaller,
+// and calls fn with batches of chunks up to maxBatchSize. Seq is number of first chunk
+// in batch starting at 1. Returns content size.
+func readChunks(r io.Reader, chunkSize int, fn func(seq int, chunks [][]byte) error) (size int64, err error) {
+	batchLen := maxBatchSize / chunkSize
+	if batchLen < 1 {
+		batchLen = 1
+	}
+
+	buf := make([]byte, chunkSize*batchLen)
+	batch := make([][]byte, 0, batchLen)
+	for seq := 1; ; {
+		chunk := buf[len(batch)*chunkSize : (len(batch)+1)*chunkSize]
+		n, err := io.ReadFull(r, chunk)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, fmt.Errorf("io.ReadFull: %w", convertErr(err))
+		}
+
+		if n > 0 {
+			batch = append(batch, chunk[:n])
+			size += int64(n)
+		}
+
+		last := n < chunkSize
+		if len(batch) > 0 && (len(batch) == batchLen || last) {
+			err = fn(seq, batch)
+			if err != nil {
+				return 0, err
+			}
+
+			seq += len(batch)
+			batch = batch[:0]
+		}
+
+		if last {
+			return size, nil
+		}
+	}
+}
+
+// insertRows inserts all rows by one multi-row insert statement.
+// Into contains table name with columns, suffix is appended to statement.
+func insertRows(ctx context.Context, tx *sqlx.Tx, into string, rows [][]interface{}, suffix string) error {
+	query := strings.Builder{}
+	query.WriteString("insert into " + into + " values ")
+
+	args := make([]interface{}, 0, len(rows)*len(rows[0]))
+	for i, row := range rows {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+
+		query.WriteString("(")
+		for j := range row {
+			if j > 0 {
+				query.WriteString(", ")
+			}
+
+			args = append(args, row[j])
+			fmt.Fprintf(&query, "$%d", len(args))
+		}
+		query.WriteString(")")
+	}
+	query.WriteString(suffix)
+
+	_, err := tx.ExecContext(ctx, query.String(), args...)
+	if err != nil {
+		return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+	}
+
+	return nil
+}
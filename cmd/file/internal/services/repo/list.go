@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// List for implements app.Repo.
+func (r *Repo) List(ctx context.Context, owner app.Principal, params app.ListParams, after *app.Cursor) (files []app.File, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		query, args := listQuery(owner, params, after)
+
+		res := make([]fileInfo, 0, params.Limit)
+		err := db.SelectContext(ctx, &res, query, args...)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", convertErr(err))
+		}
+
+		files = make([]app.File, len(res))
+		for i := range res {
+			files[i] = *res[i].convert()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// likeEscaper escapes wildcards of like pattern, so they match themselves.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+var sortColumns = map[app.SortField]string{
+	app.SortByCreatedAt: "created_at",
+	app.SortBySize:      "size",
+}
+
+// listQuery builds query with filters and keyset pagination by sort field and id.
+func listQuery(owner app.Principal, params app.ListParams, after *app.Cursor) (string, []interface{}) {
+	var (
+		where = []string{`not exists (select 1 from file_variants where file_variants.file_id = files.id)`}
+		args  []interface{}
+	)
+
+	arg := func(v interface{}) string {
+		args = append(args, v)
+
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if owner != app.AnyOwner {
+		where = append(where, "owner = "+arg(owner))
+	}
+
+	if params.MinSize > 0 {
+		where = append(where, "size >= "+arg(params.MinSize))
+	}
+	if params.MaxSize > 0 {
+		where = append(where, "size <= "+arg(params.MaxSize))
+	}
+	if !params.CreatedAfter.IsZero() {
+		where = append(where, "created_at >= "+arg(params.CreatedAfter))
+	}
+	if !params.CreatedBefore.IsZero() {
+		where = append(where, "created_at < "+arg(params.CreatedBefore))
+	}
+
+	switch {
+	case strings.HasSuffix(params.ContentType, "/*"):
+		where = append(where, "content_type like "+arg(likeEscaper.Replace(strings.TrimSuffix(params.ContentType, "*"))+"%"))
+	case params.ContentType != "":
+		// Stored content type may contain params, e.g. "text/plain; charset=utf-8".
+		where = append(where, fmt.Sprintf("(content_type = %s or content_type like %s)",
+			arg(params.ContentType), arg(likeEscaper.Replace(params.ContentType)+";%")))
+	}
+
+	if len(params.Metadata) > 0 {
+		where = append(where, "metadata @> "+arg(pgtype.JSONB{Bytes: params.Metadata, Status: pgtype.Present}))
+	}
+
+	sortField, order, cmp := sortColumns[params.SortBy], "asc", ">"
+	if params.Desc {
+		order, cmp = "desc", "<"
+	}
+
+	if after != nil {
+		var value interface{} = after.CreatedAt
+		if params.SortBy == app.SortBySize {
+			value = after.Size
+		}
+
+		where = append(where, fmt.Sprintf("(%s, id) %s (%s, %s)", sortField, cmp, arg(value), arg(after.ID)))
+	}
+
+	query := fmt.Sprintf(`select * from files where %s order by %s %s, id %s limit %s`,
+		strings.Join(where, " and "), sortField, order, order, arg(params.Limit))
+
+	return query, args
+}
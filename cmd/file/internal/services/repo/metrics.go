@@ -0,0 +1,51 @@
+package repo
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics contains metrics for reading file chunks.
+type Metrics struct {
+	fetchDuration prometheus.Histogram
+	fetchErrTotal prometheus.Counter
+	fetchedTotal  prometheus.Counter
+}
+
+// NewMetrics registers and returns chunk store metrics.
+func NewMetrics(reg *prometheus.Registry, namespace string) *Metrics {
+	metric := newMetrics(namespace)
+	reg.MustRegister(metric.fetchDuration, metric.fetchErrTotal, metric.fetchedTotal)
+
+	return metric
+}
+
+func newMetrics(namespace string) *Metrics {
+	const subsystem = "chunk_store"
+
+	return &Metrics{
+		fetchDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "fetch_duration_seconds",
+				Help:      "Latency of fetching batch of file chunks.",
+			},
+		),
+		fetchErrTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "fetch_errors_total",
+				Help:      "Amount of failed fetches of file chunks.",
+			},
+		),
+		fetchedTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "fetched_chunks_total",
+				Help:      "Amount of fetched file chunks.",
+			},
+		),
+	}
+}
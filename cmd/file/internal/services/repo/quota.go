@@ -0,0 +1,149 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// accountColumn selects principal charged for file,
+// files uploaded before quotas were added are charged to owner.
+const accountColumn = `case when account = '' then owner else account end as account`
+
+type (
+	quotaLimits struct {
+		maxBytes int64
+		maxFiles int64
+	}
+
+	// Limits are null until owner quota is set, so default limits are applied.
+	quotaInfo struct {
+		Owner     string        `db:"owner"`
+		MaxBytes  sql.NullInt64 `db:"max_bytes"`
+		MaxFiles  sql.NullInt64 `db:"max_files"`
+		UsedBytes int64         `db:"used_bytes"`
+		UsedFiles int64         `db:"used_files"`
+	}
+)
+
+func (q *quotaInfo) convert(defaults quotaLimits) *app.Quota {
+	res := &app.Quota{
+		Owner:     app.Principal(q.Owner),
+		MaxBytes:  defaults.maxBytes,
+		MaxFiles:  defaults.maxFiles,
+		UsedBytes: q.UsedBytes,
+		UsedFiles: q.UsedFiles,
+	}
+
+	if q.MaxBytes.Valid {
+		res.MaxBytes = q.MaxBytes.Int64
+	}
+
+	if q.MaxFiles.Valid {
+		res.MaxFiles = q.MaxFiles.Int64
+	}
+
+	return res
+}
+
+// Quota for implements app.Repo.
+func (r *Repo) Quota(ctx context.Context, owner app.Principal) (res *app.Quota, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `select owner, max_bytes, max_files, used_bytes, used_files from quotas where owner = $1`
+
+		info := &quotaInfo{Owner: string(owner)}
+		err := db.GetContext(ctx, info, query, owner)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("db.GetContext: %w", convertErr(err))
+		}
+
+		res = info.convert(r.defaultQuota)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetQuota for implements app.Repo.
+func (r *Repo) SetQuota(ctx context.Context, owner app.Principal, maxBytes, maxFiles int64) (res *app.Quota, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `
+		insert into quotas (owner, max_bytes, max_files) values ($1, $2, $3)
+		on conflict (owner) do update set max_bytes = excluded.max_bytes, max_files = excluded.max_files, updated_at = now()
+		returning owner, max_bytes, max_files, used_bytes, used_files`
+
+		info := &quotaInfo{}
+		err := db.GetContext(ctx, info, query, owner, maxBytes, maxFiles)
+		if err != nil {
+			return fmt.Errorf("db.GetContext: %w", convertErr(err))
+		}
+
+		res = info.convert(r.defaultQuota)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// charge adds bytes and files to usage of account quota.
+// Returns app.ErrQuotaExceeded if usage becomes greater than limits.
+func (r *Repo) charge(ctx context.Context, tx *sqlx.Tx, account app.Principal, bytes, files int64) error {
+	const (
+		queryCreate = `insert into quotas (owner) values ($1) on conflict (owner) do nothing`
+		query       = `
+		update quotas
+		set used_bytes = used_bytes + $2, used_files = used_files + $3, updated_at = now()
+		where owner = $1
+		and (coalesce(max_bytes, $4) = 0 or used_bytes + $2 <= coalesce(max_bytes, $4))
+		and (coalesce(max_files, $5) = 0 or used_files + $3 <= coalesce(max_files, $5))`
+	)
+
+	_, err := tx.ExecContext(ctx, queryCreate, account)
+	if err != nil {
+		return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+	}
+
+	result, err := tx.ExecContext(ctx, query, account, bytes, files, r.defaultQuota.maxBytes, r.defaultQuota.maxFiles)
+	if err != nil {
+		return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("result.RowsAffected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return app.ErrQuotaExceeded
+	}
+
+	return nil
+}
+
+// refund removes bytes and files from usage of account quota.
+func refund(ctx context.Context, tx *sqlx.Tx, account app.Principal, bytes, files int64) error {
+	const query = `
+	update quotas
+	set used_bytes = used_bytes - $2, used_files = used_files - $3, updated_at = now()
+	where owner = $1`
+
+	_, err := tx.ExecContext(ctx, query, account, bytes, files)
+	if err != nil {
+		return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+	}
+
+	return nil
+}
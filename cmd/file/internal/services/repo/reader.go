@@ -1,9 +1,12 @@
 package repo
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/jackc/pgtype"
 	"github.com/jmoiron/sqlx"
@@ -11,17 +14,42 @@ import (
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
 )
 
-var _ io.ReadSeekCloser = &file{}
+var (
+	_ io.ReadSeekCloser = &file{}
+	_ app.EncodedReader = &file{}
+)
 
+// file reads chunks by windows of several chunks and prefetches
+// next window while current one is read.
 type file struct {
-	db          *sqlx.DB
-	chunks      pgtype.UUIDArray
-	isClosed    bool
-	size        int64
-	position    int64
-	chunkCached int64
-	chunkCache  []byte
-	error       error
+	ctx       context.Context
+	cancel    context.CancelFunc
+	db        *sqlx.DB
+	metric    *Metrics
+	cipher    *chunkCipher
+	chunks    [][]byte
+	encodings []string
+	isClosed  bool
+	size      int64
+	chunkSize int64
+	window    int
+	position  int64
+	cached    int
+	cache     [][]byte
+	prefetch  *prefetch
+	error     error
+}
+
+// prefetch contains window fetched in background.
+type prefetch struct {
+	window int
+	cancel context.CancelFunc
+	res    chan fetchResult
+}
+
+type fetchResult struct {
+	chunks [][]byte
+	err    error
 }
 
 // Read for implemented io.Reader.
@@ -37,22 +65,23 @@ func (f *file) Read(dst []byte) (n int, err error) {
 		return 0, ErrNegativePosition
 	}
 
-	stoppedAtChunk := int(f.position / app.MaxChunkSize)
-	stoppedAtIndex := int(f.position % app.MaxChunkSize)
+	stoppedAtChunk := int(f.position / f.chunkSize)
+	stoppedAtIndex := int(f.position % f.chunkSize)
 
-	chunk := &chunk{}
-	if stoppedAtChunk != int(f.chunkCached) {
-		const query = `select * from chunks where id = $1;`
-		err = f.db.Get(chunk, query, f.chunks.Elements[stoppedAtChunk])
+	window := stoppedAtChunk / f.window
+	if window != f.cached {
+		f.cache, err = f.windowChunks(window)
 		if err != nil {
-			return 0, f.lastErr(fmt.Errorf("f.db.Get: %w", convertErr(err)))
+			return 0, f.lastErr(err)
 		}
 
-		f.chunkCached = int64(stoppedAtChunk)
-		f.chunkCache = chunk.Bytes.Bytes
+		f.cached = window
+		if (window+1)*f.window < len(f.chunks) {
+			f.startPrefetch(window + 1)
+		}
 	}
 
-	n = copy(dst, f.chunkCache[stoppedAtIndex:])
+	n = copy(dst, f.cache[stoppedAtChunk-window*f.window][stoppedAtIndex:])
 	f.position += int64(n)
 	if f.position >= f.size {
 		return n, f.lastErr(io.EOF)
@@ -61,6 +90,103 @@ func (f *file) Read(dst []byte) (n int, err error) {
 	return n, nil
 }
 
+// windowChunks returns chunks of window, prefetched if possible.
+func (f *file) windowChunks(window int) ([][]byte, error) {
+	p := f.prefetch
+	f.prefetch = nil
+	if p != nil && p.window == window {
+		res := <-p.res
+		p.cancel()
+
+		return res.chunks, res.err
+	}
+
+	// Prefetch is useless after seek, its goroutine doesn't block on buffered channel.
+	if p != nil {
+		p.cancel()
+	}
+
+	return f.fetch(f.ctx, window, true)
+}
+
+func (f *file) startPrefetch(window int) {
+	ctx, cancel := context.WithCancel(f.ctx)
+	p := &prefetch{
+		window: window,
+		cancel: cancel,
+		res:    make(chan fetchResult, 1),
+	}
+
+	go func() {
+		chunks, err := f.fetch(ctx, window, true)
+		p.res <- fetchResult{chunks: chunks, err: err}
+	}()
+
+	f.prefetch = p
+}
+
+// fetch returns chunks of window by one query, decoded or in stored encoding.
+func (f *file) fetch(ctx context.Context, window int, decoded bool) (_ [][]byte, err error) {
+	start := time.Now()
+	defer func() {
+		f.metric.fetchDuration.Observe(time.Since(start).Seconds())
+		if err != nil {
+			f.metric.fetchErrTotal.Inc()
+		}
+	}()
+
+	first := window * f.window
+	hashes := f.chunks[first:]
+	if len(hashes) > f.window {
+		hashes = hashes[:f.window]
+	}
+
+	arg := pgtype.ByteaArray{}
+	err = arg.Set(hashes)
+	if err != nil {
+		return nil, fmt.Errorf("arg.Set: %w", err)
+	}
+
+	const query = `select hash, encoding, bytes from blobs where hash = any($1)`
+
+	var blobs []blob
+	err = f.db.SelectContext(ctx, &blobs, query, arg)
+	if err != nil {
+		return nil, fmt.Errorf("f.db.SelectContext: %w", convertErr(err))
+	}
+
+	// Repeated chunks are returned once, blobs with equal hash can differ by encoding.
+	byKey := make(map[blobKey][]byte, len(blobs))
+	for _, b := range blobs {
+		byKey[blobKey{hash: string(b.Hash), encoding: b.Encoding}] = b.Bytes.Bytes
+	}
+
+	chunks := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		sealed, ok := byKey[blobKey{hash: string(hash), encoding: f.encodings[first+i]}]
+		if !ok {
+			return nil, fmt.Errorf("%w: %x", ErrMissingChunk, hash)
+		}
+
+		chunks[i], err = f.cipher.open(first+i+1, sealed)
+		if err != nil {
+			return nil, fmt.Errorf("f.cipher.open: %w", err)
+		}
+
+		if !decoded {
+			continue
+		}
+
+		chunks[i], err = decode(f.encodings[first+i], chunks[i])
+		if err != nil {
+			return nil, fmt.Errorf("decode: %w", err)
+		}
+	}
+	f.metric.fetchedTotal.Add(float64(len(chunks)))
+
+	return chunks, nil
+}
+
 // Seek for implemented io.Seeker.
 func (f *file) Seek(offset int64, whence int) (int64, error) {
 	switch {
@@ -91,6 +217,23 @@ func (f *file) Seek(offset int64, whence int) (int64, error) {
 	return f.position, nil
 }
 
+// Encoded for implemented app.EncodedReader.
+// Content is read in encoding only if all chunks are stored in it,
+// gzip members of chunks are concatenated into one gzip stream.
+func (f *file) Encoded(encoding string) (io.Reader, bool) {
+	if encoding != encodingGzip || len(f.encodings) == 0 {
+		return nil, false
+	}
+
+	for _, e := range f.encodings {
+		if e != encoding {
+			return nil, false
+		}
+	}
+
+	return &encodedReader{file: f}, true
+}
+
 // Close for implemented io.Closer.
 func (f *file) Close() error {
 	if f.isClosed {
@@ -98,6 +241,7 @@ func (f *file) Close() error {
 	}
 
 	f.isClosed = true
+	f.cancel()
 
 	return nil
 }
@@ -107,3 +251,82 @@ func (f *file) lastErr(err error) error {
 
 	return f.error
 }
+
+// encodedReader reads file chunks in stored encoding window by window.
+type encodedReader struct {
+	file   *file
+	window int
+	cache  [][]byte
+}
+
+// Read for implemented io.Reader.
+func (r *encodedReader) Read(dst []byte) (n int, err error) {
+	switch {
+	case r.file.isClosed:
+		return 0, os.ErrClosed
+	case len(r.cache) == 0 && r.window*r.file.window >= len(r.file.chunks):
+		return 0, io.EOF
+	case len(r.cache) == 0:
+		r.cache, err = r.file.fetch(r.file.ctx, r.window, false)
+		if err != nil {
+			return 0, err
+		}
+		r.window++
+	}
+
+	n = copy(dst, r.cache[0])
+	r.cache[0] = r.cache[0][n:]
+	if len(r.cache[0]) == 0 {
+		r.cache = r.cache[1:]
+	}
+
+	return n, nil
+}
+
+var _ io.ReadCloser = &partsReader{}
+
+// partsReader reads content of upload parts row by row.
+type partsReader struct {
+	rows     *sql.Rows
+	cache    []byte
+	isClosed bool
+}
+
+// Read for implemented io.Reader.
+func (p *partsReader) Read(dst []byte) (int, error) {
+	if p.isClosed {
+		return 0, os.ErrClosed
+	}
+
+	for len(p.cache) == 0 {
+		if !p.rows.Next() {
+			err := p.rows.Err()
+			if err != nil {
+				return 0, fmt.Errorf("p.rows.Err: %w", err)
+			}
+
+			return 0, io.EOF
+		}
+
+		err := p.rows.Scan(&p.cache)
+		if err != nil {
+			return 0, fmt.Errorf("p.rows.Scan: %w", err)
+		}
+	}
+
+	n := copy(dst, p.cache)
+	p.cache = p.cache[n:]
+
+	return n, nil
+}
+
+// Close for implemented io.Closer.
+func (p *partsReader) Close() error {
+	if p.isClosed {
+		return os.ErrClosed
+	}
+
+	p.isClosed = true
+
+	return p.rows.Close()
+}
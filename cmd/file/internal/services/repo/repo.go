@@ -1,13 +1,13 @@
-// Package repo contains implements for app.Repo.
-// Provide file chunk to and from repository.
+// Package repo contains implements for app.Repo and app.BlobStore.
+// Provide file info and file chunks to and from repository.
 package repo
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgtype"
@@ -22,100 +22,101 @@ var _ app.Repo = &Repo{}
 type (
 	// Repo provided data from and to database.
 	Repo struct {
-		db *db.DB
+		db           *db.DB
+		defaultQuota quotaLimits
 	}
 
+	// Option for building Repo.
+	Option func(*Repo)
+
 	fileInfo struct {
-		ID        pgtype.UUID      `db:"id"`
-		Size      int64            `db:"size"`
-		Metadata  pgtype.JSONB     `db:"metadata"`
-		ChunkIDs  pgtype.UUIDArray `db:"chunk_ids"`
-		CreatedAt pgtype.Timestamp `db:"created_at"`
-		UpdatedAt pgtype.Timestamp `db:"updated_at"`
+		ID          pgtype.UUID      `db:"id"`
+		Size        int64            `db:"size"`
+		Digest      string           `db:"digest"`
+		ContentType string           `db:"content_type"`
+		Owner       string           `db:"owner"`
+		Account     string           `db:"account"`
+		Visibility  string           `db:"visibility"`
+		Metadata    pgtype.JSONB     `db:"metadata"`
+		ChunkSize   int64            `db:"chunk_size"`
+		KeyID       string           `db:"key_id"`
+		DataKey     []byte           `db:"data_key"`
+		ScanStatus  string           `db:"scan_status"`
+		ScanThreat  string           `db:"scan_threat"`
+		ScannedAt   pgtype.Timestamp `db:"scanned_at"`
+		CreatedAt   pgtype.Timestamp `db:"created_at"`
+		UpdatedAt   pgtype.Timestamp `db:"updated_at"`
 	}
+)
 
-	chunk struct {
-		ID        pgtype.UUID      `db:"id"`
-		FileID    pgtype.UUID      `db:"file_id"`
-		Bytes     pgtype.Bytea     `db:"bytes"`
-		CreatedAt pgtype.Timestamp `db:"created_at"`
-		UpdatedAt pgtype.Timestamp `db:"updated_at"`
+func (f *fileInfo) convert() *app.File {
+	// Files uploaded before quotas were added are charged to owner.
+	account := f.Account
+	if account == "" {
+		account = f.Owner
 	}
-)
 
-func (f *fileInfo) convert(r *file) *app.File {
 	return &app.File{
-		ReadSeekCloser: r,
+		ReadSeekCloser: nil,
 		ID:             f.ID.Bytes,
 		Size:           f.Size,
-		Metadata:       f.Metadata.Bytes,
+		Digest:         f.Digest,
+		ContentType:    f.ContentType,
+		Owner:          app.Principal(f.Owner),
+		Account:        app.Principal(account),
+		Access: app.Access{
+			Visibility: app.Visibility(f.Visibility),
+		},
+		Metadata: f.Metadata.Bytes,
+		Scan: app.Scan{
+			Status:    app.ScanStatus(f.ScanStatus),
+			Threat:    f.ScanThreat,
+			ScannedAt: f.ScannedAt.Time,
+		},
+		CreatedAt: f.CreatedAt.Time,
+		UpdatedAt: f.UpdatedAt.Time,
 	}
 }
 
 // New build and returns user db.
-func New(r *db.DB) *Repo {
-	return &Repo{
+func New(r *db.DB, options ...Option) *Repo {
+	repo := &Repo{
 		db: r,
 	}
+
+	for i := range options {
+		options[i](repo)
+	}
+
+	return repo
+}
+
+// DefaultQuota option for sets limits of owners without own quota, unlimited if 0.
+func DefaultQuota(maxBytes, maxFiles int64) Option {
+	return func(r *Repo) {
+		r.defaultQuota = quotaLimits{maxBytes: maxBytes, maxFiles: maxFiles}
+	}
 }
 
-// Save for implements app.Repo.
-func (r *Repo) Save(ctx context.Context, reader io.Reader) (res uuid.UUID, err error) {
-	err = r.db.Tx(ctx, nil, func(tx *sqlx.Tx) (err error) {
-		const querySaveFile = `
-			insert into files default values returning *
-		`
+// Create for implements app.Repo.
+func (r *Repo) Create(ctx context.Context, owner, account app.Principal, access app.Access) (id uuid.UUID, err error) {
+	err = r.db.Tx(ctx, nil, func(tx *sqlx.Tx) error {
+		const query = `insert into files (owner, account, visibility) values ($1, $2, $3) returning id`
 
-		file := &fileInfo{}
-		err = tx.QueryRowxContext(ctx, querySaveFile).StructScan(file)
+		err := tx.GetContext(ctx, &id, query, owner, account, access.Visibility)
 		if err != nil {
-			return fmt.Errorf("tx.QueryRowxContext: %w", convertErr(err))
-		}
-
-		const querySaveChunk = `insert into chunks (file_id, bytes) values ($1, $2) returning *`
-
-		buf := make([]byte, app.MaxChunkSize)
-		i := 0
-		for {
-			n, err := reader.Read(buf)
-			if err != nil && !errors.Is(err, io.EOF) {
-				return fmt.Errorf("reader.Read: %w", convertErr(err))
-			}
-
-			if n == 0 {
-				break
-			}
-
-			fileChunk := &chunk{}
-			err = tx.QueryRowxContext(ctx, querySaveChunk, file.ID, pgtype.Bytea{
-				Bytes:  buf[:n],
-				Status: pgtype.Present,
-			}).StructScan(fileChunk)
-			if err != nil {
-				return fmt.Errorf("tx.QueryRowxContext: %w", convertErr(err))
-			}
-
-			file.ChunkIDs.Elements = append(file.ChunkIDs.Elements, fileChunk.ID)
-			file.Size += int64(n)
-			i++
-		}
-
-		const queryUpdateSizeAndChunks = `
-			update files set size = $1, chunk_ids = $2 where id = $3;
-		`
-
-		file.ChunkIDs.Status = pgtype.Present
-		file.ChunkIDs.Dimensions = append(file.ChunkIDs.Dimensions,
-			pgtype.ArrayDimension{
-				Length:     int32(len(file.ChunkIDs.Elements)),
-				LowerBound: 1,
-			})
-		_, err = tx.ExecContext(ctx, queryUpdateSizeAndChunks, file.Size, file.ChunkIDs, file.ID)
+			return fmt.Errorf("tx.GetContext: %w", convertErr(err))
+		}
+
+		err = r.charge(ctx, tx, account, 0, 1)
 		if err != nil {
-			return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+			return fmt.Errorf("r.charge: %w", err)
 		}
 
-		res = file.ID.Bytes
+		err = saveShares(ctx, tx, id, access.SharedWith)
+		if err != nil {
+			return fmt.Errorf("saveShares: %w", err)
+		}
 
 		return nil
 	})
@@ -123,13 +124,40 @@ func (r *Repo) Save(ctx context.Context, reader io.Reader) (res uuid.UUID, err e
 		return uuid.Nil, err
 	}
 
-	return res, nil
+	return id, nil
+}
+
+// SetContent for implements app.Repo.
+func (r *Repo) SetContent(ctx context.Context, f *app.File) error {
+	return r.db.Tx(ctx, nil, func(tx *sqlx.Tx) error {
+		const query = `
+		update files
+		set size = $1, digest = $2, content_type = $3, scan_status = $4, updated_at = now()
+		where id = $5
+		returning ` + accountColumn
+
+		var account app.Principal
+		err := tx.GetContext(ctx, &account, query, f.Size, f.Digest, f.ContentType, f.Scan.Status, f.ID)
+		if err != nil {
+			return fmt.Errorf("tx.GetContext: %w", convertErr(err))
+		}
+
+		err = r.charge(ctx, tx, account, f.Size, 0)
+		if err != nil {
+			return fmt.Errorf("r.charge: %w", err)
+		}
+
+		return nil
+	})
 }
 
-// Read for implements app.Repo.
-func (r *Repo) Read(ctx context.Context, fileID uuid.UUID) (res *app.File, err error) {
+// ByID for implements app.Repo.
+func (r *Repo) ByID(ctx context.Context, fileID uuid.UUID) (res *app.File, err error) {
 	err = r.db.NoTx(func(db *sqlx.DB) error {
-		const query = `select * from files where id = $1;`
+		const (
+			query       = `select * from files where id = $1`
+			queryShares = `select principal from file_shares where file_id = $1 order by principal`
+		)
 		fInfo := &fileInfo{}
 
 		err := db.GetContext(ctx, fInfo, query, fileID)
@@ -137,18 +165,16 @@ func (r *Repo) Read(ctx context.Context, fileID uuid.UUID) (res *app.File, err e
 			return fmt.Errorf("db.GetContext: %w", convertErr(err))
 		}
 
-		f := &file{
-			db:          db,
-			chunks:      fInfo.ChunkIDs,
-			isClosed:    false,
-			size:        fInfo.Size,
-			position:    0,
-			chunkCached: -1,
-			chunkCache:  make([]byte, app.MaxChunkSize),
-			error:       nil,
+		res = fInfo.convert()
+
+		if res.Access.Visibility != app.VisibilityShared {
+			return nil
 		}
 
-		res = fInfo.convert(f)
+		err = db.SelectContext(ctx, &res.Access.SharedWith, queryShares, fileID)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", convertErr(err))
+		}
 
 		return nil
 	})
@@ -159,6 +185,42 @@ func (r *Repo) Read(ctx context.Context, fileID uuid.UUID) (res *app.File, err e
 	return res, nil
 }
 
+// SetAccess for implements app.Repo.
+func (r *Repo) SetAccess(ctx context.Context, fileID uuid.UUID, access app.Access) error {
+	return r.db.Tx(ctx, nil, func(tx *sqlx.Tx) error {
+		const (
+			query             = `update files set visibility = $1 where id = $2`
+			queryDeleteShares = `delete from file_shares where file_id = $1`
+		)
+
+		result, err := tx.ExecContext(ctx, query, access.Visibility, fileID)
+		if err != nil {
+			return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return app.ErrNotFound
+		}
+
+		_, err = tx.ExecContext(ctx, queryDeleteShares, fileID)
+		if err != nil {
+			return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+		}
+
+		err = saveShares(ctx, tx, fileID, access.SharedWith)
+		if err != nil {
+			return fmt.Errorf("saveShares: %w", err)
+		}
+
+		return nil
+	})
+}
+
 // SetMetadata for implements app.Repo.
 func (r *Repo) SetMetadata(ctx context.Context, fileID uuid.UUID, metadata json.RawMessage) error {
 	return r.db.NoTx(func(db *sqlx.DB) error {
@@ -189,17 +251,43 @@ func (r *Repo) SetMetadata(ctx context.Context, fileID uuid.UUID, metadata json.
 
 // Delete for implements app.Repo.
 func (r *Repo) Delete(ctx context.Context, fileID uuid.UUID) error {
-	return r.db.NoTx(func(db *sqlx.DB) error {
+	return r.db.Tx(ctx, nil, func(tx *sqlx.Tx) error {
 		const query = `
 		delete
 		from files
-		where id = $1`
+		where id = $1
+		returning ` + accountColumn + `, size`
 
-		_, err := db.ExecContext(ctx, query, fileID)
+		var deleted struct {
+			Account app.Principal `db:"account"`
+			Size    int64         `db:"size"`
+		}
+		err := tx.GetContext(ctx, &deleted, query, fileID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+		case err != nil:
+			return fmt.Errorf("tx.GetContext: %w", convertErr(err))
+		}
+
+		err = refund(ctx, tx, deleted.Account, deleted.Size, 1)
 		if err != nil {
-			return fmt.Errorf("db.ExecContext: %w", convertErr(err))
+			return fmt.Errorf("refund: %w", err)
 		}
 
 		return nil
 	})
 }
+
+func saveShares(ctx context.Context, tx *sqlx.Tx, fileID uuid.UUID, principals []app.Principal) error {
+	const query = `insert into file_shares (file_id, principal) values ($1, $2)`
+
+	for _, principal := range principals {
+		_, err := tx.ExecContext(ctx, query, fileID, principal)
+		if err != nil {
+			return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+		}
+	}
+
+	return nil
+}
@@ -1,19 +1,31 @@
 package repo_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"io"
 	"os"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 
 	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/envelope"
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/repo"
+	"github.com/Meat-Hook/back-template/libs/migrater"
 )
 
 func TestRepo_Smoke(t *testing.T) {
 	t.Parallel()
 
-	ctx, r, assert := start(t)
+	ctx, r, chunks, assert := start(t)
 
 	f, err := os.Open(testFile)
 	assert.NoError(err)
@@ -21,21 +33,34 @@ func TestRepo_Smoke(t *testing.T) {
 		assert.NoError(f.Close())
 	}()
 
-	fileID, err := r.Save(ctx, f)
+	fileID, err := r.Create(ctx, owner, owner, private)
 	assert.NoError(err)
 	assert.NotNil(fileID)
 
+	size, err := chunks.Put(ctx, fileID, contentType, f)
+	assert.NoError(err)
+
+	err = r.SetContent(ctx, &app.File{ID: fileID, Size: size, Digest: digest, ContentType: contentType})
+	assert.NoError(err)
+
 	_, err = f.Seek(0, io.SeekStart)
 	assert.NoError(err)
 
-	fFromDB, err := r.Read(ctx, fileID)
+	fFromDB, err := r.ByID(ctx, fileID)
 	assert.NoError(err, context.Canceled)
+	assert.Equal(size, fFromDB.Size)
+	assert.Equal(digest, fFromDB.Digest)
+	assert.Equal(contentType, fFromDB.ContentType)
+
+	content, err := chunks.Get(ctx, fileID)
+	assert.NoError(err)
 
 	bufOldFile, err := io.ReadAll(f)
 	assert.NoError(err)
 
-	bufNewFile, err := io.ReadAll(fFromDB)
+	bufNewFile, err := io.ReadAll(content)
 	assert.NoError(err)
+	assert.NoError(content.Close())
 
 	assert.Equal(bufOldFile, bufNewFile)
 
@@ -43,15 +68,858 @@ func TestRepo_Smoke(t *testing.T) {
 	err = r.SetMetadata(ctx, fFromDB.ID, fFromDB.Metadata)
 	assert.NoError(err)
 
-	updatedFileFromDB, err := r.Read(ctx, fFromDB.ID)
+	updatedFileFromDB, err := r.ByID(ctx, fFromDB.ID)
 	assert.NoError(err)
 
 	assert.Equal(fFromDB.Metadata, updatedFileFromDB.Metadata)
 
+	err = chunks.Delete(ctx, fFromDB.ID)
+	assert.NoError(err)
+
 	err = r.Delete(ctx, fFromDB.ID)
 	assert.NoError(err)
 
-	newF, err := r.Read(ctx, fFromDB.ID)
+	newF, err := r.ByID(ctx, fFromDB.ID)
 	assert.Nil(newF)
 	assert.ErrorIs(err, app.ErrNotFound)
+
+	err = r.SetContent(ctx, fFromDB)
+	assert.ErrorIs(err, app.ErrNotFound)
+}
+
+func TestChunkStore_Dedup(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, chunks, assert := start(t)
+
+	content := bytes.Repeat([]byte("a"), app.MaxChunkSize*3+10)
+
+	upload := func() uuid.UUID {
+		fileID, err := r.Create(ctx, owner, owner, private)
+		assert.NoError(err)
+		size, err := chunks.Put(ctx, fileID, contentType, bytes.NewReader(content))
+		assert.NoError(err)
+		assert.EqualValues(len(content), size)
+		err = r.SetContent(ctx, &app.File{ID: fileID, Size: size, Digest: digest})
+		assert.NoError(err)
+
+		return fileID
+	}
+	read := func(fileID uuid.UUID) []byte {
+		f, err := chunks.Get(ctx, fileID)
+		assert.NoError(err)
+		defer func() {
+			assert.NoError(f.Close())
+		}()
+		buf, err := io.ReadAll(f)
+		assert.NoError(err)
+
+		return buf
+	}
+
+	first, second := upload(), upload()
+	assert.Equal(content, read(first))
+	assert.Equal(content, read(second))
+
+	err := chunks.Delete(ctx, first)
+	assert.NoError(err)
+	err = r.Delete(ctx, first)
+	assert.NoError(err)
+
+	assert.Equal(content, read(second))
+
+	err = chunks.Delete(ctx, second)
+	assert.NoError(err)
+	err = r.Delete(ctx, second)
+	assert.NoError(err)
+}
+
+func TestChunkStore_ChunkSize(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, chunks, assert := start(t, repo.ChunkSize(10))
+
+	// Repeated chunks are written by one batch, last chunk is smaller.
+	content := append(bytes.Repeat([]byte("0123456789"), 1000), "tail"...)
+
+	for i := 0; i < 2; i++ {
+		fileID, err := r.Create(ctx, owner, owner, private)
+		assert.NoError(err)
+
+		size, err := chunks.Put(ctx, fileID, contentType, bytes.NewReader(content))
+		assert.NoError(err)
+		assert.EqualValues(len(content), size)
+
+		err = r.SetContent(ctx, &app.File{ID: fileID, Size: size, Digest: digest, ContentType: contentType})
+		assert.NoError(err)
+
+		res, err := chunks.Get(ctx, fileID)
+		assert.NoError(err)
+
+		_, err = res.Seek(9995, io.SeekStart)
+		assert.NoError(err)
+
+		buf, err := io.ReadAll(res)
+		assert.NoError(err)
+		assert.NoError(res.Close())
+		assert.Equal([]byte("56789tail"), buf)
+	}
+}
+
+func TestChunkStore_ReadAhead(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, chunks, assert := start(t, repo.ChunkSize(10), repo.ReadAhead(30))
+
+	content := make([]byte, 1005)
+	_, err := rand.Read(content)
+	assert.NoError(err)
+
+	fileID, err := r.Create(ctx, owner, owner, private)
+	assert.NoError(err)
+
+	size, err := chunks.Put(ctx, fileID, contentType, bytes.NewReader(content))
+	assert.NoError(err)
+
+	err = r.SetContent(ctx, &app.File{ID: fileID, Size: size, Digest: digest, ContentType: contentType})
+	assert.NoError(err)
+
+	res, err := chunks.Get(ctx, fileID)
+	assert.NoError(err)
+
+	buf := make([]byte, 45)
+	_, err = io.ReadFull(res, buf)
+	assert.NoError(err)
+	assert.Equal(content[:45], buf)
+
+	// Seek out of prefetched window.
+	_, err = res.Seek(500, io.SeekStart)
+	assert.NoError(err)
+
+	buf, err = io.ReadAll(res)
+	assert.NoError(err)
+	assert.Equal(content[500:], buf)
+	assert.NoError(res.Close())
+
+	readCtx, cancel := context.WithCancel(ctx)
+	res, err = chunks.Get(readCtx, fileID)
+	assert.NoError(err)
+
+	cancel()
+	_, err = res.Read(buf)
+	assert.ErrorIs(err, context.Canceled)
+	assert.NoError(res.Close())
+}
+
+func TestChunkStore_Encryption(t *testing.T) {
+	t.Parallel()
+
+	var (
+		oldKey = bytes.Repeat([]byte{1}, 32)
+		newKey = bytes.Repeat([]byte{2}, 32)
+	)
+
+	oldKeys, err := envelope.New("old", map[string][]byte{"old": oldKey})
+	require.NoError(t, err)
+	rotatedKeys, err := envelope.New("new", map[string][]byte{"old": oldKey, "new": newKey})
+	require.NoError(t, err)
+	newKeys, err := envelope.New("new", map[string][]byte{"new": newKey})
+	require.NoError(t, err)
+
+	ctx, conn, assert := startDB(t, migrateDir)
+	r := repo.New(conn)
+	chunks := repo.NewChunkStore(conn, repo.ChunkSize(10), repo.ReadAhead(30), repo.Encryption(oldKeys))
+
+	content := bytes.Repeat([]byte("0123456789"), 100)
+	fileID, err := r.Create(ctx, owner, owner, private)
+	assert.NoError(err)
+
+	size, err := chunks.Put(ctx, fileID, contentType, bytes.NewReader(content))
+	assert.NoError(err)
+
+	err = r.SetContent(ctx, &app.File{ID: fileID, Size: size, Digest: digest, ContentType: contentType})
+	assert.NoError(err)
+
+	read := func(store *repo.ChunkStore) ([]byte, error) {
+		res, err := store.Get(ctx, fileID)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Close()
+
+		_, err = res.Seek(95, io.SeekStart)
+		if err != nil {
+			return nil, err
+		}
+
+		return io.ReadAll(res)
+	}
+
+	buf, err := read(chunks)
+	assert.NoError(err)
+	assert.Equal(content[95:], buf)
+
+	_, err = read(repo.NewChunkStore(conn))
+	assert.ErrorIs(err, repo.ErrNoWrapper)
+
+	_, err = read(repo.NewChunkStore(conn, repo.Encryption(newKeys)))
+	assert.ErrorIs(err, envelope.ErrUnknownKey)
+
+	// Master key rotation.
+	rotated := repo.NewChunkStore(conn, repo.Encryption(rotatedKeys))
+	total, err := rotated.Rewrap(ctx, 1)
+	assert.NoError(err)
+	assert.EqualValues(1, total)
+
+	total, err = rotated.Rewrap(ctx, 1)
+	assert.NoError(err)
+	assert.EqualValues(0, total)
+
+	buf, err = read(repo.NewChunkStore(conn, repo.Encryption(newKeys)))
+	assert.NoError(err)
+	assert.Equal(content[95:], buf)
+}
+
+func TestChunkStore_Compression(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, chunks, assert := start(t, repo.ChunkSize(100), repo.ReadAhead(300), repo.Compression(0.9))
+
+	text := bytes.Repeat([]byte("compressible text "), 100)
+	random := make([]byte, 1000)
+	_, err := rand.Read(random)
+	assert.NoError(err)
+
+	testCases := []struct {
+		name        string
+		contentType string
+		content     []byte
+		gzipped     bool
+	}{
+		{"gzip", "text/plain; charset=utf-8", text, true},
+		{"zstd", "application/octet-stream", text, false},
+		{"identity", "image/png", text, false},
+		{"incompressible", "text/plain; charset=utf-8", random, false},
+		{"partly_compressible", "text/plain; charset=utf-8", append(append([]byte(nil), text...), random...), false},
+	}
+
+	for _, tc := range testCases {
+		fileID, err := r.Create(ctx, owner, owner, private)
+		assert.NoError(err)
+
+		size, err := chunks.Put(ctx, fileID, tc.contentType, bytes.NewReader(tc.content))
+		assert.NoError(err)
+		assert.EqualValues(len(tc.content), size)
+
+		err = r.SetContent(ctx, &app.File{ID: fileID, Size: size, Digest: digest, ContentType: tc.contentType})
+		assert.NoError(err)
+
+		res, err := chunks.Get(ctx, fileID)
+		assert.NoError(err)
+
+		_, err = res.Seek(150, io.SeekStart)
+		assert.NoError(err)
+
+		buf, err := io.ReadAll(res)
+		assert.NoError(err)
+		assert.Equal(tc.content[150:], buf, tc.name)
+
+		encoded, ok := res.(app.EncodedReader).Encoded("gzip")
+		assert.Equal(tc.gzipped, ok, tc.name)
+		if ok {
+			gz, err := gzip.NewReader(encoded)
+			assert.NoError(err)
+
+			buf, err = io.ReadAll(gz)
+			assert.NoError(err)
+			assert.Equal(tc.content, buf, tc.name)
+		}
+		assert.NoError(res.Close())
+	}
+}
+
+func TestChunkStore_EncodingCollision(t *testing.T) {
+	t.Parallel()
+
+	ctx, conn, assert := startDB(t, migrateDir)
+	r, chunks := repo.New(conn), repo.NewChunkStore(conn, repo.ChunkSize(100), repo.Compression(0.9))
+
+	upload := func(contentType string, content []byte) uuid.UUID {
+		fileID, err := r.Create(ctx, owner, owner, private)
+		assert.NoError(err)
+		size, err := chunks.Put(ctx, fileID, contentType, bytes.NewReader(content))
+		assert.NoError(err)
+		err = r.SetContent(ctx, &app.File{ID: fileID, Size: size, Digest: digest, ContentType: contentType})
+		assert.NoError(err)
+
+		return fileID
+	}
+	read := func(fileID uuid.UUID) []byte {
+		res, err := chunks.Get(ctx, fileID)
+		assert.NoError(err)
+		defer func() {
+			assert.NoError(res.Close())
+		}()
+		buf, err := io.ReadAll(res)
+		assert.NoError(err)
+
+		return buf
+	}
+
+	text := bytes.Repeat([]byte("a"), 100)
+	gzipped := upload("text/plain; charset=utf-8", text)
+
+	var stored []byte
+	err := conn.NoTx(func(db *sqlx.DB) error {
+		return db.GetContext(ctx, &stored, `select bytes from blobs where encoding = 'gzip'`)
+	})
+	assert.NoError(err)
+
+	// Identity chunk with the same bytes as gzip chunk of other file.
+	identity := upload("image/png", stored)
+
+	assert.Equal(text, read(gzipped))
+	assert.Equal(stored, read(identity))
+
+	var blobs int
+	err = conn.NoTx(func(db *sqlx.DB) error {
+		return db.GetContext(ctx, &blobs, `select count(*) from blobs`)
+	})
+	assert.NoError(err)
+	assert.Equal(2, blobs)
+
+	err = chunks.Delete(ctx, gzipped)
+	assert.NoError(err)
+	assert.Equal(stored, read(identity))
+}
+
+func BenchmarkChunkStore_Put(b *testing.B) {
+	content := make([]byte, 4<<20)
+	_, err := rand.Read(content)
+	require.NoError(b, err)
+
+	for _, chunkSize := range []int{4 << 10, 64 << 10, 1 << 20} {
+		chunkSize := chunkSize
+		b.Run(strconv.Itoa(chunkSize), func(b *testing.B) {
+			ctx, r, chunks, assert := start(b, repo.ChunkSize(chunkSize))
+
+			fileIDs := make([]uuid.UUID, b.N)
+			for i := range fileIDs {
+				fileIDs[i], err = r.Create(ctx, owner, owner, private)
+				assert.NoError(err)
+			}
+
+			b.SetBytes(int64(len(content)))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// Fresh content for every file, otherwise only ref counts are updated.
+				content[0], content[len(content)-1] = byte(i), byte(i>>8)
+				_, err = chunks.Put(ctx, fileIDs[i], contentType, bytes.NewReader(content))
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestRepo_UploadSession(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, _, assert := start(t)
+
+	policy := app.Policy{AllowedTypes: []string{"image/*"}, MaxSize: 1024, Account: "user:account"}
+	session, err := r.CreateSession(ctx, owner, 0, policy)
+	assert.NoError(err)
+	assert.Equal(policy, session.Policy)
+
+	first := bytes.Repeat([]byte("a"), app.MaxChunkSize+10)
+	second := []byte("second")
+
+	size, err := r.SavePart(ctx, session.ID, 2, bytes.NewReader(second))
+	assert.NoError(err)
+	assert.EqualValues(len(second), size)
+
+	size, err = r.SavePart(ctx, session.ID, 1, bytes.NewReader([]byte("replaced")))
+	assert.NoError(err)
+	assert.EqualValues(len("replaced"), size)
+
+	size, err = r.SavePart(ctx, session.ID, 1, bytes.NewReader(first))
+	assert.NoError(err)
+	assert.EqualValues(len(first), size)
+
+	res, err := r.Session(ctx, session.ID)
+	assert.NoError(err)
+	assert.Equal([]app.Part{{Number: 1, Size: int64(len(first))}, {Number: 2, Size: int64(len(second))}}, res.Parts)
+	assert.Equal(policy, res.Policy)
+
+	content, err := r.Content(ctx, session.ID)
+	assert.NoError(err)
+	buf, err := io.ReadAll(content)
+	assert.NoError(err)
+	assert.NoError(content.Close())
+	assert.Equal(append(first, second...), buf)
+
+	deleted, err := r.DeleteStale(ctx, res.CreatedAt)
+	assert.NoError(err)
+	assert.Zero(deleted)
+
+	err = r.DeleteSession(ctx, session.ID)
+	assert.NoError(err)
+
+	_, err = r.Session(ctx, session.ID)
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	_, err = r.SavePart(ctx, session.ID, 1, bytes.NewReader(second))
+	assert.ErrorIs(err, app.ErrNotFound)
+}
+
+func TestRepo_Variants(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, _, assert := start(t)
+
+	create := func() uuid.UUID {
+		fileID, err := r.Create(ctx, owner, owner, private)
+		assert.NoError(err)
+
+		return fileID
+	}
+
+	originalID, variantID := create(), create()
+
+	_, err := r.Variant(ctx, originalID, 256)
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	err = r.SaveVariant(ctx, originalID, 256, variantID)
+	assert.NoError(err)
+
+	err = r.SaveVariant(ctx, originalID, 256, create())
+	assert.ErrorIs(err, app.ErrVariantExist)
+
+	err = r.SaveVariant(ctx, uuid.Must(uuid.NewV4()), 256, create())
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	res, err := r.Variant(ctx, originalID, 256)
+	assert.NoError(err)
+	assert.Equal(variantID, res)
+
+	variants, err := r.Variants(ctx, originalID)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{variantID}, variants)
+
+	err = r.Delete(ctx, variantID)
+	assert.NoError(err)
+
+	variants, err = r.Variants(ctx, originalID)
+	assert.NoError(err)
+	assert.Empty(variants)
+}
+
+func TestRepo_List(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, _, assert := start(t)
+
+	// Metadata value is unique for isolation from other tests.
+	key := uuid.Must(uuid.NewV4()).String()
+	metadata := json.RawMessage(`{"test":"` + key + `"}`)
+
+	ids := make([]uuid.UUID, 3)
+	for i := range ids {
+		fileID, err := r.Create(ctx, owner, owner, private)
+		assert.NoError(err)
+
+		err = r.SetContent(ctx, &app.File{ID: fileID, Size: int64(3 - i), ContentType: contentType})
+		assert.NoError(err)
+
+		err = r.SetMetadata(ctx, fileID, metadata)
+		assert.NoError(err)
+
+		ids[i] = fileID
+	}
+
+	variantID, err := r.Create(ctx, owner, owner, private)
+	assert.NoError(err)
+	err = r.SetMetadata(ctx, variantID, metadata)
+	assert.NoError(err)
+	err = r.SaveVariant(ctx, ids[0], 64, variantID)
+	assert.NoError(err)
+
+	list := func(params app.ListParams, after *app.Cursor) []uuid.UUID {
+		params.Metadata = metadata
+		files, err := r.List(ctx, owner, params, after)
+		assert.NoError(err)
+
+		res := make([]uuid.UUID, len(files))
+		for i := range files {
+			res[i] = files[i].ID
+		}
+
+		return res
+	}
+
+	bySize := app.ListParams{Limit: 2, SortBy: app.SortBySize}
+	assert.Equal([]uuid.UUID{ids[2], ids[1]}, list(bySize, nil))
+	assert.Equal([]uuid.UUID{ids[0]}, list(bySize, &app.Cursor{ID: ids[1], Size: 2}))
+
+	bySizeDesc := app.ListParams{Limit: 5, SortBy: app.SortBySize, Desc: true}
+	assert.Equal(ids, list(bySizeDesc, nil))
+
+	filtered := app.ListParams{Limit: 5, SortBy: app.SortByCreatedAt, MinSize: 2, ContentType: "image/*"}
+	assert.ElementsMatch(ids[:2], list(filtered, nil))
+
+	filtered.ContentType = "text/plain"
+	assert.Empty(list(filtered, nil))
+
+	// Wildcards of like pattern match themselves.
+	filtered.ContentType = "i_a%/*"
+	assert.Empty(list(filtered, nil))
+
+	files, err := r.List(ctx, app.ServicePrincipal("other"), app.ListParams{Limit: 5, Metadata: metadata}, nil)
+	assert.NoError(err)
+	assert.Empty(files)
+
+	files, err = r.List(ctx, app.AnyOwner, app.ListParams{Limit: 5, Metadata: metadata}, nil)
+	assert.NoError(err)
+	assert.Len(files, len(ids))
+}
+
+func TestRepo_Access(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, _, assert := start(t)
+
+	shared := app.Access{
+		Visibility: app.VisibilityShared,
+		SharedWith: []app.Principal{app.ServicePrincipal("first"), app.ServicePrincipal("second")},
+	}
+
+	fileID, err := r.Create(ctx, owner, owner, shared)
+	assert.NoError(err)
+
+	file, err := r.ByID(ctx, fileID)
+	assert.NoError(err)
+	assert.Equal(owner, file.Owner)
+	assert.Equal(shared, file.Access)
+
+	public := app.Access{Visibility: app.VisibilityPublic}
+	err = r.SetAccess(ctx, fileID, public)
+	assert.NoError(err)
+
+	file, err = r.ByID(ctx, fileID)
+	assert.NoError(err)
+	assert.Equal(public, file.Access)
+
+	err = r.SetAccess(ctx, fileID, shared)
+	assert.NoError(err)
+
+	file, err = r.ByID(ctx, fileID)
+	assert.NoError(err)
+	assert.Equal(shared, file.Access)
+
+	err = r.SetAccess(ctx, uuid.Must(uuid.NewV4()), public)
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	session, err := r.CreateSession(ctx, owner, 0, app.Policy{})
+	assert.NoError(err)
+	assert.Equal(owner, session.Owner)
+}
+
+func TestRepo_Claims(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, _, assert := start(t)
+
+	create := func(owner app.Principal) uuid.UUID {
+		fileID, err := r.Create(ctx, owner, owner, private)
+		assert.NoError(err)
+
+		return fileID
+	}
+
+	user := app.UserPrincipal(uuid.Must(uuid.NewV4()))
+	fileID, originalID, variantID, userFileID := create(owner), create(owner), create(owner), create(user)
+
+	err := r.SaveVariant(ctx, originalID, 256, variantID)
+	assert.NoError(err)
+
+	orphans, err := r.Orphans(ctx, time.Now().Add(-time.Hour), 10)
+	assert.NoError(err)
+	assert.Empty(orphans)
+
+	// Derived files are never orphans.
+	orphans, err = r.Orphans(ctx, time.Now().Add(time.Hour), 10)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{fileID, originalID, userFileID}, orphans)
+
+	orphans, err = r.Orphans(ctx, time.Now().Add(time.Hour), 1)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{fileID}, orphans)
+
+	// User files are claimed by owner.
+	err = r.Claim(ctx, userFileID, string(user))
+	assert.NoError(err)
+
+	orphans, err = r.Orphans(ctx, time.Now().Add(time.Hour), 10)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{fileID, originalID}, orphans)
+
+	err = r.Claim(ctx, fileID, "ref")
+	assert.NoError(err)
+	err = r.Claim(ctx, fileID, "ref")
+	assert.NoError(err)
+	err = r.Claim(ctx, fileID, "other")
+	assert.NoError(err)
+
+	orphans, err = r.Orphans(ctx, time.Now().Add(time.Hour), 10)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{originalID}, orphans)
+
+	err = r.Release(ctx, fileID, "ref")
+	assert.NoError(err)
+	err = r.Release(ctx, fileID, "ref")
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	orphans, err = r.Orphans(ctx, time.Now().Add(time.Hour), 10)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{originalID}, orphans)
+
+	err = r.Release(ctx, fileID, "other")
+	assert.NoError(err)
+
+	orphans, err = r.Orphans(ctx, time.Now().Add(time.Hour), 10)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{fileID, originalID}, orphans)
+
+	// Claimed file is removed with claims.
+	err = r.Claim(ctx, fileID, "ref")
+	assert.NoError(err)
+	err = r.Delete(ctx, fileID)
+	assert.NoError(err)
+}
+
+func TestChunkStore_DeleteDangling(t *testing.T) {
+	t.Parallel()
+
+	ctx, conn, assert := startDB(t, migrateDir)
+	r, chunks := repo.New(conn), repo.NewChunkStore(conn)
+
+	content := []byte("content")
+	fileID, err := r.Create(ctx, owner, owner, private)
+	assert.NoError(err)
+	_, err = chunks.Put(ctx, fileID, contentType, bytes.NewReader(content))
+	assert.NoError(err)
+
+	// Blob left by interrupted removing of file.
+	err = conn.NoTx(func(db *sqlx.DB) error {
+		_, err := db.ExecContext(ctx, `insert into blobs (hash, bytes, ref_count) values ($1, $2, 1)`,
+			[]byte("dangling"), []byte("dangling"))
+		return err
+	})
+	assert.NoError(err)
+
+	total, err := chunks.DeleteDangling(ctx, 10, true)
+	assert.NoError(err)
+	assert.EqualValues(1, total)
+
+	total, err = chunks.DeleteDangling(ctx, 10, false)
+	assert.NoError(err)
+	assert.EqualValues(1, total)
+
+	total, err = chunks.DeleteDangling(ctx, 10, false)
+	assert.NoError(err)
+	assert.EqualValues(0, total)
+
+	err = r.SetContent(ctx, &app.File{ID: fileID, Size: int64(len(content)), Digest: digest, ContentType: contentType})
+	assert.NoError(err)
+
+	res, err := chunks.Get(ctx, fileID)
+	assert.NoError(err)
+
+	buf, err := io.ReadAll(res)
+	assert.NoError(err)
+	assert.Equal(content, buf)
+	assert.NoError(res.Close())
+}
+
+func TestRepo_Quota(t *testing.T) {
+	t.Parallel()
+
+	ctx, conn, assert := startDB(t, migrateDir)
+	r := repo.New(conn, repo.DefaultQuota(100, 2))
+
+	user := app.UserPrincipal(uuid.Must(uuid.NewV4()))
+
+	quota, err := r.Quota(ctx, user)
+	assert.NoError(err)
+	assert.Equal(&app.Quota{Owner: user, MaxBytes: 100, MaxFiles: 2}, quota)
+
+	fileID, err := r.Create(ctx, owner, user, private)
+	assert.NoError(err)
+
+	file, err := r.ByID(ctx, fileID)
+	assert.NoError(err)
+	assert.Equal(user, file.Account)
+
+	err = r.SetContent(ctx, &app.File{ID: fileID, Size: 60, Digest: digest, ContentType: contentType})
+	assert.NoError(err)
+
+	otherID, err := r.Create(ctx, owner, user, private)
+	assert.NoError(err)
+
+	_, err = r.Create(ctx, owner, user, private)
+	assert.ErrorIs(err, app.ErrQuotaExceeded)
+
+	err = r.SetContent(ctx, &app.File{ID: otherID, Size: 41, Digest: digest, ContentType: contentType})
+	assert.ErrorIs(err, app.ErrQuotaExceeded)
+
+	quota, err = r.Quota(ctx, user)
+	assert.NoError(err)
+	assert.Equal(&app.Quota{Owner: user, MaxBytes: 100, MaxFiles: 2, UsedBytes: 60, UsedFiles: 2}, quota)
+
+	// Owner isn't charged for files uploaded on behalf of other principal.
+	quota, err = r.Quota(ctx, owner)
+	assert.NoError(err)
+	assert.Equal(&app.Quota{Owner: owner, MaxBytes: 100, MaxFiles: 2}, quota)
+
+	err = r.Delete(ctx, fileID)
+	assert.NoError(err)
+
+	quota, err = r.Quota(ctx, user)
+	assert.NoError(err)
+	assert.Equal(&app.Quota{Owner: user, MaxBytes: 100, MaxFiles: 2, UsedFiles: 1}, quota)
+
+	quota, err = r.SetQuota(ctx, user, 0, 10)
+	assert.NoError(err)
+	assert.Equal(&app.Quota{Owner: user, MaxFiles: 10, UsedFiles: 1}, quota)
+
+	err = r.SetContent(ctx, &app.File{ID: otherID, Size: 1000, Digest: digest, ContentType: contentType})
+	assert.NoError(err)
+
+	quota, err = r.Quota(ctx, user)
+	assert.NoError(err)
+	assert.Equal(&app.Quota{Owner: user, MaxFiles: 10, UsedBytes: 1000, UsedFiles: 1}, quota)
+}
+
+func TestRepo_Scan(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, _, assert := start(t)
+
+	pending := func() uuid.UUID {
+		fileID, err := r.Create(ctx, owner, owner, private)
+		assert.NoError(err)
+
+		err = r.SetContent(ctx, &app.File{ID: fileID, ContentType: contentType, Scan: app.Scan{Status: app.ScanPending}})
+		assert.NoError(err)
+
+		return fileID
+	}
+
+	cleanID, infectedID := pending(), pending()
+
+	// Files without content aren't scanned.
+	_, err := r.Create(ctx, owner, owner, private)
+	assert.NoError(err)
+
+	file, err := r.ByID(ctx, cleanID)
+	assert.NoError(err)
+	assert.Equal(app.Scan{Status: app.ScanPending}, file.Scan)
+
+	quarantined, err := r.Quarantined(ctx, 10)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{cleanID, infectedID}, quarantined)
+
+	quarantined, err = r.Quarantined(ctx, 1)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{cleanID}, quarantined)
+
+	err = r.SetScan(ctx, cleanID, app.ScanClean, "")
+	assert.NoError(err)
+	err = r.SetScan(ctx, infectedID, app.ScanInfected, "Eicar-Signature")
+	assert.NoError(err)
+
+	file, err = r.ByID(ctx, infectedID)
+	assert.NoError(err)
+	assert.Equal(app.ScanInfected, file.Scan.Status)
+	assert.Equal("Eicar-Signature", file.Scan.Threat)
+	assert.False(file.Scan.ScannedAt.IsZero())
+
+	quarantined, err = r.Quarantined(ctx, 10)
+	assert.NoError(err)
+	assert.Empty(quarantined)
+
+	err = r.SetScan(ctx, uuid.Must(uuid.NewV4()), app.ScanClean, "")
+	assert.ErrorIs(err, app.ErrNotFound)
+}
+
+func TestRepo_LegacyFile(t *testing.T) {
+	t.Parallel()
+
+	ctx, conn, assert := startDB(t, legacyDir)
+	userService := app.ServicePrincipal("user")
+
+	// Files were split into chunks of 4096 bytes before chunk size was added.
+	content := make([]byte, 4096+10)
+	_, err := rand.Read(content)
+	assert.NoError(err)
+
+	fileID, firstID, secondID := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	err = conn.NoTx(func(db *sqlx.DB) error {
+		_, err := db.ExecContext(ctx, `insert into files (id, size, chunk_ids) values ($1, $2, array[$3::uuid, $4::uuid])`,
+			fileID, len(content), firstID, secondID)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.ExecContext(ctx, `insert into chunks (id, file_id, bytes) values ($1, $3, $4), ($2, $3, $5)`,
+			firstID, secondID, fileID, content[:4096], content[4096:])
+		if err != nil {
+			return err
+		}
+
+		return migrater.Auto(ctx, db.DB, migrateDir)
+	})
+	assert.NoError(err)
+
+	var legacyTables int
+	err = conn.NoTx(func(db *sqlx.DB) error {
+		return db.GetContext(ctx, &legacyTables, `select count(*) from information_schema.tables where table_name = 'chunks'`)
+	})
+	assert.NoError(err)
+	assert.Zero(legacyTables)
+
+	r, chunks := repo.New(conn), repo.NewChunkStore(conn)
+
+	file, err := r.ByID(ctx, fileID)
+	assert.NoError(err)
+	assert.EqualValues(len(content), file.Size)
+	assert.Equal(userService, file.Owner)
+
+	res, err := chunks.Get(ctx, fileID)
+	assert.NoError(err)
+
+	buf, err := io.ReadAll(res)
+	assert.NoError(err)
+	assert.Equal(content, buf)
+	assert.NoError(res.Close())
+
+	// Avatars uploaded before claims were added are released by user service by legacy reference.
+	orphans, err := r.Orphans(ctx, time.Now().Add(time.Hour), 10)
+	assert.NoError(err)
+	assert.Empty(orphans)
+	err = r.Release(ctx, fileID, "legacy")
+	assert.NoError(err)
+	orphans, err = r.Orphans(ctx, time.Now().Add(time.Hour), 10)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{fileID}, orphans)
+
+	// Files uploaded before access control was added are deleted by user service.
+	module := app.New(r, chunks, r, nil, nil, nil, nil)
+	err = module.Delete(ctx, app.ServicePrincipal("other"), fileID)
+	assert.ErrorIs(err, app.ErrAccessDenied)
+	err = module.Delete(ctx, userService, fileID)
+	assert.NoError(err)
+
+	_, err = r.ByID(ctx, fileID)
+	assert.ErrorIs(err, app.ErrNotFound)
 }
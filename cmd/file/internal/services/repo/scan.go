@@ -0,0 +1,53 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// SetScan for implements app.Repo.
+func (r *Repo) SetScan(ctx context.Context, fileID uuid.UUID, status app.ScanStatus, threat string) error {
+	return r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `update files set scan_status = $1, scan_threat = $2, scanned_at = now() where id = $3`
+
+		result, err := db.ExecContext(ctx, query, status, threat, fileID)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", convertErr(err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return app.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// Quarantined for implements app.Repo.
+func (r *Repo) Quarantined(ctx context.Context, limit int) (ids []uuid.UUID, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `select id from files where scan_status = $1 order by created_at limit $2`
+
+		err := db.SelectContext(ctx, &ids, query, app.ScanPending, limit)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", convertErr(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,240 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgtype"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+var _ app.UploadRepo = &Repo{}
+
+type (
+	uploadSession struct {
+		ID           pgtype.UUID      `db:"id"`
+		Owner        string           `db:"owner"`
+		Size         int64            `db:"size"`
+		AllowedTypes pgtype.TextArray `db:"allowed_types"`
+		MaxSize      int64            `db:"max_size"`
+		Account      string           `db:"account"`
+		CreatedAt    pgtype.Timestamp `db:"created_at"`
+		UpdatedAt    pgtype.Timestamp `db:"updated_at"`
+	}
+
+	uploadPart struct {
+		Number int   `db:"number"`
+		Size   int64 `db:"size"`
+	}
+)
+
+func (s *uploadSession) convert(parts []uploadPart) *app.UploadSession {
+	res := &app.UploadSession{
+		ID:    s.ID.Bytes,
+		Owner: app.Principal(s.Owner),
+		Size:  s.Size,
+		Policy: app.Policy{
+			MaxSize: s.MaxSize,
+			Account: app.Principal(s.Account),
+		},
+		Parts:     make([]app.Part, len(parts)),
+		CreatedAt: s.CreatedAt.Time,
+		UpdatedAt: s.UpdatedAt.Time,
+	}
+
+	for _, allowed := range s.AllowedTypes.Elements {
+		res.Policy.AllowedTypes = append(res.Policy.AllowedTypes, allowed.String)
+	}
+
+	for i := range parts {
+		res.Parts[i] = app.Part{
+			Number: parts[i].Number,
+			Size:   parts[i].Size,
+		}
+	}
+
+	return res
+}
+
+// CreateSession for implements app.UploadRepo.
+func (r *Repo) CreateSession(ctx context.Context, owner app.Principal, size int64, policy app.Policy) (res *app.UploadSession, err error) {
+	// Column isn't nullable, empty array allows any type.
+	allowedTypes := pgtype.TextArray{}
+	err = allowedTypes.Set(append([]string{}, policy.AllowedTypes...))
+	if err != nil {
+		return nil, fmt.Errorf("allowedTypes.Set: %w", err)
+	}
+
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `
+		insert into upload_sessions (owner, size, allowed_types, max_size, account)
+		values ($1, $2, $3, $4, $5)
+		returning *`
+		session := &uploadSession{}
+
+		err := db.GetContext(ctx, session, query, owner, size, allowedTypes, policy.MaxSize, policy.Account)
+		if err != nil {
+			return fmt.Errorf("db.GetContext: %w", convertErr(err))
+		}
+
+		res = session.convert(nil)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Session for implements app.UploadRepo.
+func (r *Repo) Session(ctx context.Context, sessionID uuid.UUID) (res *app.UploadSession, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const (
+			querySession = `select * from upload_sessions where id = $1`
+			queryParts   = `
+			select number, sum(length(bytes)) as size
+			from upload_parts
+			where session_id = $1
+			group by number
+			order by number`
+		)
+
+		session := &uploadSession{}
+		err := db.GetContext(ctx, session, querySession, sessionID)
+		if err != nil {
+			return fmt.Errorf("db.GetContext: %w", convertErr(err))
+		}
+
+		var parts []uploadPart
+		err = db.SelectContext(ctx, &parts, queryParts, sessionID)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", convertErr(err))
+		}
+
+		res = session.convert(parts)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SavePart for implements app.UploadRepo.
+func (r *Repo) SavePart(ctx context.Context, sessionID uuid.UUID, number int, reader io.Reader) (size int64, err error) {
+	err = r.db.Tx(ctx, nil, func(tx *sqlx.Tx) error {
+		const (
+			queryTouch      = `update upload_sessions set updated_at = now() where id = $1`
+			queryDeletePart = `delete from upload_parts where session_id = $1 and number = $2`
+		)
+
+		result, err := tx.ExecContext(ctx, queryTouch, sessionID)
+		if err != nil {
+			return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return app.ErrNotFound
+		}
+
+		_, err = tx.ExecContext(ctx, queryDeletePart, sessionID, number)
+		if err != nil {
+			return fmt.Errorf("tx.ExecContext: %w", convertErr(err))
+		}
+
+		size, err = readChunks(reader, app.MaxChunkSize, func(seq int, chunks [][]byte) error {
+			rows := make([][]interface{}, len(chunks))
+			for i := range chunks {
+				rows[i] = []interface{}{sessionID, number, seq + i, pgtype.Bytea{
+					Bytes:  chunks[i],
+					Status: pgtype.Present,
+				}}
+			}
+
+			return insertRows(ctx, tx, "upload_parts (session_id, number, seq, bytes)", rows, "")
+		})
+		if err != nil {
+			return fmt.Errorf("readChunks: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// Content for implements app.UploadRepo.
+func (r *Repo) Content(ctx context.Context, sessionID uuid.UUID) (res io.ReadCloser, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `select bytes from upload_parts where session_id = $1 order by number, seq`
+
+		rows, err := db.QueryContext(ctx, query, sessionID)
+		if err != nil {
+			return fmt.Errorf("db.QueryContext: %w", convertErr(err))
+		}
+
+		res = &partsReader{rows: rows}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteSession for implements app.UploadRepo.
+func (r *Repo) DeleteSession(ctx context.Context, sessionID uuid.UUID) error {
+	return r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `delete from upload_sessions where id = $1`
+
+		_, err := db.ExecContext(ctx, query, sessionID)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", convertErr(err))
+		}
+
+		return nil
+	})
+}
+
+// DeleteStale for implements app.UploadRepo.
+func (r *Repo) DeleteStale(ctx context.Context, updatedBefore time.Time) (deleted int64, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `delete from upload_sessions where updated_at < $1`
+
+		result, err := db.ExecContext(ctx, query, updatedBefore)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", convertErr(err))
+		}
+
+		deleted, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
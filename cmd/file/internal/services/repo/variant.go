@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/libs/db"
+)
+
+// SaveVariant for implements app.Repo.
+func (r *Repo) SaveVariant(ctx context.Context, fileID uuid.UUID, width int, variantID uuid.UUID) error {
+	return r.db.NoTx(func(conn *sqlx.DB) error {
+		const query = `
+		insert into file_variants (original_id, width, file_id)
+		values ($1, $2, $3)`
+
+		_, err := conn.ExecContext(ctx, query, fileID, width, variantID)
+		switch {
+		case db.PostgresErrName(err, db.PostgresUniqueViolation):
+			return app.ErrVariantExist
+		case db.PostgresErrName(err, db.PostgresForeignKeyViolation):
+			return app.ErrNotFound
+		case err != nil:
+			return fmt.Errorf("conn.ExecContext: %w", convertErr(err))
+		}
+
+		return nil
+	})
+}
+
+// Variant for implements app.Repo.
+func (r *Repo) Variant(ctx context.Context, fileID uuid.UUID, width int) (variantID uuid.UUID, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `select file_id from file_variants where original_id = $1 and width = $2`
+
+		err := db.GetContext(ctx, &variantID, query, fileID, width)
+		if err != nil {
+			return fmt.Errorf("db.GetContext: %w", convertErr(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return variantID, nil
+}
+
+// Variants for implements app.Repo.
+func (r *Repo) Variants(ctx context.Context, fileID uuid.UUID) (variants []uuid.UUID, err error) {
+	err = r.db.NoTx(func(db *sqlx.DB) error {
+		const query = `select file_id from file_variants where original_id = $1 order by width`
+
+		err := db.SelectContext(ctx, &variants, query, fileID)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", convertErr(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
@@ -0,0 +1,18 @@
+package s3
+
+import (
+	"github.com/minio/minio-go/v7"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+const codeNoSuchKey = "NoSuchKey"
+
+func convertErr(err error) error {
+	switch {
+	case minio.ToErrorResponse(err).Code == codeNoSuchKey:
+		return app.ErrNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,77 @@
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/s3"
+)
+
+const (
+	testFile    = `../../../testdata/test.jpg`
+	contentType = `image/jpeg`
+	timeout     = time.Second * 30
+	accessKey   = `minio`
+	secretKey   = `minio-secret`
+	bucket      = `files`
+)
+
+var logger = zerolog.New(os.Stdout)
+
+func start(t *testing.T) (context.Context, *s3.Store, *require.Assertions) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	assert := require.New(t)
+	pool, err := dockertest.NewPool("")
+	assert.NoError(err)
+	pool.MaxWait = timeout
+
+	opt := &dockertest.RunOptions{
+		Repository: "minio/minio",
+		Tag:        "RELEASE.2021-06-17T00-10-46Z",
+		Cmd:        []string{"server", "/data"},
+		Env: []string{
+			"MINIO_ROOT_USER=" + accessKey,
+			"MINIO_ROOT_PASSWORD=" + secretKey,
+		},
+	}
+
+	resource, err := pool.RunWithOptions(opt, func(cfg *docker.HostConfig) {
+		cfg.AutoRemove = true
+	})
+	assert.NoError(err)
+
+	var store *s3.Store
+	err = pool.Retry(func() error {
+		store, err = s3.New(ctx, s3.Config{
+			Endpoint:  fmt.Sprintf("localhost:%s", resource.GetPort("9000/tcp")),
+			AccessKey: accessKey,
+			SecretKey: secretKey,
+			Bucket:    bucket,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	assert.NoError(err)
+
+	t.Cleanup(func() {
+		err = pool.Purge(resource)
+		assert.NoError(err)
+	})
+
+	return logger.WithContext(ctx), store, assert
+}
@@ -0,0 +1,104 @@
+// Package s3 contains implements for app.BlobStore.
+// Provide file content to and from S3-compatible object storage.
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gofrs/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+var _ app.BlobStore = &Store{}
+
+type (
+	// Config for connecting to object storage.
+	Config struct {
+		Endpoint  string
+		AccessKey string
+		SecretKey string
+		Bucket    string
+		Region    string
+		UseSSL    bool
+	}
+
+	// Store provided file content from and to object storage.
+	// Every file is kept as separate object named by file id.
+	Store struct {
+		client *minio.Client
+		bucket string
+	}
+)
+
+// New build and returns object storage store.
+// Bucket will be created if not exists.
+func New(ctx context.Context, cfg Config) (*Store, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("minio.New: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("client.BucketExists: %w", err)
+	}
+
+	if !exists {
+		err = client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region})
+		if err != nil {
+			return nil, fmt.Errorf("client.MakeBucket: %w", err)
+		}
+	}
+
+	return &Store{
+		client: client,
+		bucket: cfg.Bucket,
+	}, nil
+}
+
+// Put for implements app.BlobStore.
+func (s *Store) Put(ctx context.Context, fileID uuid.UUID, contentType string, reader io.Reader) (int64, error) {
+	info, err := s.client.PutObject(ctx, s.bucket, fileID.String(), reader, -1, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("client.PutObject: %w", err)
+	}
+
+	return info.Size, nil
+}
+
+// Get for implements app.BlobStore.
+func (s *Store) Get(ctx context.Context, fileID uuid.UUID) (io.ReadSeekCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, fileID.String(), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("client.GetObject: %w", convertErr(err))
+	}
+
+	// GetObject is lazy, so check object existence before return.
+	_, err = obj.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("obj.Stat: %w, obj.Close: %s", convertErr(err), obj.Close())
+	}
+
+	return obj, nil
+}
+
+// Delete for implements app.BlobStore.
+func (s *Store) Delete(ctx context.Context, fileID uuid.UUID) error {
+	err := s.client.RemoveObject(ctx, s.bucket, fileID.String(), minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("client.RemoveObject: %w", err)
+	}
+
+	return nil
+}
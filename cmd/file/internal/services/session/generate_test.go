@@ -0,0 +1,3 @@
+package session_test
+
+//go:generate mockgen -source=session.go -destination mock.app.contracts_test.go -package session_test
@@ -0,0 +1,27 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/session"
+)
+
+var (
+	ctx    = context.Background()
+	errAny = errors.New("any err")
+)
+
+func start(t *testing.T) (*session.Client, *MocksessionSvc, *require.Assertions) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	mock := NewMocksessionSvc(ctrl)
+
+	return session.New(mock), mock, require.New(t)
+}
@@ -0,0 +1,46 @@
+// Package session needed for get user session by token.
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	session "github.com/Meat-Hook/back-template/cmd/session/client"
+)
+
+var _ app.AuthSvc = &Client{}
+
+// For easy testing.
+type sessionSvc interface {
+	Session(ctx context.Context, token string, ip net.IP, userAgent string) (*session.Session, error)
+}
+
+// Client wrapper for session microservice.
+type Client struct {
+	session sessionSvc
+}
+
+// New build and returns new session Client.
+func New(svc sessionSvc) *Client {
+	return &Client{session: svc}
+}
+
+// Session for implements app.AuthSvc.
+func (c *Client) Session(ctx context.Context, token string, origin app.Origin) (*app.Session, error) {
+	res, err := c.session.Session(ctx, token, origin.IP, origin.UserAgent)
+	switch {
+	case errors.Is(err, session.ErrNotFound), errors.Is(err, session.ErrExpired),
+		errors.Is(err, session.ErrInvalidToken), errors.Is(err, session.ErrOriginMismatch):
+		return nil, app.ErrNotFound
+	case err != nil:
+		return nil, fmt.Errorf("c.session.Session: %w", err)
+	}
+
+	return &app.Session{
+		ID:     res.ID,
+		UserID: res.UserID,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package session_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+	"github.com/Meat-Hook/back-template/cmd/session/client"
+)
+
+func TestClient_Session(t *testing.T) {
+	t.Parallel()
+
+	sessionInfo := &client.Session{
+		ID:     uuid.Must(uuid.NewV4()),
+		UserID: uuid.Must(uuid.NewV4()),
+	}
+	origin := app.Origin{IP: net.ParseIP("192.100.10.4"), UserAgent: "Mozilla/5.0"}
+
+	testCases := []struct {
+		name    string
+		token   string
+		svcRes  *client.Session
+		svcErr  error
+		want    *app.Session
+		wantErr error
+	}{
+		{"success", "validToken", sessionInfo, nil, &app.Session{ID: sessionInfo.ID, UserID: sessionInfo.UserID}, nil},
+		{"err_not_found", "notFoundToken", nil, client.ErrNotFound, nil, app.ErrNotFound},
+		{"err_expired", "expiredToken", nil, client.ErrExpired, nil, app.ErrNotFound},
+		{"err_origin_mismatch", "stolenToken", nil, client.ErrOriginMismatch, nil, app.ErrNotFound},
+		{"err_invalid_token", "notValidToken", nil, client.ErrInvalidToken, nil, app.ErrNotFound},
+		{"err_any", "notValidToken", nil, errAny, nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, mock, assert := start(t)
+
+			mock.EXPECT().Session(ctx, tc.token, origin.IP, origin.UserAgent).Return(tc.svcRes, tc.svcErr)
+
+			res, err := svc.Session(ctx, tc.token, origin)
+			assert.Equal(tc.want, res)
+			assert.ErrorIs(err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package signer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/signer"
+)
+
+func start(t *testing.T, key string) (*signer.Signer, *require.Assertions) {
+	t.Helper()
+
+	return signer.New([]byte(key)), require.New(t)
+}
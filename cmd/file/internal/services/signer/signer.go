@@ -0,0 +1,30 @@
+// Package signer contains implements for app.Signer.
+// Signs data by HMAC-SHA256 with secret key.
+package signer
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+var _ app.Signer = &Signer{}
+
+// Signer signs data with secret key.
+type Signer struct {
+	key []byte
+}
+
+// New build and returns new Signer.
+func New(key []byte) *Signer {
+	return &Signer{key: key}
+}
+
+// Sign for implements app.Signer.
+func (s *Signer) Sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(data)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,33 @@
+package signer_test
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		key  string
+		data string
+		want string
+	}{
+		// RFC 4231, test case 2.
+		{"rfc", "Jefe", "what do ya want for nothing?", "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"},
+		{"empty", "key", "", "5d5d139563c95b5967b9bd9a8c9b233a9dedb45072794cd232dc1b74832607d0"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, assert := start(t, tc.key)
+
+			res := s.Sign([]byte(tc.data))
+			assert.Equal(tc.want, hex.EncodeToString(res))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package thumbnail_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/services/thumbnail"
+)
+
+func start(t *testing.T) (*thumbnail.Thumbnailer, *require.Assertions) {
+	t.Helper()
+
+	return thumbnail.New(), require.New(t)
+}
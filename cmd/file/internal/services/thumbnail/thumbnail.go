@@ -0,0 +1,93 @@
+// Package thumbnail contains implements for app.Thumbnailer.
+// Resize images using pure Go codecs.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // Register GIF decoder.
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // Register WebP decoder.
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+var _ app.Thumbnailer = &Thumbnailer{}
+
+const (
+	// jpegQuality is quality of encoded JPEG variants.
+	jpegQuality = 85
+	// maxPixels limits size of decoded image, so small file declaring huge size
+	// doesn't exhaust memory. Decoded image takes up to 4 bytes per pixel.
+	maxPixels = 50 * 1000 * 1000
+)
+
+// Thumbnailer resizes JPEG, PNG, GIF and WebP images.
+// Images which may contain transparency are encoded to PNG, others to JPEG.
+type Thumbnailer struct {
+	scaler draw.Scaler
+}
+
+// New build and returns new Thumbnailer.
+func New() *Thumbnailer {
+	return &Thumbnailer{
+		scaler: draw.CatmullRom,
+	}
+}
+
+// Resize for implements app.Thumbnailer.
+func (t *Thumbnailer) Resize(w io.Writer, r io.Reader, width int) error {
+	// Header read for size check is decoded again with the rest of image.
+	head := &bytes.Buffer{}
+	cfg, _, err := image.DecodeConfig(io.TeeReader(r, head))
+	if errors.Is(err, image.ErrFormat) {
+		return app.ErrNotImage
+	}
+	if err != nil {
+		return fmt.Errorf("image.DecodeConfig: %w", err)
+	}
+
+	if int64(cfg.Width)*int64(cfg.Height) > maxPixels {
+		return app.ErrImageTooLarge
+	}
+
+	src, format, err := image.Decode(io.MultiReader(head, r))
+	if err != nil {
+		return fmt.Errorf("image.Decode: %w", err)
+	}
+
+	dst := src
+	bounds := src.Bounds()
+	// Images are never upscaled.
+	if bounds.Dx() > width {
+		height := bounds.Dy() * width / bounds.Dx()
+		if height == 0 {
+			height = 1
+		}
+
+		res := image.NewRGBA(image.Rect(0, 0, width, height))
+		t.scaler.Scale(res, res.Bounds(), src, bounds, draw.Over, nil)
+		dst = res
+	}
+
+	switch format {
+	case "png", "gif", "webp":
+		err = png.Encode(w, dst)
+		if err != nil {
+			return fmt.Errorf("png.Encode: %w", err)
+		}
+	default:
+		err = jpeg.Encode(w, dst, &jpeg.Options{Quality: jpegQuality})
+		if err != nil {
+			return fmt.Errorf("jpeg.Encode: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package thumbnail_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+func TestThumbnailer_Resize(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+
+	jpegFile := &bytes.Buffer{}
+	err := jpeg.Encode(jpegFile, image.NewRGBA(image.Rect(0, 0, 512, 512)), nil)
+	assert.NoError(err)
+
+	pngFile := &bytes.Buffer{}
+	err = png.Encode(pngFile, image.NewNRGBA(image.Rect(0, 0, 300, 150)))
+	assert.NoError(err)
+
+	// Decompression bomb: small file declares 100000x100000 image in IHDR chunk.
+	bombFile := append([]byte{}, pngFile.Bytes()...)
+	binary.BigEndian.PutUint32(bombFile[16:20], 100000)
+	binary.BigEndian.PutUint32(bombFile[20:24], 100000)
+	binary.BigEndian.PutUint32(bombFile[29:33], crc32.ChecksumIEEE(bombFile[12:29]))
+
+	testCases := []struct {
+		name       string
+		file       io.Reader
+		width      int
+		wantFormat string
+		wantWidth  int
+		wantErr    error
+	}{
+		{"success_jpeg", bytes.NewReader(jpegFile.Bytes()), 256, "jpeg", 256, nil},
+		{"success_png", bytes.NewReader(pngFile.Bytes()), 64, "png", 64, nil},
+		{"success_no_upscale", bytes.NewReader(pngFile.Bytes()), 1024, "png", 300, nil},
+		{"err_not_image", bytes.NewReader([]byte("text")), 64, "", 0, app.ErrNotImage},
+		{"err_too_large", bytes.NewReader(bombFile), 64, "", 0, app.ErrImageTooLarge},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			thumb, assert := start(t)
+
+			res := &bytes.Buffer{}
+			err := thumb.Resize(res, tc.file, tc.width)
+			assert.ErrorIs(err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+
+			cfg, format, err := image.DecodeConfig(res)
+			assert.NoError(err)
+			assert.Equal(tc.wantFormat, format)
+			assert.Equal(tc.wantWidth, cfg.Width)
+		})
+	}
+}
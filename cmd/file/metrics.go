@@ -0,0 +1,49 @@
+package file
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Meat-Hook/back-template/cmd/file/internal/app"
+)
+
+// reaperMetrics contains metrics of removed orphaned files and chunks.
+type reaperMetrics struct {
+	files  *prometheus.CounterVec
+	chunks *prometheus.CounterVec
+}
+
+func newReaperMetrics(reg *prometheus.Registry, namespace string) *reaperMetrics {
+	const subsystem = "reaper"
+
+	metric := &reaperMetrics{
+		files: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "files_total",
+				Help:      "Amount of reaped orphaned files.",
+			},
+			[]string{"dry_run"},
+		),
+		chunks: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "chunks_total",
+				Help:      "Amount of reaped chunks without file.",
+			},
+			[]string{"dry_run"},
+		),
+	}
+	reg.MustRegister(metric.files, metric.chunks)
+
+	return metric
+}
+
+func (m *reaperMetrics) observe(res *app.ReapResult, dryRun bool) {
+	label := strconv.FormatBool(dryRun)
+	m.files.WithLabelValues(label).Add(float64(len(res.Files)))
+	m.chunks.WithLabelValues(label).Add(float64(res.Chunks))
+}
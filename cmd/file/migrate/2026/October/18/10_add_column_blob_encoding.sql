@@ -0,0 +1,20 @@
+--up
+ALTER TABLE blobs ADD COLUMN encoding STRING NOT NULL DEFAULT '';
+ALTER TABLE file_chunks ADD COLUMN encoding STRING NOT NULL DEFAULT '';
+
+-- Equal bytes stored in different encodings are different blobs.
+ALTER TABLE file_chunks DROP CONSTRAINT fk_hash_ref_blobs;
+DROP INDEX file_chunks@file_chunks_hash_idx;
+ALTER TABLE blobs DROP CONSTRAINT "primary", ADD CONSTRAINT "primary" PRIMARY KEY (hash, encoding);
+CREATE INDEX file_chunks_hash_idx ON file_chunks (hash, encoding);
+ALTER TABLE file_chunks ADD CONSTRAINT fk_hash_ref_blobs FOREIGN KEY (hash, encoding) REFERENCES blobs (hash, encoding);
+
+--down
+ALTER TABLE file_chunks DROP CONSTRAINT fk_hash_ref_blobs;
+DROP INDEX file_chunks@file_chunks_hash_idx;
+ALTER TABLE blobs DROP CONSTRAINT "primary", ADD CONSTRAINT "primary" PRIMARY KEY (hash);
+CREATE INDEX file_chunks_hash_idx ON file_chunks (hash);
+ALTER TABLE file_chunks ADD CONSTRAINT fk_hash_ref_blobs FOREIGN KEY (hash) REFERENCES blobs (hash);
+
+ALTER TABLE file_chunks DROP COLUMN encoding;
+ALTER TABLE blobs DROP COLUMN encoding;
@@ -0,0 +1,21 @@
+--up
+CREATE TABLE file_claims
+(
+    file_id    UUID      NOT NULL,
+    ref        STRING    NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (file_id) REFERENCES files ON DELETE CASCADE,
+    PRIMARY KEY (file_id, ref)
+);
+
+-- Avatars uploaded before claims were added are released by user service by 'legacy' reference.
+INSERT INTO file_claims (file_id, ref)
+    SELECT id, 'legacy' FROM files WHERE owner LIKE 'service:%';
+
+-- Files uploaded by users are referenced by owner.
+INSERT INTO file_claims (file_id, ref)
+    SELECT id, owner FROM files WHERE owner LIKE 'user:%';
+
+--down
+DROP TABLE file_claims;
@@ -0,0 +1,22 @@
+--up
+ALTER TABLE files ADD COLUMN account STRING NOT NULL DEFAULT '';
+
+CREATE TABLE quotas
+(
+    owner      STRING    NOT NULL,
+    max_bytes  INT8               DEFAULT NULL,
+    max_files  INT8               DEFAULT NULL,
+    used_bytes INT8      NOT NULL DEFAULT 0,
+    used_files INT8      NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (owner)
+);
+
+INSERT INTO quotas (owner, used_bytes, used_files)
+    SELECT owner, SUM(size)::INT8, COUNT(*) FROM files GROUP BY owner;
+
+--down
+DROP TABLE quotas;
+ALTER TABLE files DROP COLUMN account;
@@ -0,0 +1,9 @@
+--up
+ALTER TABLE files ADD COLUMN scan_status STRING NOT NULL DEFAULT 'skipped';
+ALTER TABLE files ADD COLUMN scan_threat STRING NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN scanned_at TIMESTAMP;
+
+--down
+ALTER TABLE files DROP COLUMN scanned_at;
+ALTER TABLE files DROP COLUMN scan_threat;
+ALTER TABLE files DROP COLUMN scan_status;
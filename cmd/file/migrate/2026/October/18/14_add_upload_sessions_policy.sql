@@ -0,0 +1,9 @@
+--up
+ALTER TABLE upload_sessions ADD COLUMN allowed_types STRING[] NOT NULL DEFAULT ARRAY[];
+ALTER TABLE upload_sessions ADD COLUMN max_size INT NOT NULL DEFAULT 0;
+ALTER TABLE upload_sessions ADD COLUMN account STRING NOT NULL DEFAULT '';
+
+--down
+ALTER TABLE upload_sessions DROP COLUMN account;
+ALTER TABLE upload_sessions DROP COLUMN max_size;
+ALTER TABLE upload_sessions DROP COLUMN allowed_types;
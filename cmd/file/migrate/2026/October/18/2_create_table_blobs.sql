@@ -0,0 +1,73 @@
+--up
+ALTER TABLE files ADD COLUMN digest STRING NOT NULL DEFAULT '';
+
+CREATE TABLE blobs
+(
+    hash       BYTES     NOT NULL,
+    bytes      BYTEA     NOT NULL,
+    ref_count  INT       NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (hash)
+);
+
+CREATE TABLE file_chunks
+(
+    file_id UUID  NOT NULL,
+    seq     INT   NOT NULL,
+    hash    BYTES NOT NULL,
+
+    FOREIGN KEY (file_id) REFERENCES files,
+    FOREIGN KEY (hash) REFERENCES blobs,
+    INDEX (hash),
+    PRIMARY KEY (file_id, seq)
+);
+
+INSERT INTO blobs (hash, bytes, ref_count)
+    SELECT decode(sha256(chunks.bytes), 'hex'), min(chunks.bytes), count(*)
+    FROM chunks
+             JOIN files ON files.id = chunks.file_id
+    WHERE array_position(files.chunk_ids, chunks.id) IS NOT NULL
+    GROUP BY 1;
+
+INSERT INTO file_chunks (file_id, seq, hash)
+    SELECT chunks.file_id, array_position(files.chunk_ids, chunks.id), decode(sha256(chunks.bytes), 'hex')
+    FROM chunks
+             JOIN files ON files.id = chunks.file_id
+    WHERE array_position(files.chunk_ids, chunks.id) IS NOT NULL;
+
+DROP TABLE chunks;
+ALTER TABLE files DROP COLUMN chunk_ids;
+
+--down
+ALTER TABLE files ADD COLUMN chunk_ids UUID[] DEFAULT NULL;
+
+CREATE TABLE chunks
+(
+    id         UUID      NOT NULL DEFAULT GEN_RANDOM_UUID(),
+    file_id    UUID      NOT NULL,
+    bytes      BYTEA     NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (file_id) REFERENCES files ON DELETE CASCADE,
+    PRIMARY KEY (id)
+);
+
+-- Chunk ids are derived from file id and position, so file refers to them in order.
+INSERT INTO chunks (id, file_id, bytes)
+    SELECT md5(file_chunks.file_id::STRING || '/' || file_chunks.seq::STRING)::UUID, file_chunks.file_id, blobs.bytes
+    FROM file_chunks
+             JOIN blobs ON blobs.hash = file_chunks.hash;
+
+UPDATE files
+SET chunk_ids = ARRAY(
+        SELECT md5(file_chunks.file_id::STRING || '/' || file_chunks.seq::STRING)::UUID
+        FROM file_chunks
+        WHERE file_chunks.file_id = files.id
+        ORDER BY file_chunks.seq);
+
+DROP TABLE file_chunks;
+DROP TABLE blobs;
+ALTER TABLE files DROP COLUMN digest;
@@ -0,0 +1,27 @@
+--up
+CREATE TABLE upload_sessions
+(
+    id         UUID      NOT NULL DEFAULT GEN_RANDOM_UUID(),
+    size       INT       NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    INDEX (updated_at),
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE upload_parts
+(
+    session_id UUID      NOT NULL,
+    number     INT       NOT NULL,
+    seq        INT       NOT NULL,
+    bytes      BYTEA     NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (session_id) REFERENCES upload_sessions ON DELETE CASCADE,
+    PRIMARY KEY (session_id, number, seq)
+);
+
+--down
+DROP TABLE upload_parts;
+DROP TABLE upload_sessions;
@@ -0,0 +1,5 @@
+--up
+ALTER TABLE files ADD COLUMN content_type STRING NOT NULL DEFAULT 'application/octet-stream';
+
+--down
+ALTER TABLE files DROP COLUMN content_type;
@@ -0,0 +1,16 @@
+--up
+CREATE TABLE file_variants
+(
+    original_id UUID      NOT NULL,
+    width       INT       NOT NULL,
+    file_id     UUID      NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (original_id) REFERENCES files ON DELETE CASCADE,
+    FOREIGN KEY (file_id) REFERENCES files ON DELETE CASCADE,
+    UNIQUE INDEX (file_id),
+    PRIMARY KEY (original_id, width)
+);
+
+--down
+DROP TABLE file_variants;
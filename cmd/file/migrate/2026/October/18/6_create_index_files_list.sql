@@ -0,0 +1,9 @@
+--up
+CREATE INDEX files_created_at_idx ON files (created_at, id);
+CREATE INDEX files_size_idx ON files (size, id);
+CREATE INVERTED INDEX files_metadata_idx ON files (metadata);
+
+--down
+DROP INDEX files@files_metadata_idx;
+DROP INDEX files@files_size_idx;
+DROP INDEX files@files_created_at_idx;
@@ -0,0 +1,22 @@
+--up
+ALTER TABLE files ADD COLUMN owner STRING NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN visibility STRING NOT NULL DEFAULT 'public';
+ALTER TABLE upload_sessions ADD COLUMN owner STRING NOT NULL DEFAULT '';
+
+-- Files were uploaded only by user service before access control was added.
+UPDATE files SET owner = 'service:user' WHERE owner = '';
+
+CREATE TABLE file_shares
+(
+    file_id   UUID   NOT NULL,
+    principal STRING NOT NULL,
+
+    FOREIGN KEY (file_id) REFERENCES files ON DELETE CASCADE,
+    PRIMARY KEY (file_id, principal)
+);
+
+--down
+DROP TABLE file_shares;
+ALTER TABLE upload_sessions DROP COLUMN owner;
+ALTER TABLE files DROP COLUMN visibility;
+ALTER TABLE files DROP COLUMN owner;
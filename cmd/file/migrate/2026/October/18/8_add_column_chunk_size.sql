@@ -0,0 +1,5 @@
+--up
+ALTER TABLE files ADD COLUMN chunk_size INT NOT NULL DEFAULT 4096;
+
+--down
+ALTER TABLE files DROP COLUMN chunk_size;
@@ -0,0 +1,7 @@
+--up
+ALTER TABLE files ADD COLUMN key_id STRING NOT NULL DEFAULT '';
+ALTER TABLE files ADD COLUMN data_key BYTES;
+
+--down
+ALTER TABLE files DROP COLUMN data_key;
+ALTER TABLE files DROP COLUMN key_id;
@@ -3,8 +3,11 @@ package client
 
 import (
 	"context"
+	"crypto/ed25519"
+	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"google.golang.org/grpc"
@@ -12,14 +15,19 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
-	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+	"github.com/Meat-Hook/back-template/cmd/session/token"
 	"github.com/Meat-Hook/back-template/libs/log"
 	pb "github.com/Meat-Hook/back-template/proto/gen/go/session/v1"
 )
 
 // Errors.
 var (
-	ErrNotFound = app.ErrNotFound
+	ErrNotFound     = errors.New("not found")
+	ErrExpired      = token.ErrExpired
+	ErrReused       = errors.New("refresh token reused")
+	ErrInvalidToken = token.ErrInvalid
+	// ErrOriginMismatch is returned when session is used from origin rejected by policy of session service.
+	ErrOriginMismatch = errors.New("session used from other origin")
 )
 
 // Client to session microservice.
@@ -38,23 +46,85 @@ type Session struct {
 	UserID uuid.UUID
 }
 
+// SessionInfo contains info about where user is logged in.
+type SessionInfo struct {
+	ID         uuid.UUID
+	IP         net.IP
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+}
+
+// PublicKey contains Ed25519 key for verifying public tokens.
+type PublicKey struct {
+	ID  string
+	Key ed25519.PublicKey
+}
+
+// Event contains security event of user session, e.g. new sign-in.
+type Event struct {
+	ID        uuid.UUID
+	Kind      EventKind
+	SessionID uuid.UUID
+	IP        net.IP
+	UserAgent string
+	// Country contains ISO 3166-1 alpha-2 code of origin country, empty if unknown.
+	Country string
+	// Reasons contains failed origin checks of suspicious and rejected usage.
+	Reasons   []string
+	CreatedAt time.Time
+}
+
+// EventKind contains kind of security event.
+type EventKind string
+
+// Event kinds.
+const (
+	// EventNewSignIn is emitted for every new session.
+	EventNewSignIn EventKind = "new_sign_in"
+	// EventSuspicious is emitted when session is used from origin flagged by policy.
+	EventSuspicious EventKind = "suspicious"
+	// EventRejected is emitted when session is used from origin rejected by policy.
+	EventRejected EventKind = "rejected"
+)
+
 // Token contains user's authorization token.
 type Token struct {
-	Value string
+	Value     string
+	ExpiresAt time.Time
+}
+
+// TokenPair contains user's access and refresh tokens.
+type TokenPair struct {
+	Access  Token
+	Refresh Token
 }
 
 // Session get user session by his auth token.
-func (c *Client) Session(ctx context.Context, token string) (*Session, error) {
+// Origin of session usage is checked by session service unless ip and userAgent are empty.
+func (c *Client) Session(ctx context.Context, token string, ip net.IP, userAgent string) (*Session, error) {
 	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
 		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
 	})
 
-	res, err := c.conn.Session(ctx, &pb.SessionRequest{
-		Token: token,
-	})
+	req := &pb.SessionRequest{
+		Token:     token,
+		UserAgent: userAgent,
+	}
+	if ip != nil {
+		req.Ip = ip.String()
+	}
+
+	res, err := c.conn.Session(ctx, req)
 	switch {
 	case status.Code(err) == codes.NotFound:
 		return nil, fmt.Errorf("%w: %s", ErrNotFound, err)
+	case status.Code(err) == codes.InvalidArgument:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	case status.Code(err) == codes.Unauthenticated:
+		return nil, fmt.Errorf("%w: %s", ErrExpired, err)
+	case status.Code(err) == codes.PermissionDenied:
+		return nil, fmt.Errorf("%w: %s", ErrOriginMismatch, err)
 	case err != nil:
 		return nil, fmt.Errorf("c.conn.Session: %w", err)
 	}
@@ -92,7 +162,7 @@ func (c *Client) RemoveSession(ctx context.Context, sessionID uuid.UUID) error {
 }
 
 // NewSession make new session for user.
-func (c *Client) NewSession(ctx context.Context, userID uuid.UUID, ip net.IP, userAgent string) (*Token, error) {
+func (c *Client) NewSession(ctx context.Context, userID uuid.UUID, ip net.IP, userAgent string) (*TokenPair, error) {
 	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
 		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
 	})
@@ -106,5 +176,159 @@ func (c *Client) NewSession(ctx context.Context, userID uuid.UUID, ip net.IP, us
 		return nil, fmt.Errorf("c.conn.NewSession: %w", err)
 	}
 
-	return &Token{Value: res.Token}, nil
+	return &TokenPair{
+		Access:  Token{Value: res.Token, ExpiresAt: res.ExpiresAt.AsTime()},
+		Refresh: Token{Value: res.RefreshToken, ExpiresAt: res.RefreshExpiresAt.AsTime()},
+	}, nil
+}
+
+// Refresh exchanges refresh token for new pair of tokens.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
+	})
+
+	res, err := c.conn.Refresh(ctx, &pb.RefreshRequest{
+		RefreshToken: refreshToken,
+	})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, err)
+	case status.Code(err) == codes.InvalidArgument:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	case status.Code(err) == codes.Unauthenticated:
+		return nil, fmt.Errorf("%w: %s", ErrExpired, err)
+	case status.Code(err) == codes.PermissionDenied:
+		return nil, fmt.Errorf("%w: %s", ErrReused, err)
+	case err != nil:
+		return nil, fmt.Errorf("c.conn.Refresh: %w", err)
+	}
+
+	return &TokenPair{
+		Access:  Token{Value: res.Token, ExpiresAt: res.ExpiresAt.AsTime()},
+		Refresh: Token{Value: res.RefreshToken, ExpiresAt: res.RefreshExpiresAt.AsTime()},
+	}, nil
+}
+
+// ListSessions returns active user's sessions, most recently used first.
+func (c *Client) ListSessions(ctx context.Context, userID uuid.UUID) ([]SessionInfo, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
+	})
+
+	res, err := c.conn.ListSessions(ctx, &pb.ListSessionsRequest{
+		UserId: &pb.UUID{Value: userID.String()},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("c.conn.ListSessions: %w", err)
+	}
+
+	sessions := make([]SessionInfo, len(res.Sessions))
+	for i, session := range res.Sessions {
+		sessionID, err := uuid.FromString(session.SessionId.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("uuid.FromString: %w", err)
+		}
+
+		sessions[i] = SessionInfo{
+			ID:         sessionID,
+			IP:         net.ParseIP(session.Ip),
+			UserAgent:  session.UserAgent,
+			CreatedAt:  session.CreatedAt.AsTime(),
+			LastSeenAt: session.LastSeenAt.AsTime(),
+		}
+	}
+
+	return sessions, nil
+}
+
+// RemoveAllSessions removes all user's sessions except the specified one
+// and returns amount of removed sessions. Nil exceptSessionID removes all sessions.
+func (c *Client) RemoveAllSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) (int, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
+	})
+
+	req := &pb.RemoveAllSessionsRequest{
+		UserId: &pb.UUID{Value: userID.String()},
+	}
+	if exceptSessionID != uuid.Nil {
+		req.ExceptSessionId = &pb.UUID{Value: exceptSessionID.String()}
+	}
+
+	res, err := c.conn.RemoveAllSessions(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("c.conn.RemoveAllSessions: %w", err)
+	}
+
+	return int(res.Removed), nil
+}
+
+// keyAlgorithm contains the only supported algorithm of public keys.
+const keyAlgorithm = "Ed25519"
+
+// PublicKeys returns keys for verifying public tokens, empty if tokens are encrypted.
+// Keys of unknown algorithms are skipped.
+func (c *Client) PublicKeys(ctx context.Context) ([]PublicKey, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
+	})
+
+	res, err := c.conn.PublicKeys(ctx, &pb.PublicKeysRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("c.conn.PublicKeys: %w", err)
+	}
+
+	keys := make([]PublicKey, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		if key.Algorithm != keyAlgorithm || len(key.Key) != ed25519.PublicKeySize {
+			continue
+		}
+
+		keys = append(keys, PublicKey{ID: key.KeyId, Key: key.Key})
+	}
+
+	return keys, nil
+}
+
+// ListEvents returns up to limit last security events of user, most recent first.
+// Session service limits amount of events if limit is 0 or too big.
+func (c *Client) ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]Event, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		log.ReqID: []string{log.ReqIDFromCtx(ctx)},
+	})
+
+	res, err := c.conn.ListEvents(ctx, &pb.ListEventsRequest{
+		UserId: &pb.UUID{Value: userID.String()},
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("c.conn.ListEvents: %w", err)
+	}
+
+	events := make([]Event, len(res.Events))
+	for i, event := range res.Events {
+		eventID, err := uuid.FromString(event.EventId.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("uuid.FromString: %w", err)
+		}
+
+		sessionID, err := uuid.FromString(event.SessionId.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("uuid.FromString: %w", err)
+		}
+
+		events[i] = Event{
+			ID:        eventID,
+			Kind:      EventKind(event.Kind),
+			SessionID: sessionID,
+			IP:        net.ParseIP(event.Ip),
+			UserAgent: event.UserAgent,
+			Country:   event.Country,
+			Reasons:   event.Reasons,
+			CreatedAt: event.CreatedAt.AsTime(),
+		}
+	}
+
+	return events, nil
 }
@@ -2,10 +2,12 @@ package client_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
@@ -14,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Meat-Hook/back-template/cmd/session/client"
 	"github.com/Meat-Hook/back-template/libs/log"
@@ -75,6 +78,8 @@ func TestClient_Session(t *testing.T) {
 		}
 		token             = `token`
 		notValidToken     = `notValidToken`
+		ip                = net.ParseIP("192.100.10.4")
+		userAgent         = "userAgent"
 		internalStatusErr = status.Error(codes.Internal, errAny.Error())
 	)
 
@@ -88,6 +93,9 @@ func TestClient_Session(t *testing.T) {
 	}{
 		{"success", token, &pb.SessionResponse{SessionId: &pb.UUID{Value: session.ID.String()}, UserId: &pb.UUID{Value: session.UserID.String()}}, nil, session, nil},
 		{"not_found", notValidToken, nil, status.Error(codes.NotFound, "not found"), nil, client.ErrNotFound},
+		{"expired", token, nil, status.Error(codes.Unauthenticated, "session expired"), nil, client.ErrExpired},
+		{"origin_mismatch", token, nil, status.Error(codes.PermissionDenied, "session used from other origin"), nil, client.ErrOriginMismatch},
+		{"invalid_token", notValidToken, nil, status.Error(codes.InvalidArgument, "not valid auth"), nil, client.ErrInvalidToken},
 		{"err_any", notValidToken, nil, internalStatusErr, nil, internalStatusErr},
 	}
 
@@ -96,10 +104,13 @@ func TestClient_Session(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			conn, mock, assert := start(t)
 
-			mock.EXPECT().Session(reqIDMatcher{expect: reqID.String()}, protoMatcher{value: &pb.SessionRequest{Token: tc.token}}).
-				Return(tc.appResponse, tc.appError)
+			mock.EXPECT().Session(reqIDMatcher{expect: reqID.String()}, protoMatcher{value: &pb.SessionRequest{
+				Token:     tc.token,
+				Ip:        ip.String(),
+				UserAgent: userAgent,
+			}}).Return(tc.appResponse, tc.appError)
 
-			res, err := conn.Session(ctx, tc.token)
+			res, err := conn.Session(ctx, tc.token, ip, userAgent)
 			assert.Equal(tc.want, res)
 			assert.ErrorIs(err, tc.wantErr)
 		})
@@ -146,17 +157,21 @@ func TestClient_NewSession(t *testing.T) {
 		userID            = uuid.Must(uuid.NewV4())
 		ip                = net.ParseIP("192.100.10.4")
 		userAgent         = "userAgent"
-		token             = "token"
 	)
 
 	testCases := []struct {
 		name        string
 		appResponse *pb.NewSessionResponse
 		appError    error
-		want        *client.Token
+		want        *client.TokenPair
 		wantErr     error
 	}{
-		{"success", &pb.NewSessionResponse{Token: token}, nil, &client.Token{Value: token}, nil},
+		{"success", &pb.NewSessionResponse{
+			Token:            tokens.Access.Value,
+			RefreshToken:     tokens.Refresh.Value,
+			ExpiresAt:        timestamppb.New(tokens.Access.ExpiresAt),
+			RefreshExpiresAt: timestamppb.New(tokens.Refresh.ExpiresAt),
+		}, nil, &tokens, nil},
 		{"err_any", nil, internalStatusErr, nil, status.Error(codes.Internal, errAny.Error())},
 	}
 
@@ -171,9 +186,238 @@ func TestClient_NewSession(t *testing.T) {
 				UserAgent: userAgent,
 			}}).Return(tc.appResponse, tc.appError)
 
-			token, err := conn.NewSession(ctx, userID, ip, userAgent)
+			res, err := conn.NewSession(ctx, userID, ip, userAgent)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestClient_Refresh(t *testing.T) {
+	t.Parallel()
+
+	var (
+		internalStatusErr = status.Error(codes.Internal, errAny.Error())
+		refreshToken      = "refreshToken"
+	)
+
+	testCases := []struct {
+		name        string
+		appResponse *pb.RefreshResponse
+		appError    error
+		want        *client.TokenPair
+		wantErr     error
+	}{
+		{"success", &pb.RefreshResponse{
+			Token:            tokens.Access.Value,
+			RefreshToken:     tokens.Refresh.Value,
+			ExpiresAt:        timestamppb.New(tokens.Access.ExpiresAt),
+			RefreshExpiresAt: timestamppb.New(tokens.Refresh.ExpiresAt),
+		}, nil, &tokens, nil},
+		{"err_not_found", nil, status.Error(codes.NotFound, "not found"), nil, client.ErrNotFound},
+		{"err_invalid_token", nil, status.Error(codes.InvalidArgument, "not valid auth"), nil, client.ErrInvalidToken},
+		{"err_expired", nil, status.Error(codes.Unauthenticated, "session expired"), nil, client.ErrExpired},
+		{"err_reused", nil, status.Error(codes.PermissionDenied, "refresh token reused"), nil, client.ErrReused},
+		{"err_any", nil, internalStatusErr, nil, internalStatusErr},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			conn, mock, assert := start(t)
+
+			mock.EXPECT().Refresh(reqIDMatcher{expect: reqID.String()}, protoMatcher{value: &pb.RefreshRequest{RefreshToken: refreshToken}}).
+				Return(tc.appResponse, tc.appError)
+
+			res, err := conn.Refresh(ctx, refreshToken)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestClient_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		internalStatusErr = status.Error(codes.Internal, errAny.Error())
+		userID            = uuid.Must(uuid.NewV4())
+		session           = client.SessionInfo{
+			ID:         uuid.Must(uuid.NewV4()),
+			IP:         net.ParseIP("192.100.10.4"),
+			UserAgent:  "userAgent",
+			CreatedAt:  time.Now().Add(-time.Hour).UTC(),
+			LastSeenAt: time.Now().UTC(),
+		}
+	)
+
+	testCases := []struct {
+		name        string
+		appResponse *pb.ListSessionsResponse
+		appError    error
+		want        []client.SessionInfo
+		wantErr     error
+	}{
+		{"success", &pb.ListSessionsResponse{Sessions: []*pb.SessionInfo{{
+			SessionId:  &pb.UUID{Value: session.ID.String()},
+			Ip:         session.IP.String(),
+			UserAgent:  session.UserAgent,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastSeenAt: timestamppb.New(session.LastSeenAt),
+		}}}, nil, []client.SessionInfo{session}, nil},
+		{"err_any", nil, internalStatusErr, nil, internalStatusErr},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			conn, mock, assert := start(t)
+
+			mock.EXPECT().ListSessions(reqIDMatcher{expect: reqID.String()}, protoMatcher{value: &pb.ListSessionsRequest{UserId: &pb.UUID{Value: userID.String()}}}).
+				Return(tc.appResponse, tc.appError)
+
+			res, err := conn.ListSessions(ctx, userID)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestClient_ListEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		internalStatusErr = status.Error(codes.Internal, errAny.Error())
+		userID            = uuid.Must(uuid.NewV4())
+		event             = client.Event{
+			ID:        uuid.Must(uuid.NewV4()),
+			Kind:      client.EventSuspicious,
+			SessionID: uuid.Must(uuid.NewV4()),
+			IP:        net.ParseIP("192.100.10.4"),
+			UserAgent: "userAgent",
+			Country:   "FR",
+			Reasons:   []string{"subnet"},
+			CreatedAt: time.Now().UTC(),
+		}
+	)
+
+	testCases := []struct {
+		name        string
+		appResponse *pb.ListEventsResponse
+		appError    error
+		want        []client.Event
+		wantErr     error
+	}{
+		{"success", &pb.ListEventsResponse{Events: []*pb.Event{{
+			EventId:   &pb.UUID{Value: event.ID.String()},
+			Kind:      string(event.Kind),
+			SessionId: &pb.UUID{Value: event.SessionID.String()},
+			Ip:        event.IP.String(),
+			UserAgent: event.UserAgent,
+			Country:   event.Country,
+			Reasons:   event.Reasons,
+			CreatedAt: timestamppb.New(event.CreatedAt),
+		}}}, nil, []client.Event{event}, nil},
+		{"err_any", nil, internalStatusErr, nil, internalStatusErr},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			conn, mock, assert := start(t)
+
+			mock.EXPECT().ListEvents(reqIDMatcher{expect: reqID.String()}, protoMatcher{value: &pb.ListEventsRequest{
+				UserId: &pb.UUID{Value: userID.String()},
+				Limit:  10,
+			}}).Return(tc.appResponse, tc.appError)
+
+			res, err := conn.ListEvents(ctx, userID, 10)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestClient_RemoveAllSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		internalStatusErr = status.Error(codes.Internal, errAny.Error())
+		userID            = uuid.Must(uuid.NewV4())
+		currentID         = uuid.Must(uuid.NewV4())
+	)
+
+	testCases := []struct {
+		name        string
+		except      uuid.UUID
+		req         *pb.RemoveAllSessionsRequest
+		appResponse *pb.RemoveAllSessionsResponse
+		appError    error
+		want        int
+		wantErr     error
+	}{
+		{"success", currentID, &pb.RemoveAllSessionsRequest{
+			UserId:          &pb.UUID{Value: userID.String()},
+			ExceptSessionId: &pb.UUID{Value: currentID.String()},
+		}, &pb.RemoveAllSessionsResponse{Removed: 2}, nil, 2, nil},
+		{"success_all", uuid.Nil, &pb.RemoveAllSessionsRequest{
+			UserId: &pb.UUID{Value: userID.String()},
+		}, &pb.RemoveAllSessionsResponse{Removed: 3}, nil, 3, nil},
+		{"err_any", uuid.Nil, &pb.RemoveAllSessionsRequest{
+			UserId: &pb.UUID{Value: userID.String()},
+		}, nil, internalStatusErr, 0, internalStatusErr},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			conn, mock, assert := start(t)
+
+			mock.EXPECT().RemoveAllSessions(reqIDMatcher{expect: reqID.String()}, protoMatcher{value: tc.req}).
+				Return(tc.appResponse, tc.appError)
+
+			res, err := conn.RemoveAllSessions(ctx, userID, tc.except)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestClient_PublicKeys(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key               = ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+		internalStatusErr = status.Error(codes.Internal, errAny.Error())
+	)
+
+	testCases := []struct {
+		name        string
+		appResponse *pb.PublicKeysResponse
+		appError    error
+		want        []client.PublicKey
+		wantErr     error
+	}{
+		{"success", &pb.PublicKeysResponse{Keys: []*pb.PublicKey{
+			{KeyId: "2026-10", Algorithm: "Ed25519", Key: key},
+			{KeyId: "other_algorithm", Algorithm: "RSA", Key: key},
+			{KeyId: "not_valid", Algorithm: "Ed25519", Key: key[:8]},
+		}}, nil, []client.PublicKey{{ID: "2026-10", Key: key}}, nil},
+		{"success_empty", &pb.PublicKeysResponse{}, nil, []client.PublicKey{}, nil},
+		{"err_any", nil, internalStatusErr, nil, internalStatusErr},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			conn, mock, assert := start(t)
+
+			mock.EXPECT().PublicKeys(reqIDMatcher{expect: reqID.String()}, protoMatcher{value: &pb.PublicKeysRequest{}}).
+				Return(tc.appResponse, tc.appError)
+
+			res, err := conn.PublicKeys(ctx)
+			assert.Equal(tc.want, res)
 			assert.ErrorIs(err, tc.wantErr)
-			assert.Equal(tc.want, token)
 		})
 	}
 }
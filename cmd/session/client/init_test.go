@@ -6,6 +6,7 @@ import (
 	"net"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/prometheus/client_golang/prometheus"
@@ -26,7 +27,11 @@ var (
 	reqID  = xid.New()
 	ctx    = log.ReqIDWithCtx(context.Background(), reqID.String())
 
-	errAny       = errors.New("any err")
+	errAny = errors.New("any err")
+	tokens = client.TokenPair{
+		Access:  client.Token{Value: "token", ExpiresAt: time.Now().Add(time.Minute).UTC()},
+		Refresh: client.Token{Value: "refreshToken", ExpiresAt: time.Now().Add(time.Hour).UTC()},
+	}
 	reg          = prometheus.NewPedanticRegistry()
 	clientMetric = rpc.NewClientMetrics(reg, "test")
 )
@@ -36,6 +36,46 @@ func (m *MockServiceClient) EXPECT() *MockServiceClientMockRecorder {
 	return m.recorder
 }
 
+// ListEvents mocks base method.
+func (m *MockServiceClient) ListEvents(ctx context.Context, in *pb.ListEventsRequest, opts ...grpc.CallOption) (*pb.ListEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEvents", varargs...)
+	ret0, _ := ret[0].(*pb.ListEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockServiceClientMockRecorder) ListEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockServiceClient)(nil).ListEvents), varargs...)
+}
+
+// ListSessions mocks base method.
+func (m *MockServiceClient) ListSessions(ctx context.Context, in *pb.ListSessionsRequest, opts ...grpc.CallOption) (*pb.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSessions", varargs...)
+	ret0, _ := ret[0].(*pb.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockServiceClientMockRecorder) ListSessions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockServiceClient)(nil).ListSessions), varargs...)
+}
+
 // NewSession mocks base method.
 func (m *MockServiceClient) NewSession(ctx context.Context, in *pb.NewSessionRequest, opts ...grpc.CallOption) (*pb.NewSessionResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +96,66 @@ func (mr *MockServiceClientMockRecorder) NewSession(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockServiceClient)(nil).NewSession), varargs...)
 }
 
+// PublicKeys mocks base method.
+func (m *MockServiceClient) PublicKeys(ctx context.Context, in *pb.PublicKeysRequest, opts ...grpc.CallOption) (*pb.PublicKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublicKeys", varargs...)
+	ret0, _ := ret[0].(*pb.PublicKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockServiceClientMockRecorder) PublicKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockServiceClient)(nil).PublicKeys), varargs...)
+}
+
+// Refresh mocks base method.
+func (m *MockServiceClient) Refresh(ctx context.Context, in *pb.RefreshRequest, opts ...grpc.CallOption) (*pb.RefreshResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Refresh", varargs...)
+	ret0, _ := ret[0].(*pb.RefreshResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockServiceClientMockRecorder) Refresh(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockServiceClient)(nil).Refresh), varargs...)
+}
+
+// RemoveAllSessions mocks base method.
+func (m *MockServiceClient) RemoveAllSessions(ctx context.Context, in *pb.RemoveAllSessionsRequest, opts ...grpc.CallOption) (*pb.RemoveAllSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveAllSessions", varargs...)
+	ret0, _ := ret[0].(*pb.RemoveAllSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAllSessions indicates an expected call of RemoveAllSessions.
+func (mr *MockServiceClientMockRecorder) RemoveAllSessions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllSessions", reflect.TypeOf((*MockServiceClient)(nil).RemoveAllSessions), varargs...)
+}
+
 // RemoveSession mocks base method.
 func (m *MockServiceClient) RemoveSession(ctx context.Context, in *pb.RemoveSessionRequest, opts ...grpc.CallOption) (*pb.RemoveSessionResponse, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +219,36 @@ func (m *MockServiceServer) EXPECT() *MockServiceServerMockRecorder {
 	return m.recorder
 }
 
+// ListEvents mocks base method.
+func (m *MockServiceServer) ListEvents(arg0 context.Context, arg1 *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ListEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockServiceServerMockRecorder) ListEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockServiceServer)(nil).ListEvents), arg0, arg1)
+}
+
+// ListSessions mocks base method.
+func (m *MockServiceServer) ListSessions(arg0 context.Context, arg1 *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockServiceServerMockRecorder) ListSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockServiceServer)(nil).ListSessions), arg0, arg1)
+}
+
 // NewSession mocks base method.
 func (m *MockServiceServer) NewSession(arg0 context.Context, arg1 *pb.NewSessionRequest) (*pb.NewSessionResponse, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +264,51 @@ func (mr *MockServiceServerMockRecorder) NewSession(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockServiceServer)(nil).NewSession), arg0, arg1)
 }
 
+// PublicKeys mocks base method.
+func (m *MockServiceServer) PublicKeys(arg0 context.Context, arg1 *pb.PublicKeysRequest) (*pb.PublicKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys", arg0, arg1)
+	ret0, _ := ret[0].(*pb.PublicKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockServiceServerMockRecorder) PublicKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockServiceServer)(nil).PublicKeys), arg0, arg1)
+}
+
+// Refresh mocks base method.
+func (m *MockServiceServer) Refresh(arg0 context.Context, arg1 *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", arg0, arg1)
+	ret0, _ := ret[0].(*pb.RefreshResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockServiceServerMockRecorder) Refresh(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockServiceServer)(nil).Refresh), arg0, arg1)
+}
+
+// RemoveAllSessions mocks base method.
+func (m *MockServiceServer) RemoveAllSessions(arg0 context.Context, arg1 *pb.RemoveAllSessionsRequest) (*pb.RemoveAllSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAllSessions", arg0, arg1)
+	ret0, _ := ret[0].(*pb.RemoveAllSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAllSessions indicates an expected call of RemoveAllSessions.
+func (mr *MockServiceServerMockRecorder) RemoveAllSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllSessions", reflect.TypeOf((*MockServiceServer)(nil).RemoveAllSessions), arg0, arg1)
+}
+
 // RemoveSession mocks base method.
 func (m *MockServiceServer) RemoveSession(arg0 context.Context, arg1 *pb.RemoveSessionRequest) (*pb.RemoveSessionResponse, error) {
 	m.ctrl.T.Helper()
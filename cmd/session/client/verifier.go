@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/o1egl/paseto/v2"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/Meat-Hook/back-template/cmd/session/token"
+)
+
+// minKeysFetchInterval min duration between fetching keys because of unknown key id,
+// so tokens with random key ids don't flood session service.
+const minKeysFetchInterval = 10 * time.Second
+
+// DefaultCheckInterval is default duration for which session checked by session service
+// is trusted by Verifier.
+const DefaultCheckInterval = 30 * time.Second
+
+// Verifier is Client which validates access tokens locally by public keys of session service.
+// Keys are cached and fetched again after ttl or when token is signed by unknown key.
+// Revocation and origin of session usage are checked by Client.Session once per
+// check interval for every session, so removed session is accepted at most check interval
+// after removal, use Client.Session where it matters.
+type Verifier struct {
+	*Client
+	ttl           time.Duration
+	checkInterval time.Duration
+	fetch         singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]ed25519.PublicKey
+	fetchedAt time.Time
+	// checked contains last time when session was checked by session service.
+	checked  map[uuid.UUID]time.Time
+	prunedAt time.Time
+}
+
+// NewVerifier build and returns new Verifier, keys are fetched by client every ttl,
+// sessions are checked by client every checkInterval.
+func NewVerifier(client *Client, ttl, checkInterval time.Duration) *Verifier {
+	return &Verifier{
+		Client:        client,
+		ttl:           ttl,
+		checkInterval: checkInterval,
+		checked:       make(map[uuid.UUID]time.Time),
+	}
+}
+
+// Session get user session by his access token like Client.Session.
+// Tokens which aren't public, e.g. issued before switching to public tokens,
+// are checked by Client.Session with origin.
+// Errors: ErrInvalidToken, ErrExpired, ErrNotFound, ErrOriginMismatch, unknown.
+func (v *Verifier) Session(ctx context.Context, value string, ip net.IP, userAgent string) (*Session, error) {
+	version, purpose, err := paseto.GetTokenInfo(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	if version != paseto.VersionV2 || purpose != paseto.PurposePublic {
+		return v.Client.Session(ctx, value, ip, userAgent)
+	}
+
+	keyID, err := token.KeyID(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	key, err := v.key(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("v.key: %w", err)
+	}
+
+	claims, err := token.Verify(value, key)
+	if err != nil {
+		return nil, fmt.Errorf("token.Verify: %w", err)
+	}
+
+	if claims.Kind != token.Access || claims.UserID == uuid.Nil {
+		return nil, fmt.Errorf("%w: not access token", ErrInvalidToken)
+	}
+
+	if !v.recentlyChecked(claims.SessionID) {
+		res, err := v.Client.Session(ctx, value, ip, userAgent)
+		if err != nil {
+			return nil, fmt.Errorf("v.Client.Session: %w", err)
+		}
+		v.setChecked(claims.SessionID)
+
+		return res, nil
+	}
+
+	return &Session{
+		ID:     claims.SessionID,
+		UserID: claims.UserID,
+	}, nil
+}
+
+// recentlyChecked returns true if session was checked by session service during check interval.
+func (v *Verifier) recentlyChecked(sessionID uuid.UUID) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	checkedAt, ok := v.checked[sessionID]
+
+	return ok && time.Since(checkedAt) < v.checkInterval
+}
+
+// setChecked saves time of session check, sessions checked before check interval
+// are removed once per interval, so only recently used sessions are kept.
+func (v *Verifier) setChecked(sessionID uuid.UUID) {
+	now := time.Now()
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if now.Sub(v.prunedAt) >= v.checkInterval {
+		for id, checkedAt := range v.checked {
+			if now.Sub(checkedAt) >= v.checkInterval {
+				delete(v.checked, id)
+			}
+		}
+		v.prunedAt = now
+	}
+
+	v.checked[sessionID] = now
+}
+
+// key returns cached key by id, keys are fetched if cache is stale or key is unknown.
+// Cached key is used if keys can't be fetched.
+func (v *Verifier) key(ctx context.Context, keyID string) (ed25519.PublicKey, error) {
+	v.mu.Lock()
+	key, ok := v.keys[keyID]
+	sinceFetch := time.Since(v.fetchedAt)
+	v.mu.Unlock()
+
+	switch {
+	case ok && sinceFetch < v.ttl:
+		return key, nil
+	case !ok && sinceFetch < minKeysFetchInterval:
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidToken, keyID)
+	}
+
+	// Concurrent requests wait for single fetch, lock isn't held during it.
+	_, err, _ := v.fetch.Do("", func() (interface{}, error) {
+		return nil, v.fetchKeys(ctx)
+	})
+	switch {
+	case err != nil && ok:
+		return key, nil
+	case err != nil:
+		return nil, err
+	}
+
+	v.mu.Lock()
+	key, ok = v.keys[keyID]
+	v.mu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidToken, keyID)
+	}
+
+	return key, nil
+}
+
+// fetchKeys replaces cached keys by keys of session service.
+func (v *Verifier) fetchKeys(ctx context.Context) error {
+	keys, err := v.Client.PublicKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("v.Client.PublicKeys: %w", err)
+	}
+
+	res := make(map[string]ed25519.PublicKey, len(keys))
+	for _, k := range keys {
+		res[k.ID] = k.Key
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys = res
+	v.fetchedAt = time.Now()
+
+	return nil
+}
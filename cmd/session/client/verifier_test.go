@@ -0,0 +1,196 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Meat-Hook/back-template/cmd/session/client"
+	"github.com/Meat-Hook/back-template/cmd/session/internal/auth"
+	"github.com/Meat-Hook/back-template/cmd/session/token"
+	pb "github.com/Meat-Hook/back-template/proto/gen/go/session/v1"
+)
+
+func TestVerifier_Session(t *testing.T) {
+	t.Parallel()
+
+	conn, mock, assert := start(t)
+	verifier := client.NewVerifier(conn, time.Hour, time.Hour)
+
+	var (
+		ip        = net.ParseIP("192.100.10.4")
+		userAgent = "userAgent"
+	)
+
+	ring := auth.KeyRing{ActiveKeyID: "2026-10", Keys: map[string]string{
+		"2026-10": "super-duper-secret-key-qwertyuio",
+		"2026-11": "other-super-duper-secret-key-qwe",
+	}}
+	public, err := auth.New(auth.PurposePublic, ring)
+	assert.NoError(err)
+	local, err := auth.New(auth.PurposeLocal, ring)
+	assert.NoError(err)
+
+	// Only current key is published.
+	keys := public.PublicKeys()
+	published := &pb.PublicKeysResponse{Keys: []*pb.PublicKey{
+		{KeyId: keys[0].ID, Algorithm: "Ed25519", Key: keys[0].Key},
+	}}
+
+	session := &client.Session{ID: uuid.Must(uuid.NewV4()), UserID: uuid.Must(uuid.NewV4())}
+	newToken := func(issuer *auth.Auth, subject token.Claims) string {
+		t.Helper()
+
+		res, err := issuer.Token(subject)
+		assert.NoError(err)
+
+		return res.Value
+	}
+	access := token.Claims{
+		SessionID: session.ID,
+		UserID:    session.UserID,
+		Kind:      token.Access,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+	refresh := access
+	refresh.Kind = token.Refresh
+	expired := access
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	revoked := access
+	revoked.SessionID = uuid.Must(uuid.NewV4())
+
+	publicToken := newToken(public, access)
+	localToken := newToken(local, access)
+	revokedToken := newToken(public, revoked)
+	sessionRequest := func(value string) protoMatcher {
+		return protoMatcher{value: &pb.SessionRequest{
+			Token:     value,
+			Ip:        ip.String(),
+			UserAgent: userAgent,
+		}}
+	}
+	sessionResponse := &pb.SessionResponse{
+		SessionId: &pb.UUID{Value: session.ID.String()},
+		UserId:    &pb.UUID{Value: session.UserID.String()},
+	}
+
+	mock.EXPECT().PublicKeys(gomock.Any(), gomock.Any()).Return(published, nil).Times(1)
+	// Revocation of public token is checked once during check interval.
+	mock.EXPECT().Session(gomock.Any(), sessionRequest(publicToken)).Return(sessionResponse, nil).Times(1)
+	mock.EXPECT().Session(gomock.Any(), sessionRequest(localToken)).Return(sessionResponse, nil)
+	mock.EXPECT().Session(gomock.Any(), sessionRequest(revokedToken)).Return(nil, status.Error(codes.NotFound, "not found"))
+
+	otherKey := ring
+	otherKey.ActiveKeyID = "2026-11"
+	publicOtherKey, err := auth.New(auth.PurposePublic, otherKey)
+	assert.NoError(err)
+
+	testCases := []struct {
+		name    string
+		token   string
+		want    *client.Session
+		wantErr error
+	}{
+		{"success", publicToken, session, nil},
+		{"success_cached", publicToken, session, nil},
+		{"success_local", localToken, session, nil},
+		{"err_revoked", revokedToken, nil, client.ErrNotFound},
+		{"err_refresh", newToken(public, refresh), nil, client.ErrInvalidToken},
+		{"err_expired", newToken(public, expired), nil, client.ErrExpired},
+		{"err_unknown_key", newToken(publicOtherKey, access), nil, client.ErrInvalidToken},
+		{"err_not_valid", "not valid", nil, client.ErrInvalidToken},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := verifier.Session(ctx, tc.token, ip, userAgent)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestVerifier_SessionErrFetch(t *testing.T) {
+	t.Parallel()
+
+	conn, mock, assert := start(t)
+	verifier := client.NewVerifier(conn, time.Hour, time.Hour)
+
+	public, err := auth.New(auth.PurposePublic, auth.KeyRing{ActiveKeyID: "2026-10", Keys: map[string]string{
+		"2026-10": "super-duper-secret-key-qwertyuio",
+	}})
+	assert.NoError(err)
+
+	value, err := public.Token(token.Claims{
+		SessionID: uuid.Must(uuid.NewV4()),
+		UserID:    uuid.Must(uuid.NewV4()),
+		Kind:      token.Access,
+		ExpiresAt: time.Now().Add(time.Minute),
+	})
+	assert.NoError(err)
+
+	mock.EXPECT().PublicKeys(gomock.Any(), gomock.Any()).Return(nil, errAny)
+
+	res, err := verifier.Session(ctx, value.Value, nil, "")
+	assert.Error(err)
+	assert.Nil(res)
+}
+
+func TestVerifier_SessionConcurrent(t *testing.T) {
+	t.Parallel()
+
+	conn, mock, assert := start(t)
+	// Every usage is checked by session service.
+	verifier := client.NewVerifier(conn, time.Hour, 0)
+
+	public, err := auth.New(auth.PurposePublic, auth.KeyRing{ActiveKeyID: "2026-10", Keys: map[string]string{
+		"2026-10": "super-duper-secret-key-qwertyuio",
+	}})
+	assert.NoError(err)
+
+	session := &client.Session{ID: uuid.Must(uuid.NewV4()), UserID: uuid.Must(uuid.NewV4())}
+	value, err := public.Token(token.Claims{
+		SessionID: session.ID,
+		UserID:    session.UserID,
+		Kind:      token.Access,
+		ExpiresAt: time.Now().Add(time.Minute),
+	})
+	assert.NoError(err)
+
+	keys := public.PublicKeys()
+	const workers = 10
+
+	// Concurrent requests share one fetch of keys.
+	mock.EXPECT().PublicKeys(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, *pb.PublicKeysRequest) (*pb.PublicKeysResponse, error) {
+		time.Sleep(100 * time.Millisecond)
+
+		return &pb.PublicKeysResponse{Keys: []*pb.PublicKey{
+			{KeyId: keys[0].ID, Algorithm: "Ed25519", Key: keys[0].Key},
+		}}, nil
+	}).Times(1)
+	mock.EXPECT().Session(gomock.Any(), gomock.Any()).Return(&pb.SessionResponse{
+		SessionId: &pb.UUID{Value: session.ID.String()},
+		UserId:    &pb.UUID{Value: session.UserID.String()},
+	}, nil).Times(workers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			res, err := verifier.Session(ctx, value.Value, nil, "")
+			assert.NoError(err)
+			assert.Equal(session, res)
+		}()
+	}
+	wg.Wait()
+}
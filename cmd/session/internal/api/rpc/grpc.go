@@ -17,9 +17,14 @@ import (
 // For convenient testing.
 // Wrapper for app.Module.
 type sessions interface {
-	Session(ctx context.Context, token string) (*app.Session, error)
-	NewSession(ctx context.Context, userID uuid.UUID, origin app.Origin) (*app.Token, error)
+	Session(ctx context.Context, token string, origin app.Origin) (*app.Session, error)
+	NewSession(ctx context.Context, userID uuid.UUID, origin app.Origin) (*app.TokenPair, error)
 	RemoveSession(ctx context.Context, sessionID uuid.UUID) error
+	Refresh(ctx context.Context, token string) (*app.TokenPair, error)
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]app.Session, error)
+	RemoveAllSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) (int, error)
+	PublicKeys() []app.PublicKey
+	ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]app.Event, error)
 }
 
 type api struct {
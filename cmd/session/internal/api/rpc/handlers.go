@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
 	pb "github.com/Meat-Hook/back-template/proto/gen/go/session/v1"
@@ -16,7 +17,10 @@ import (
 
 // Session implements pb.ServiceServer.
 func (a *api) Session(ctx context.Context, request *pb.SessionRequest) (*pb.SessionResponse, error) {
-	session, err := a.app.Session(ctx, request.Token)
+	session, err := a.app.Session(ctx, request.Token, app.Origin{
+		IP:        net.ParseIP(request.Ip),
+		UserAgent: request.UserAgent,
+	})
 	if err != nil {
 		return nil, apiError(err)
 	}
@@ -49,7 +53,7 @@ func (a *api) NewSession(ctx context.Context, request *pb.NewSessionRequest) (*p
 		return nil, apiError(err)
 	}
 
-	token, err := a.app.NewSession(ctx, userID, app.Origin{
+	tokens, err := a.app.NewSession(ctx, userID, app.Origin{
 		IP:        net.ParseIP(request.Ip),
 		UserAgent: request.UserAgent,
 	})
@@ -57,7 +61,155 @@ func (a *api) NewSession(ctx context.Context, request *pb.NewSessionRequest) (*p
 		return nil, apiError(err)
 	}
 
-	return &pb.NewSessionResponse{Token: token.Value}, nil
+	return &pb.NewSessionResponse{
+		Token:            tokens.Access.Value,
+		RefreshToken:     tokens.Refresh.Value,
+		ExpiresAt:        timestamppb.New(tokens.Access.ExpiresAt),
+		RefreshExpiresAt: timestamppb.New(tokens.Refresh.ExpiresAt),
+	}, nil
+}
+
+// Refresh implements pb.ServiceServer.
+func (a *api) Refresh(ctx context.Context, request *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	tokens, err := a.app.Refresh(ctx, request.RefreshToken)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.RefreshResponse{
+		Token:            tokens.Access.Value,
+		RefreshToken:     tokens.Refresh.Value,
+		ExpiresAt:        timestamppb.New(tokens.Access.ExpiresAt),
+		RefreshExpiresAt: timestamppb.New(tokens.Refresh.ExpiresAt),
+	}, nil
+}
+
+// ListSessions implements pb.ServiceServer.
+func (a *api) ListSessions(ctx context.Context, request *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	userID, err := uuid.FromString(request.UserId.GetValue())
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	sessions, err := a.app.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	res := make([]*pb.SessionInfo, len(sessions))
+	for i := range sessions {
+		res[i] = apiSessionInfo(sessions[i])
+	}
+
+	return &pb.ListSessionsResponse{Sessions: res}, nil
+}
+
+// RemoveAllSessions implements pb.ServiceServer.
+func (a *api) RemoveAllSessions(ctx context.Context, request *pb.RemoveAllSessionsRequest) (*pb.RemoveAllSessionsResponse, error) {
+	userID, err := uuid.FromString(request.UserId.GetValue())
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	exceptSessionID := uuid.Nil
+	if request.ExceptSessionId != nil {
+		exceptSessionID, err = uuid.FromString(request.ExceptSessionId.Value)
+		if err != nil {
+			return nil, apiError(err)
+		}
+	}
+
+	removed, err := a.app.RemoveAllSessions(ctx, userID, exceptSessionID)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return &pb.RemoveAllSessionsResponse{Removed: int32(removed)}, nil
+}
+
+// keyAlgorithm contains algorithm of public keys.
+const keyAlgorithm = "Ed25519"
+
+// PublicKeys implements pb.ServiceServer.
+func (a *api) PublicKeys(_ context.Context, _ *pb.PublicKeysRequest) (*pb.PublicKeysResponse, error) {
+	keys := a.app.PublicKeys()
+
+	res := &pb.PublicKeysResponse{Keys: make([]*pb.PublicKey, len(keys))}
+	for i := range keys {
+		res.Keys[i] = &pb.PublicKey{
+			KeyId:     keys[i].ID,
+			Algorithm: keyAlgorithm,
+			Key:       keys[i].Key,
+		}
+	}
+
+	return res, nil
+}
+
+// maxEventsLimit contains max amount of events returned at once, it's used if limit isn't set.
+const maxEventsLimit = 100
+
+// ListEvents implements pb.ServiceServer.
+func (a *api) ListEvents(ctx context.Context, request *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	userID, err := uuid.FromString(request.UserId.GetValue())
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	limit := int(request.Limit)
+	if limit <= 0 || limit > maxEventsLimit {
+		limit = maxEventsLimit
+	}
+
+	events, err := a.app.ListEvents(ctx, userID, limit)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	res := make([]*pb.Event, len(events))
+	for i := range events {
+		res[i] = apiEvent(events[i])
+	}
+
+	return &pb.ListEventsResponse{Events: res}, nil
+}
+
+func apiEvent(event app.Event) *pb.Event {
+	ip := ""
+	if event.Origin.IP != nil {
+		ip = event.Origin.IP.String()
+	}
+
+	reasons := make([]string, len(event.Reasons))
+	for i := range event.Reasons {
+		reasons[i] = string(event.Reasons[i])
+	}
+
+	return &pb.Event{
+		EventId:   &pb.UUID{Value: event.ID.String()},
+		Kind:      string(event.Kind),
+		SessionId: &pb.UUID{Value: event.SessionID.String()},
+		Ip:        ip,
+		UserAgent: event.Origin.UserAgent,
+		Country:   event.Country,
+		Reasons:   reasons,
+		CreatedAt: timestamppb.New(event.CreatedAt),
+	}
+}
+
+func apiSessionInfo(session app.Session) *pb.SessionInfo {
+	ip := ""
+	if session.Origin.IP != nil {
+		ip = session.Origin.IP.String()
+	}
+
+	return &pb.SessionInfo{
+		SessionId:  &pb.UUID{Value: session.ID.String()},
+		Ip:         ip,
+		UserAgent:  session.Origin.UserAgent,
+		CreatedAt:  timestamppb.New(session.CreatedAt),
+		LastSeenAt: timestamppb.New(session.UpdatedAt),
+	}
 }
 
 func apiError(err error) error {
@@ -71,6 +223,10 @@ func apiError(err error) error {
 		code = codes.NotFound
 	case errors.Is(err, app.ErrInvalidToken):
 		code = codes.InvalidArgument
+	case errors.Is(err, app.ErrExpired):
+		code = codes.Unauthenticated
+	case errors.Is(err, app.ErrReused), errors.Is(err, app.ErrOriginMismatch):
+		code = codes.PermissionDenied
 	case errors.Is(err, context.DeadlineExceeded):
 		code = codes.DeadlineExceeded
 	case errors.Is(err, context.Canceled):
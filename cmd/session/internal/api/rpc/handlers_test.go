@@ -2,6 +2,7 @@ package rpc_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"net"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
 	pb "github.com/Meat-Hook/back-template/proto/gen/go/session/v1"
@@ -24,6 +26,10 @@ var (
 		IP:        net.ParseIP("192.100.10.4"),
 		UserAgent: "UserAgent",
 	}
+	tokens = app.TokenPair{
+		Access:  app.Token{Value: "token", ExpiresAt: time.Now().Add(time.Minute)},
+		Refresh: app.Token{Value: "refreshToken", ExpiresAt: time.Now().Add(time.Hour)},
+	}
 )
 
 func TestApi_Session(t *testing.T) {
@@ -46,6 +52,8 @@ func TestApi_Session(t *testing.T) {
 	}
 
 	errNotFound := status.Error(codes.NotFound, app.ErrNotFound.Error())
+	errExpired := status.Error(codes.Unauthenticated, app.ErrExpired.Error())
+	errMismatch := status.Error(codes.PermissionDenied, app.ErrOriginMismatch.Error())
 	errDeadline := status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
 	errCanceled := status.Error(codes.Canceled, context.Canceled.Error())
 	errInternal := status.Error(codes.Internal, errAny.Error())
@@ -61,6 +69,8 @@ func TestApi_Session(t *testing.T) {
 	}{
 		{"success", &sessionInfo, &sessionResponse, nil, nil},
 		{"err_not_found", nil, nil, app.ErrNotFound, errNotFound},
+		{"err_expired", nil, nil, app.ErrExpired, errExpired},
+		{"err_origin_mismatch", nil, nil, app.ErrOriginMismatch, errMismatch},
 		{"err_deadline", nil, nil, context.DeadlineExceeded, errDeadline},
 		{"err_canceled", nil, nil, context.Canceled, errCanceled},
 		{"err_any", nil, nil, errAny, errInternal},
@@ -76,9 +86,13 @@ func TestApi_Session(t *testing.T) {
 
 			c, mockApp, assert := start(t, prometheus.NewPedanticRegistry())
 
-			mockApp.EXPECT().Session(gomock.Any(), token).Return(tc.session, tc.appErr)
+			mockApp.EXPECT().Session(gomock.Any(), token, origin).Return(tc.session, tc.appErr)
 
-			res, err := c.Session(ctx, &pb.SessionRequest{Token: token})
+			res, err := c.Session(ctx, &pb.SessionRequest{
+				Token:     token,
+				Ip:        origin.IP.String(),
+				UserAgent: origin.UserAgent,
+			})
 			assert.ErrorIs(err, tc.wantErr)
 			assert.True(proto.Equal(tc.want, res))
 		})
@@ -133,16 +147,19 @@ func TestApi_NewSession(t *testing.T) {
 	errCanceled := status.Error(codes.Canceled, context.Canceled.Error())
 	errInternal := status.Error(codes.Internal, errAny.Error())
 
-	const token = `token`
-
 	testCases := []struct {
-		name     string
-		appToken *app.Token
-		want     *pb.NewSessionResponse
-		appErr   error
-		wantErr  error
+		name      string
+		appTokens *app.TokenPair
+		want      *pb.NewSessionResponse
+		appErr    error
+		wantErr   error
 	}{
-		{"success", &app.Token{Value: token}, &pb.NewSessionResponse{Token: token}, nil, nil},
+		{"success", &tokens, &pb.NewSessionResponse{
+			Token:            tokens.Access.Value,
+			RefreshToken:     tokens.Refresh.Value,
+			ExpiresAt:        timestamppb.New(tokens.Access.ExpiresAt),
+			RefreshExpiresAt: timestamppb.New(tokens.Refresh.ExpiresAt),
+		}, nil, nil},
 		{"err_not_found", nil, nil, app.ErrNotFound, errNotFound},
 		{"err_deadline", nil, nil, context.DeadlineExceeded, errDeadline},
 		{"err_canceled", nil, nil, context.Canceled, errCanceled},
@@ -161,7 +178,7 @@ func TestApi_NewSession(t *testing.T) {
 
 			c, mockApp, assert := start(t, prometheus.NewPedanticRegistry())
 
-			mockApp.EXPECT().NewSession(gomock.Any(), userID, origin).Return(tc.appToken, tc.appErr)
+			mockApp.EXPECT().NewSession(gomock.Any(), userID, origin).Return(tc.appTokens, tc.appErr)
 
 			res, err := c.NewSession(ctx, &pb.NewSessionRequest{
 				UserId:    &pb.UUID{Value: userID.String()},
@@ -173,3 +190,265 @@ func TestApi_NewSession(t *testing.T) {
 		})
 	}
 }
+
+func TestApi_Refresh(t *testing.T) {
+	t.Parallel()
+
+	errInvalid := status.Error(codes.InvalidArgument, app.ErrInvalidToken.Error())
+	errExpired := status.Error(codes.Unauthenticated, app.ErrExpired.Error())
+	errReused := status.Error(codes.PermissionDenied, app.ErrReused.Error())
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	const refreshToken = `refreshToken`
+
+	testCases := []struct {
+		name      string
+		appTokens *app.TokenPair
+		want      *pb.RefreshResponse
+		appErr    error
+		wantErr   error
+	}{
+		{"success", &tokens, &pb.RefreshResponse{
+			Token:            tokens.Access.Value,
+			RefreshToken:     tokens.Refresh.Value,
+			ExpiresAt:        timestamppb.New(tokens.Access.ExpiresAt),
+			RefreshExpiresAt: timestamppb.New(tokens.Refresh.ExpiresAt),
+		}, nil, nil},
+		{"err_invalid_token", nil, nil, app.ErrInvalidToken, errInvalid},
+		{"err_expired", nil, nil, app.ErrExpired, errExpired},
+		{"err_reused", nil, nil, app.ErrReused, errReused},
+		{"err_any", nil, nil, errAny, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t, prometheus.NewPedanticRegistry())
+
+			mockApp.EXPECT().Refresh(gomock.Any(), refreshToken).Return(tc.appTokens, tc.appErr)
+
+			res, err := c.Refresh(ctx, &pb.RefreshRequest{RefreshToken: refreshToken})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
+
+func TestApi_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID  = uuid.Must(uuid.NewV4())
+		session = app.Session{
+			ID:        uuid.Must(uuid.NewV4()),
+			Origin:    origin,
+			UserID:    userID,
+			CreatedAt: time.Now().Add(-time.Hour),
+			UpdatedAt: time.Now(),
+		}
+		withoutIP = app.Session{
+			ID:        uuid.Must(uuid.NewV4()),
+			UserID:    userID,
+			CreatedAt: time.Now().Add(-time.Hour),
+			UpdatedAt: time.Now(),
+		}
+	)
+
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	testCases := []struct {
+		name        string
+		appSessions []app.Session
+		want        *pb.ListSessionsResponse
+		appErr      error
+		wantErr     error
+	}{
+		{"success", []app.Session{session, withoutIP}, &pb.ListSessionsResponse{Sessions: []*pb.SessionInfo{
+			{
+				SessionId:  &pb.UUID{Value: session.ID.String()},
+				Ip:         origin.IP.String(),
+				UserAgent:  origin.UserAgent,
+				CreatedAt:  timestamppb.New(session.CreatedAt),
+				LastSeenAt: timestamppb.New(session.UpdatedAt),
+			},
+			{
+				SessionId:  &pb.UUID{Value: withoutIP.ID.String()},
+				CreatedAt:  timestamppb.New(withoutIP.CreatedAt),
+				LastSeenAt: timestamppb.New(withoutIP.UpdatedAt),
+			},
+		}}, nil, nil},
+		{"err_any", nil, nil, errAny, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t, prometheus.NewPedanticRegistry())
+
+			mockApp.EXPECT().ListSessions(gomock.Any(), userID).Return(tc.appSessions, tc.appErr)
+
+			res, err := c.ListSessions(ctx, &pb.ListSessionsRequest{UserId: &pb.UUID{Value: userID.String()}})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
+
+func TestApi_RemoveAllSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = uuid.Must(uuid.NewV4())
+		currentID = uuid.Must(uuid.NewV4())
+	)
+
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	testCases := []struct {
+		name    string
+		except  *pb.UUID
+		appID   uuid.UUID
+		removed int
+		appErr  error
+		want    *pb.RemoveAllSessionsResponse
+		wantErr error
+	}{
+		{"success", &pb.UUID{Value: currentID.String()}, currentID, 2, nil, &pb.RemoveAllSessionsResponse{Removed: 2}, nil},
+		{"success_all", nil, uuid.Nil, 3, nil, &pb.RemoveAllSessionsResponse{Removed: 3}, nil},
+		{"err_any", nil, uuid.Nil, 0, errAny, nil, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t, prometheus.NewPedanticRegistry())
+
+			mockApp.EXPECT().RemoveAllSessions(gomock.Any(), userID, tc.appID).Return(tc.removed, tc.appErr)
+
+			res, err := c.RemoveAllSessions(ctx, &pb.RemoveAllSessionsRequest{
+				UserId:          &pb.UUID{Value: userID.String()},
+				ExceptSessionId: tc.except,
+			})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
+
+func TestApi_PublicKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	c, mockApp, assert := start(t, prometheus.NewPedanticRegistry())
+
+	key := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+	mockApp.EXPECT().PublicKeys().Return([]app.PublicKey{{ID: "2026-10", Key: key}})
+	mockApp.EXPECT().PublicKeys().Return(nil)
+
+	res, err := c.PublicKeys(ctx, &pb.PublicKeysRequest{})
+	assert.NoError(err)
+	assert.True(proto.Equal(&pb.PublicKeysResponse{Keys: []*pb.PublicKey{
+		{KeyId: "2026-10", Algorithm: "Ed25519", Key: key},
+	}}, res))
+
+	res, err = c.PublicKeys(ctx, &pb.PublicKeysRequest{})
+	assert.NoError(err)
+	assert.Empty(res.Keys)
+}
+
+func TestApi_ListEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = uuid.Must(uuid.NewV4())
+		event  = app.Event{
+			ID:        uuid.Must(uuid.NewV4()),
+			Kind:      app.EventSuspicious,
+			UserID:    userID,
+			SessionID: uuid.Must(uuid.NewV4()),
+			Origin:    origin,
+			Country:   "FR",
+			Reasons:   []app.Reason{app.ReasonSubnet},
+			CreatedAt: time.Now(),
+		}
+		withoutIP = app.Event{
+			ID:        uuid.Must(uuid.NewV4()),
+			Kind:      app.EventNewSignIn,
+			UserID:    userID,
+			SessionID: uuid.Must(uuid.NewV4()),
+			CreatedAt: time.Now(),
+		}
+	)
+
+	errInternal := status.Error(codes.Internal, errAny.Error())
+
+	testCases := []struct {
+		name      string
+		limit     int32
+		appLimit  int
+		appEvents []app.Event
+		appErr    error
+		want      *pb.ListEventsResponse
+		wantErr   error
+	}{
+		{"success", 10, 10, []app.Event{event, withoutIP}, nil, &pb.ListEventsResponse{Events: []*pb.Event{
+			{
+				EventId:   &pb.UUID{Value: event.ID.String()},
+				Kind:      "suspicious",
+				SessionId: &pb.UUID{Value: event.SessionID.String()},
+				Ip:        origin.IP.String(),
+				UserAgent: origin.UserAgent,
+				Country:   "FR",
+				Reasons:   []string{"subnet"},
+				CreatedAt: timestamppb.New(event.CreatedAt),
+			},
+			{
+				EventId:   &pb.UUID{Value: withoutIP.ID.String()},
+				Kind:      "new_sign_in",
+				SessionId: &pb.UUID{Value: withoutIP.SessionID.String()},
+				CreatedAt: timestamppb.New(withoutIP.CreatedAt),
+			},
+		}}, nil},
+		{"success_default_limit", 0, 100, nil, nil, &pb.ListEventsResponse{}, nil},
+		{"success_max_limit", 1000, 100, nil, nil, &pb.ListEventsResponse{}, nil},
+		{"err_any", 10, 10, nil, errAny, nil, errInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			c, mockApp, assert := start(t, prometheus.NewPedanticRegistry())
+
+			mockApp.EXPECT().ListEvents(gomock.Any(), userID, tc.appLimit).Return(tc.appEvents, tc.appErr)
+
+			res, err := c.ListEvents(ctx, &pb.ListEventsRequest{
+				UserId: &pb.UUID{Value: userID.String()},
+				Limit:  tc.limit,
+			})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.True(proto.Equal(tc.want, res))
+		})
+	}
+}
@@ -36,11 +36,41 @@ func (m *Mocksessions) EXPECT() *MocksessionsMockRecorder {
 	return m.recorder
 }
 
+// ListEvents mocks base method.
+func (m *Mocksessions) ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]app.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, userID, limit)
+	ret0, _ := ret[0].([]app.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MocksessionsMockRecorder) ListEvents(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*Mocksessions)(nil).ListEvents), ctx, userID, limit)
+}
+
+// ListSessions mocks base method.
+func (m *Mocksessions) ListSessions(ctx context.Context, userID uuid.UUID) ([]app.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]app.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MocksessionsMockRecorder) ListSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*Mocksessions)(nil).ListSessions), ctx, userID)
+}
+
 // NewSession mocks base method.
-func (m *Mocksessions) NewSession(ctx context.Context, userID uuid.UUID, origin app.Origin) (*app.Token, error) {
+func (m *Mocksessions) NewSession(ctx context.Context, userID uuid.UUID, origin app.Origin) (*app.TokenPair, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewSession", ctx, userID, origin)
-	ret0, _ := ret[0].(*app.Token)
+	ret0, _ := ret[0].(*app.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -51,6 +81,50 @@ func (mr *MocksessionsMockRecorder) NewSession(ctx, userID, origin interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*Mocksessions)(nil).NewSession), ctx, userID, origin)
 }
 
+// PublicKeys mocks base method.
+func (m *Mocksessions) PublicKeys() []app.PublicKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys")
+	ret0, _ := ret[0].([]app.PublicKey)
+	return ret0
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MocksessionsMockRecorder) PublicKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*Mocksessions)(nil).PublicKeys))
+}
+
+// Refresh mocks base method.
+func (m *Mocksessions) Refresh(ctx context.Context, token string) (*app.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, token)
+	ret0, _ := ret[0].(*app.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MocksessionsMockRecorder) Refresh(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*Mocksessions)(nil).Refresh), ctx, token)
+}
+
+// RemoveAllSessions mocks base method.
+func (m *Mocksessions) RemoveAllSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAllSessions", ctx, userID, exceptSessionID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAllSessions indicates an expected call of RemoveAllSessions.
+func (mr *MocksessionsMockRecorder) RemoveAllSessions(ctx, userID, exceptSessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllSessions", reflect.TypeOf((*Mocksessions)(nil).RemoveAllSessions), ctx, userID, exceptSessionID)
+}
+
 // RemoveSession mocks base method.
 func (m *Mocksessions) RemoveSession(ctx context.Context, sessionID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -66,16 +140,16 @@ func (mr *MocksessionsMockRecorder) RemoveSession(ctx, sessionID interface{}) *g
 }
 
 // Session mocks base method.
-func (m *Mocksessions) Session(ctx context.Context, token string) (*app.Session, error) {
+func (m *Mocksessions) Session(ctx context.Context, token string, origin app.Origin) (*app.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Session", ctx, token)
+	ret := m.ctrl.Call(m, "Session", ctx, token, origin)
 	ret0, _ := ret[0].(*app.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Session indicates an expected call of Session.
-func (mr *MocksessionsMockRecorder) Session(ctx, token interface{}) *gomock.Call {
+func (mr *MocksessionsMockRecorder) Session(ctx, token, origin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*Mocksessions)(nil).Session), ctx, token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*Mocksessions)(nil).Session), ctx, token, origin)
 }
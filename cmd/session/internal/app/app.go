@@ -1,17 +1,56 @@
 package app
 
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// touchInterval min duration between updates of session usage time,
+// so active session doesn't write to repository on every request.
+const touchInterval = time.Minute
+
+// eventInterval min duration between equal security events of session,
+// so requests rejected by origin policy don't write to repository on every request.
+const eventInterval = time.Minute
+
 // Module contains business logic for session methods.
 type Module struct {
-	session Repo
-	auth    Auth
-	id      ID
+	session  Repo
+	auth     Auth
+	id       ID
+	lifetime Lifetime
+	cache    Cache
+	geo      GeoIP
+	policy   Policy
+
+	mu sync.Mutex
+	// emitted contains time of last saved security events by session, kind and origin.
+	emitted  map[eventKey]time.Time
+	prunedAt time.Time
+}
+
+// eventKey identifies equal security events of session.
+type eventKey struct {
+	sessionID uuid.UUID
+	kind      EventKind
+	ip        string
+	userAgent string
 }
 
 // New build and returns new session module.
-func New(r Repo, a Auth, id ID) *Module {
+// Cache is optional, sessions are read from repository on every request if it's nil.
+// GeoIP is optional, countries aren't checked and recorded if it's nil.
+func New(r Repo, a Auth, id ID, lifetime Lifetime, c Cache, geo GeoIP, policy Policy) *Module {
 	return &Module{
-		session: r,
-		auth:    a,
-		id:      id,
+		session:  r,
+		auth:     a,
+		id:       id,
+		lifetime: lifetime,
+		cache:    c,
+		geo:      geo,
+		policy:   policy,
+		emitted:  make(map[eventKey]time.Time),
 	}
 }
@@ -0,0 +1,123 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+)
+
+func TestModule_SessionCached(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := startCached(t)
+
+	now := time.Now()
+	newSession := func(updatedAt time.Time) *app.Session {
+		return &app.Session{
+			ID:        uuid.Must(uuid.NewV4()),
+			UserID:    uuid.Must(uuid.NewV4()),
+			CreatedAt: now.Add(-time.Hour),
+			UpdatedAt: updatedAt,
+		}
+	}
+	access := func(s *app.Session) *app.Subject {
+		return &app.Subject{SessionID: s.ID, Kind: app.TokenAccess}
+	}
+
+	var (
+		cached   = newSession(now)
+		missed   = newSession(now)
+		touched  = newSession(now.Add(-2 * time.Minute))
+		errCache = newSession(now)
+	)
+
+	gomock.InOrder(
+		mocks.auth.EXPECT().Subject("cached").Return(access(cached), nil),
+		mocks.cache.EXPECT().Get(ctx, cached.ID).Return(cached, nil),
+		mocks.auth.EXPECT().Subject("missed").Return(access(missed), nil),
+		mocks.cache.EXPECT().Get(ctx, missed.ID).Return(nil, app.ErrNotFound),
+		mocks.repo.EXPECT().ByID(ctx, missed.ID).Return(missed, nil),
+		mocks.cache.EXPECT().Set(ctx, *missed).Return(nil),
+		mocks.auth.EXPECT().Subject("touched").Return(access(touched), nil),
+		mocks.cache.EXPECT().Get(ctx, touched.ID).Return(touched, nil),
+		mocks.repo.EXPECT().Touch(ctx, touched.ID, app.Origin{}).Return(nil),
+		mocks.cache.EXPECT().Set(ctx, gomock.Any()).Return(nil),
+		mocks.auth.EXPECT().Subject("errCache").Return(access(errCache), nil),
+		mocks.cache.EXPECT().Get(ctx, errCache.ID).Return(nil, errAny),
+	)
+
+	testCases := []struct {
+		name    string
+		token   string
+		want    *app.Session
+		wantErr error
+	}{
+		{"success_cached", "cached", cached, nil},
+		{"success_missed", "missed", missed, nil},
+		{"success_touched", "touched", touched, nil},
+		{"err_cache", "errCache", nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.Session(ctx, tc.token, app.Origin{})
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_RevokeCached(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := startCached(t)
+
+	var (
+		sessionID = uuid.Must(uuid.NewV4())
+		userID    = uuid.Must(uuid.NewV4())
+		removed   = []uuid.UUID{uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())}
+		refreshID = uuid.Must(uuid.NewV4())
+		session   = &app.Session{
+			ID:        sessionID,
+			RefreshID: refreshID,
+			UserID:    userID,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+	)
+
+	gomock.InOrder(
+		mocks.repo.EXPECT().Delete(ctx, sessionID).Return(nil),
+		mocks.cache.EXPECT().Revoke(ctx, sessionID).Return(nil),
+		mocks.repo.EXPECT().DeleteByUser(ctx, userID, sessionID).Return(removed, nil),
+		mocks.cache.EXPECT().Revoke(ctx, removed[0], removed[1]).Return(nil),
+		mocks.repo.EXPECT().DeleteByUser(ctx, userID, uuid.Nil).Return(nil, nil),
+		mocks.auth.EXPECT().Subject("refresh").Return(&app.Subject{SessionID: sessionID, Kind: app.TokenRefresh, TokenID: refreshID}, nil),
+		mocks.repo.EXPECT().ByID(ctx, sessionID).Return(session, nil),
+		mocks.auth.EXPECT().Token(gomock.Any()).Return(&app.Token{Value: "access"}, nil),
+		mocks.id.EXPECT().New().Return(uuid.Must(uuid.NewV4())),
+		mocks.auth.EXPECT().Token(gomock.Any()).Return(&app.Token{Value: "refresh"}, nil),
+		mocks.repo.EXPECT().Rotate(ctx, sessionID, refreshID, gomock.Any(), app.Token{Value: "access"}).Return(nil),
+		mocks.cache.EXPECT().Revoke(ctx, sessionID).Return(errAny),
+	)
+
+	err := module.RemoveSession(ctx, sessionID)
+	assert.NoError(err)
+
+	res, err := module.RemoveAllSessions(ctx, userID, sessionID)
+	assert.NoError(err)
+	assert.Equal(len(removed), res)
+
+	res, err = module.RemoveAllSessions(ctx, userID, uuid.Nil)
+	assert.NoError(err)
+	assert.Zero(res)
+
+	tokens, err := module.Refresh(ctx, "refresh")
+	assert.ErrorIs(err, errAny)
+	assert.Nil(tokens)
+}
@@ -2,6 +2,8 @@ package app
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"github.com/gofrs/uuid"
 )
@@ -18,16 +20,68 @@ type (
 		// Delete removes user session.
 		// Errors: unknown.
 		Delete(context.Context, uuid.UUID) error
+		// ListByUser returns all sessions of user ordered by last usage, most recent first.
+		// Errors: unknown.
+		ListByUser(ctx context.Context, userID uuid.UUID) ([]Session, error)
+		// DeleteByUser removes all sessions of user except the specified one
+		// and returns ids of removed sessions.
+		// Errors: unknown.
+		DeleteByUser(ctx context.Context, userID, exceptSessionID uuid.UUID) ([]uuid.UUID, error)
+		// DeleteExpired removes up to limit sessions created before createdBefore
+		// or last used before usedBefore and returns amount of removed sessions.
+		// Errors: unknown.
+		DeleteExpired(ctx context.Context, createdBefore, usedBefore time.Time, limit int) (int, error)
+		// Touch sets last usage time of user session to current time and its last origin.
+		// Errors: ErrNotFound, unknown.
+		Touch(ctx context.Context, sessionID uuid.UUID, origin Origin) error
+		// Rotate replaces refresh token id and access token of user session
+		// if session refresh token id still equals to old one, sets last usage time.
+		// Errors: ErrNotFound, unknown.
+		Rotate(ctx context.Context, sessionID, oldRefreshID, newRefreshID uuid.UUID, token Token) error
+		// SaveEvent saves security event, event id and creation time are set by repository.
+		// Errors: unknown.
+		SaveEvent(context.Context, Event) error
+		// DeleteEvents removes up to limit security events created before createdBefore
+		// and returns amount of removed events.
+		// Errors: unknown.
+		DeleteEvents(ctx context.Context, createdBefore time.Time, limit int) (int, error)
+		// ListEvents returns up to limit last security events of user, most recent first.
+		// Errors: unknown.
+		ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]Event, error)
+	}
+
+	// GeoIP interface for resolving country by IP.
+	GeoIP interface {
+		// Country returns ISO 3166-1 alpha-2 code of IP country, empty if unknown.
+		// Errors: unknown.
+		Country(net.IP) (string, error)
+	}
+
+	// Cache interface for keeping recently used sessions, so session isn't read
+	// from repository on every request.
+	Cache interface {
+		// Get returns cached session by session id.
+		// Errors: ErrNotFound, unknown.
+		Get(ctx context.Context, sessionID uuid.UUID) (*Session, error)
+		// Set saves session to cache.
+		// Errors: unknown.
+		Set(context.Context, Session) error
+		// Revoke removes sessions from caches of all service instances.
+		// Errors: unknown.
+		Revoke(ctx context.Context, sessionIDs ...uuid.UUID) error
 	}
 
 	// Auth interface for generate access and refresh token by subject.
 	Auth interface {
-		// Token generate tokens by subject with expire time.
+		// Token generate token by subject with expire time, token kind is kept in token.
 		// Errors: unknown.
 		Token(Subject) (*Token, error)
 		// Subject unwrap Subject info from token.
-		// Errors: ErrInvalidToken, ErrExpiredToken, unknown.
+		// Errors: ErrInvalidToken, ErrExpired, unknown.
 		Subject(token string) (*Subject, error)
+		// PublicKeys returns keys for verifying tokens outside service,
+		// empty if tokens are encrypted.
+		PublicKeys() []PublicKey
 	}
 
 	// ID generator for session.
@@ -1,21 +1,54 @@
 package app
 
 import (
+	"crypto/ed25519"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
+
+	"github.com/Meat-Hook/back-template/cmd/session/token"
 )
 
 type (
 	// Token contains auth token.
 	Token struct {
-		Value string
+		Value     string
+		ExpiresAt time.Time
+	}
+
+	// TokenPair contains tokens issued for session.
+	TokenPair struct {
+		// Access contains short-lived token for authorization.
+		Access Token
+		// Refresh contains long-lived token for getting new pair,
+		// every refresh token can be used once.
+		Refresh Token
 	}
 
+	// TokenKind contains purpose of token.
+	TokenKind = token.Kind
+
 	// Subject contains info to be saved in token.
-	Subject struct {
-		SessionID uuid.UUID
+	Subject = token.Claims
+
+	// PublicKey contains Ed25519 key for verifying public tokens.
+	PublicKey struct {
+		ID  string
+		Key ed25519.PublicKey
+	}
+
+	// Lifetime contains limits of session lifetime.
+	Lifetime struct {
+		// Idle contains max duration between session usages,
+		// every usage extends session.
+		Idle time.Duration
+		// Absolute contains max duration of session since creation,
+		// usages don't extend it.
+		Absolute time.Duration
+		// Access contains lifetime of access token.
+		Access time.Duration
 	}
 
 	// User contains user information.
@@ -33,11 +66,151 @@ type (
 
 	// Session contains session info for identify a user.
 	Session struct {
+		ID     uuid.UUID
+		Origin Origin
+		// Token contains last issued access token.
+		Token Token
+		// RefreshID contains id of the only valid refresh token,
+		// presenting other refresh token of session means it was stolen.
+		RefreshID uuid.UUID
+		UserID    uuid.UUID
+		// LastOrigin contains origin of last session usage.
+		LastOrigin Origin
+		CreatedAt  time.Time
+		// UpdatedAt contains time of last session usage.
+		UpdatedAt time.Time
+	}
+
+	// Action contains reaction to session usage from other origin.
+	Action string
+
+	// Policy contains checks of origin session is used from against origin session was created from.
+	// Origin isn't checked if it's empty, e.g. session is checked by other service on its own behalf.
+	Policy struct {
+		// BindUserAgent rejects session usage by other browser or client, e.g. Firefox instead of Chrome.
+		BindUserAgent bool
+		// Subnet contains action for usage from other IP subnet.
+		Subnet Action
+		// IPv4Prefix and IPv6Prefix contain sizes of subnet prefixes.
+		IPv4Prefix int
+		IPv6Prefix int
+		// Country contains action for usage from other country, requires GeoIP.
+		Country Action
+	}
+
+	// EventKind contains kind of security event.
+	EventKind string
+
+	// Event contains security event of user session,
+	// e.g. for notifying user about new sign-in.
+	Event struct {
 		ID        uuid.UUID
-		Origin    Origin
-		Token     Token
+		Kind      EventKind
 		UserID    uuid.UUID
+		SessionID uuid.UUID
+		Origin    Origin
+		// Country contains ISO 3166-1 alpha-2 code of origin country, empty if unknown.
+		Country string
+		// Reasons contains failed origin checks for suspicious and rejected usages.
+		Reasons   []Reason
 		CreatedAt time.Time
-		UpdatedAt time.Time
 	}
+
+	// Reason contains failed origin check.
+	Reason string
+)
+
+// Token kinds.
+const (
+	TokenAccess  = token.Access
+	TokenRefresh = token.Refresh
+)
+
+// Origin check actions.
+const (
+	// ActionNone skips check.
+	ActionNone Action = ""
+	// ActionFlag allows usage and emits EventSuspicious.
+	ActionFlag Action = "flag"
+	// ActionReject rejects usage with ErrOriginMismatch and emits EventRejected.
+	ActionReject Action = "reject"
 )
+
+// Event kinds.
+const (
+	EventNewSignIn  EventKind = "new_sign_in"
+	EventSuspicious EventKind = "suspicious"
+	EventRejected   EventKind = "rejected"
+)
+
+// Failed origin checks.
+const (
+	ReasonUserAgent Reason = "user_agent"
+	ReasonSubnet    Reason = "subnet"
+	ReasonCountry   Reason = "country"
+)
+
+// Empty returns true if origin isn't known.
+func (o Origin) Empty() bool {
+	return (o.IP == nil || o.IP.IsUnspecified()) && o.UserAgent == ""
+}
+
+// Equal returns true if origins have the same IP and user agent.
+func (o Origin) Equal(other Origin) bool {
+	return o.IP.Equal(other.IP) && o.UserAgent == other.UserAgent
+}
+
+// sameSubnet checks that both IPs are in subnet of the policy prefix size.
+// IPs of different versions are always in different subnets.
+func (p Policy) sameSubnet(a, b net.IP) bool {
+	a4, b4 := a.To4(), b.To4()
+	switch {
+	case a4 != nil && b4 != nil:
+		mask := net.CIDRMask(p.IPv4Prefix, 8*net.IPv4len)
+		return a4.Mask(mask).Equal(b4.Mask(mask))
+	case a4 == nil && b4 == nil:
+		mask := net.CIDRMask(p.IPv6Prefix, 8*net.IPv6len)
+		return a.Mask(mask).Equal(b.Mask(mask))
+	default:
+		return false
+	}
+}
+
+// Known user agent families, more specific tokens first,
+// e.g. Edge contains "Chrome/" and "Safari/" tokens too.
+var userAgentFamilies = []struct {
+	token  string
+	family string
+}{
+	{"Edg/", "Edge"},
+	{"OPR/", "Opera"},
+	{"YaBrowser/", "Yandex"},
+	{"SamsungBrowser/", "Samsung"},
+	{"Firefox/", "Firefox"},
+	{"FxiOS/", "Firefox"},
+	{"CriOS/", "Chrome"},
+	{"Chrome/", "Chrome"},
+	{"Safari/", "Safari"},
+}
+
+// userAgentFamily returns browser family of user agent, so browser updates don't change it.
+// Product name is returned for other clients, e.g. "curl" for "curl/7.68.0".
+func userAgentFamily(userAgent string) string {
+	for _, f := range userAgentFamilies {
+		if strings.Contains(userAgent, f.token) {
+			return f.family
+		}
+	}
+
+	product := strings.Fields(userAgent)
+	if len(product) == 0 {
+		return ""
+	}
+
+	return strings.SplitN(product[0], "/", 2)[0]
+}
+
+// expired checks session lifetime limits at the specified time.
+func (s Session) expired(lifetime Lifetime, now time.Time) bool {
+	return now.After(s.CreatedAt.Add(lifetime.Absolute)) || now.After(s.UpdatedAt.Add(lifetime.Idle))
+}
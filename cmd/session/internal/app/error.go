@@ -2,10 +2,15 @@ package app
 
 import (
 	"errors"
+
+	"github.com/Meat-Hook/back-template/cmd/session/token"
 )
 
 // Errors.
 var (
-	ErrNotFound     = errors.New("not found")
-	ErrInvalidToken = errors.New("not valid auth")
+	ErrNotFound       = errors.New("not found")
+	ErrInvalidToken   = token.ErrInvalid
+	ErrExpired        = token.ErrExpired
+	ErrReused         = errors.New("refresh token reused")
+	ErrOriginMismatch = errors.New("session used from other origin")
 )
@@ -2,7 +2,9 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -10,24 +12,65 @@ import (
 
 // RemoveSession remove user session.
 func (m *Module) RemoveSession(ctx context.Context, sessionID uuid.UUID) error {
-	return m.session.Delete(ctx, sessionID)
+	err := m.session.Delete(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("m.session.Delete: %w", err)
+	}
+
+	return m.revoke(ctx, sessionID)
+}
+
+// ListSessions returns active sessions of user, most recently used first.
+func (m *Module) ListSessions(ctx context.Context, userID uuid.UUID) ([]Session, error) {
+	sessions, err := m.session.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("m.session.ListByUser: %w", err)
+	}
+
+	now := time.Now()
+	active := make([]Session, 0, len(sessions))
+	for i := range sessions {
+		if !sessions[i].expired(m.lifetime, now) {
+			active = append(active, sessions[i])
+		}
+	}
+
+	return active, nil
 }
 
-// NewSession save new user session.
-func (m *Module) NewSession(ctx context.Context, userID uuid.UUID, origin Origin) (*Token, error) {
+// RemoveAllSessions removes all sessions of user except the specified one, e.g. current,
+// and returns amount of removed sessions. Nil exceptSessionID removes all sessions.
+func (m *Module) RemoveAllSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) (int, error) {
+	removed, err := m.session.DeleteByUser(ctx, userID, exceptSessionID)
+	if err != nil {
+		return 0, fmt.Errorf("m.session.DeleteByUser: %w", err)
+	}
+
+	err = m.revoke(ctx, removed...)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(removed), nil
+}
+
+// NewSession save new user session and returns its tokens.
+func (m *Module) NewSession(ctx context.Context, userID uuid.UUID, origin Origin) (*TokenPair, error) {
 	sessionID := m.id.New()
-	token, err := m.auth.Token(Subject{SessionID: sessionID})
+	tokens, refreshID, err := m.issue(sessionID, userID, time.Now())
 	if err != nil {
-		return nil, fmt.Errorf("m.auth.Token: %w", err)
+		return nil, fmt.Errorf("m.issue: %w", err)
 	}
 
 	session := Session{
-		ID:        sessionID,
-		Origin:    origin,
-		Token:     *token,
-		UserID:    userID,
-		CreatedAt: time.Time{}, // Will set in database.
-		UpdatedAt: time.Time{}, // Will set in database.
+		ID:         sessionID,
+		Origin:     origin,
+		Token:      tokens.Access,
+		RefreshID:  refreshID,
+		UserID:     userID,
+		LastOrigin: origin,
+		CreatedAt:  time.Time{}, // Will set in database.
+		UpdatedAt:  time.Time{}, // Will set in database.
 	}
 
 	err = m.session.Save(ctx, session)
@@ -35,20 +78,395 @@ func (m *Module) NewSession(ctx context.Context, userID uuid.UUID, origin Origin
 		return nil, fmt.Errorf("m.session.Save: %w", err)
 	}
 
-	return token, nil
+	err = m.emit(ctx, Event{
+		Kind:      EventNewSignIn,
+		UserID:    userID,
+		SessionID: sessionID,
+		Origin:    origin,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("m.emit: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// Session get user session by access token and extends session idle lifetime.
+// Returns ErrExpired if session wasn't used for idle lifetime or exceeded absolute lifetime.
+// Origin is checked by policy unless it's empty, ErrOriginMismatch is returned if usage is rejected.
+func (m *Module) Session(ctx context.Context, token string, origin Origin) (*Session, error) {
+	subject, err := m.auth.Subject(token)
+	if err != nil {
+		return nil, fmt.Errorf("m.auth.Subject: %w", err)
+	}
+
+	if subject.Kind != TokenAccess {
+		return nil, fmt.Errorf("%w: %s token", ErrInvalidToken, subject.Kind)
+	}
+
+	session, err := m.cached(ctx, subject.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("m.cached: %w", err)
+	}
+
+	// Tokens issued before expiration claims are limited by session timestamps only.
+	now := time.Now()
+	if session.expired(m.lifetime, now) {
+		return nil, ErrExpired
+	}
+
+	err = m.checkOrigin(ctx, *session, origin)
+	if err != nil {
+		return nil, fmt.Errorf("m.checkOrigin: %w", err)
+	}
+
+	// Changed origin is saved at once, so suspicious usage is reported once per origin.
+	originChanged := !origin.Empty() && !origin.Equal(session.LastOrigin)
+	if !originChanged && now.Sub(session.UpdatedAt) < touchInterval {
+		return session, nil
+	}
+
+	if originChanged {
+		session.LastOrigin = origin
+	}
+
+	err = m.session.Touch(ctx, session.ID, session.LastOrigin)
+	if err != nil {
+		return nil, fmt.Errorf("m.session.Touch: %w", err)
+	}
+	session.UpdatedAt = now
+
+	if m.cache != nil {
+		err = m.cache.Set(ctx, *session)
+		if err != nil {
+			return nil, fmt.Errorf("m.cache.Set: %w", err)
+		}
+	}
+
+	return session, nil
 }
 
-// Session get user session by access token.
-func (m *Module) Session(ctx context.Context, token string) (*Session, error) {
+// Refresh exchanges refresh token for new token pair, refresh token can be used once.
+// Reused refresh token is treated as stolen and whole session is removed with
+// all its tokens, ErrReused is returned.
+func (m *Module) Refresh(ctx context.Context, token string) (*TokenPair, error) {
 	subject, err := m.auth.Subject(token)
 	if err != nil {
 		return nil, fmt.Errorf("m.auth.Subject: %w", err)
 	}
 
+	if subject.Kind != TokenRefresh {
+		return nil, fmt.Errorf("%w: %s token", ErrInvalidToken, subject.Kind)
+	}
+
+	// Cached session may contain previous refresh token id, so it's read from repository.
 	session, err := m.session.ByID(ctx, subject.SessionID)
 	if err != nil {
 		return nil, fmt.Errorf("m.session.ByID: %w", err)
 	}
 
+	if session.RefreshID != subject.TokenID {
+		err = m.RemoveSession(ctx, session.ID)
+		if err != nil {
+			return nil, fmt.Errorf("m.RemoveSession: %w", err)
+		}
+
+		return nil, ErrReused
+	}
+
+	if session.expired(m.lifetime, time.Now()) {
+		return nil, ErrExpired
+	}
+
+	tokens, refreshID, err := m.issue(session.ID, session.UserID, session.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("m.issue: %w", err)
+	}
+
+	// Concurrent refresh by the same token loses here and gets ErrNotFound.
+	err = m.session.Rotate(ctx, session.ID, subject.TokenID, refreshID, tokens.Access)
+	if err != nil {
+		return nil, fmt.Errorf("m.session.Rotate: %w", err)
+	}
+
+	// Rotated session contains new access token and usage time.
+	err = m.revoke(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// DeleteExpired removes sessions past their lifetime in batches of batchSize
+// until expired sessions are left, and returns amount of removed sessions.
+// It's safe to run it by several service instances at once:
+// session removed by other instance is skipped.
+func (m *Module) DeleteExpired(ctx context.Context, batchSize int) (int, error) {
+	return deleteBatches(ctx, batchSize, func() (int, error) {
+		now := time.Now()
+		deleted, err := m.session.DeleteExpired(ctx, now.Add(-m.lifetime.Absolute), now.Add(-m.lifetime.Idle), batchSize)
+		if err != nil {
+			return 0, fmt.Errorf("m.session.DeleteExpired: %w", err)
+		}
+
+		return deleted, nil
+	})
+}
+
+// DeleteEvents removes security events older than retention in batches of batchSize
+// and returns amount of removed events, like DeleteExpired.
+func (m *Module) DeleteEvents(ctx context.Context, retention time.Duration, batchSize int) (int, error) {
+	return deleteBatches(ctx, batchSize, func() (int, error) {
+		deleted, err := m.session.DeleteEvents(ctx, time.Now().Add(-retention), batchSize)
+		if err != nil {
+			return 0, fmt.Errorf("m.session.DeleteEvents: %w", err)
+		}
+
+		return deleted, nil
+	})
+}
+
+// deleteBatches calls deleteBatch until it removes less than batchSize items
+// and returns total amount of removed items.
+func deleteBatches(ctx context.Context, batchSize int, deleteBatch func() (int, error)) (int, error) {
+	total := 0
+	for ctx.Err() == nil {
+		deleted, err := deleteBatch()
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+
+		if deleted < batchSize {
+			return total, nil
+		}
+	}
+
+	return total, ctx.Err()
+}
+
+// ListEvents returns up to limit last security events of user, most recent first.
+func (m *Module) ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]Event, error) {
+	return m.session.ListEvents(ctx, userID, limit)
+}
+
+// checkOrigin compares origin of session usage with origin of session creation by policy.
+// Suspicious usage is reported if origin differs from last one,
+// rejected usage returns ErrOriginMismatch every time.
+// Equal events are reported once per eventInterval.
+func (m *Module) checkOrigin(ctx context.Context, session Session, origin Origin) error {
+	if origin.Empty() || session.Origin.Empty() {
+		return nil
+	}
+
+	var flagged, rejected []Reason
+	check := func(action Action, reason Reason) {
+		switch action {
+		case ActionFlag:
+			flagged = append(flagged, reason)
+		case ActionReject:
+			rejected = append(rejected, reason)
+		}
+	}
+
+	if m.policy.BindUserAgent && session.Origin.UserAgent != "" &&
+		userAgentFamily(origin.UserAgent) != userAgentFamily(session.Origin.UserAgent) {
+		check(ActionReject, ReasonUserAgent)
+	}
+
+	if m.policy.Subnet != ActionNone && origin.IP != nil && session.Origin.IP != nil &&
+		!m.policy.sameSubnet(origin.IP, session.Origin.IP) {
+		check(m.policy.Subnet, ReasonSubnet)
+	}
+
+	if m.policy.Country != ActionNone {
+		country, err := m.country(origin.IP)
+		if err != nil {
+			return fmt.Errorf("m.country: %w", err)
+		}
+
+		sessionCountry, err := m.country(session.Origin.IP)
+		if err != nil {
+			return fmt.Errorf("m.country: %w", err)
+		}
+
+		// Unknown country isn't treated as other country.
+		if country != "" && sessionCountry != "" && country != sessionCountry {
+			check(m.policy.Country, ReasonCountry)
+		}
+	}
+
+	event := Event{
+		UserID:    session.UserID,
+		SessionID: session.ID,
+		Origin:    origin,
+	}
+	switch {
+	case len(rejected) > 0:
+		event.Kind, event.Reasons = EventRejected, append(rejected, flagged...)
+		err := m.emit(ctx, event)
+		if err != nil {
+			return fmt.Errorf("m.emit: %w", err)
+		}
+
+		return fmt.Errorf("%w: %v", ErrOriginMismatch, event.Reasons)
+	case len(flagged) > 0 && !origin.Equal(session.LastOrigin):
+		event.Kind, event.Reasons = EventSuspicious, flagged
+		err := m.emit(ctx, event)
+		if err != nil {
+			return fmt.Errorf("m.emit: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// emit saves security event with country of its origin.
+// Event is skipped if equal event was saved by this instance during eventInterval.
+func (m *Module) emit(ctx context.Context, event Event) error {
+	key := eventKey{
+		sessionID: event.SessionID,
+		kind:      event.Kind,
+		ip:        event.Origin.IP.String(),
+		userAgent: event.Origin.UserAgent,
+	}
+	if m.recentlyEmitted(key) {
+		return nil
+	}
+
+	country, err := m.country(event.Origin.IP)
+	if err != nil {
+		return fmt.Errorf("m.country: %w", err)
+	}
+	event.Country = country
+
+	err = m.session.SaveEvent(ctx, event)
+	if err != nil {
+		return fmt.Errorf("m.session.SaveEvent: %w", err)
+	}
+	m.setEmitted(key)
+
+	return nil
+}
+
+// recentlyEmitted returns true if event was saved during eventInterval.
+func (m *Module) recentlyEmitted(key eventKey) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	emittedAt, ok := m.emitted[key]
+
+	return ok && time.Since(emittedAt) < eventInterval
+}
+
+// setEmitted saves time of saving event, events saved before eventInterval
+// are removed once per interval, so only recent events are kept.
+func (m *Module) setEmitted(key eventKey) {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if now.Sub(m.prunedAt) >= eventInterval {
+		for k, emittedAt := range m.emitted {
+			if now.Sub(emittedAt) >= eventInterval {
+				delete(m.emitted, k)
+			}
+		}
+		m.prunedAt = now
+	}
+
+	m.emitted[key] = now
+}
+
+// country returns country of IP, empty if IP or GeoIP isn't set.
+func (m *Module) country(ip net.IP) (string, error) {
+	if m.geo == nil || ip == nil || ip.IsUnspecified() {
+		return "", nil
+	}
+
+	return m.geo.Country(ip)
+}
+
+// cached returns session from cache if it's set, otherwise from repository.
+// Session read from repository is saved to cache.
+func (m *Module) cached(ctx context.Context, sessionID uuid.UUID) (*Session, error) {
+	if m.cache == nil {
+		return m.session.ByID(ctx, sessionID)
+	}
+
+	session, err := m.cache.Get(ctx, sessionID)
+	switch {
+	case err == nil:
+		return session, nil
+	case !errors.Is(err, ErrNotFound):
+		return nil, fmt.Errorf("m.cache.Get: %w", err)
+	}
+
+	session, err = m.session.ByID(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("m.session.ByID: %w", err)
+	}
+
+	err = m.cache.Set(ctx, *session)
+	if err != nil {
+		return nil, fmt.Errorf("m.cache.Set: %w", err)
+	}
+
 	return session, nil
 }
+
+// revoke removes sessions from cache if it's set.
+func (m *Module) revoke(ctx context.Context, sessionIDs ...uuid.UUID) error {
+	if m.cache == nil || len(sessionIDs) == 0 {
+		return nil
+	}
+
+	err := m.cache.Revoke(ctx, sessionIDs...)
+	if err != nil {
+		return fmt.Errorf("m.cache.Revoke: %w", err)
+	}
+
+	return nil
+}
+
+// issue generates token pair for session created at the specified time
+// and returns it with id of new refresh token.
+// Access token doesn't outlive session absolute lifetime.
+func (m *Module) issue(sessionID, userID uuid.UUID, createdAt time.Time) (*TokenPair, uuid.UUID, error) {
+	sessionExpiresAt := createdAt.Add(m.lifetime.Absolute)
+	accessExpiresAt := time.Now().Add(m.lifetime.Access)
+	if accessExpiresAt.After(sessionExpiresAt) {
+		accessExpiresAt = sessionExpiresAt
+	}
+
+	access, err := m.auth.Token(Subject{
+		SessionID: sessionID,
+		UserID:    userID,
+		Kind:      TokenAccess,
+		ExpiresAt: accessExpiresAt,
+	})
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("m.auth.Token: %w", err)
+	}
+
+	refreshID := m.id.New()
+	refresh, err := m.auth.Token(Subject{
+		SessionID: sessionID,
+		UserID:    userID,
+		Kind:      TokenRefresh,
+		TokenID:   refreshID,
+		ExpiresAt: sessionExpiresAt,
+	})
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("m.auth.Token: %w", err)
+	}
+
+	return &TokenPair{Access: *access, Refresh: *refresh}, refreshID, nil
+}
+
+// PublicKeys returns keys for verifying tokens without calling service.
+func (m *Module) PublicKeys() []PublicKey {
+	return m.auth.PublicKeys()
+}
@@ -1,10 +1,13 @@
 package app_test
 
 import (
+	"context"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
 
 	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
 )
@@ -15,8 +18,10 @@ func TestModule_NewSession(t *testing.T) {
 	module, mocks, assert := start(t)
 
 	var (
-		id  = uuid.Must(uuid.NewV4())
-		id2 = uuid.Must(uuid.NewV4())
+		id         = uuid.Must(uuid.NewV4())
+		refreshID  = uuid.Must(uuid.NewV4())
+		id2        = uuid.Must(uuid.NewV4())
+		refreshID2 = uuid.Must(uuid.NewV4())
 
 		origin = app.Origin{
 			IP:        net.ParseIP("192.100.10.4"),
@@ -24,48 +29,77 @@ func TestModule_NewSession(t *testing.T) {
 		}
 		userID1 = uuid.Must(uuid.NewV4())
 		userID2 = uuid.Must(uuid.NewV4())
-		token   = app.Token{
-			Value: "token",
+		tokens  = app.TokenPair{
+			Access:  app.Token{Value: "token"},
+			Refresh: app.Token{Value: "refresh"},
 		}
-		token2 = app.Token{
-			Value: "token2",
+		tokens2 = app.TokenPair{
+			Access:  app.Token{Value: "token2"},
+			Refresh: app.Token{Value: "refresh2"},
 		}
 		session = app.Session{
-			ID:     id,
-			Origin: origin,
-			Token:  token,
-			UserID: userID1,
+			ID:         id,
+			Origin:     origin,
+			Token:      tokens.Access,
+			RefreshID:  refreshID,
+			UserID:     userID1,
+			LastOrigin: origin,
 		}
 		errSaveSession = app.Session{
-			ID:     id2,
-			Origin: origin,
-			Token:  token2,
-			UserID: userID2,
+			ID:         id2,
+			Origin:     origin,
+			Token:      tokens2.Access,
+			RefreshID:  refreshID2,
+			UserID:     userID2,
+			LastOrigin: origin,
 		}
 	)
 
-	mocks.id.EXPECT().New().Return(id)
-	mocks.id.EXPECT().New().Return(id2)
-	mocks.auth.EXPECT().Token(app.Subject{SessionID: id}).Return(&token, nil)
-	mocks.auth.EXPECT().Token(app.Subject{SessionID: id2}).Return(&token2, nil)
+	issue := func(sessionID, userID, refreshID uuid.UUID, tokens app.TokenPair) {
+		now := time.Now()
+		mocks.id.EXPECT().New().Return(sessionID)
+		mocks.auth.EXPECT().Token(subjectMatcher{app.Subject{
+			SessionID: sessionID,
+			UserID:    userID,
+			Kind:      app.TokenAccess,
+			ExpiresAt: now.Add(lifetime.Access),
+		}}).Return(&tokens.Access, nil)
+		mocks.id.EXPECT().New().Return(refreshID)
+		mocks.auth.EXPECT().Token(subjectMatcher{app.Subject{
+			SessionID: sessionID,
+			UserID:    userID,
+			Kind:      app.TokenRefresh,
+			TokenID:   refreshID,
+			ExpiresAt: now.Add(lifetime.Absolute),
+		}}).Return(&tokens.Refresh, nil)
+	}
+
+	issue(id, userID1, refreshID, tokens)
 	mocks.repo.EXPECT().Save(ctx, session).Return(nil)
+	mocks.repo.EXPECT().SaveEvent(ctx, app.Event{
+		Kind:      app.EventNewSignIn,
+		UserID:    userID1,
+		SessionID: id,
+		Origin:    origin,
+	}).Return(nil)
+	issue(id2, userID2, refreshID2, tokens2)
 	mocks.repo.EXPECT().Save(ctx, errSaveSession).Return(errAny)
 
 	testCases := []struct {
 		name    string
 		userID  uuid.UUID
-		want    *app.Token
+		want    *app.TokenPair
 		wantErr error
 	}{
-		{"success", userID1, &token, nil},
+		{"success", userID1, &tokens, nil},
 		{"err_any", userID2, nil, errAny},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			resToken, err := module.NewSession(ctx, tc.userID, origin)
-			assert.Equal(tc.want, resToken)
+			res, err := module.NewSession(ctx, tc.userID, origin)
+			assert.Equal(tc.want, res)
 			assert.ErrorIs(err, tc.wantErr)
 		})
 	}
@@ -101,11 +135,10 @@ func TestModule_Session(t *testing.T) {
 
 	module, mocks, assert := start(t)
 
-	var (
-		token          = "token"
-		successSubject = app.Subject{SessionID: uuid.Must(uuid.NewV4())}
-		session        = app.Session{
-			ID: successSubject.SessionID,
+	now := time.Now()
+	newSession := func(token string, createdAt, updatedAt time.Time) app.Session {
+		return app.Session{
+			ID: uuid.Must(uuid.NewV4()),
 			Origin: app.Origin{
 				IP:        net.ParseIP("192.100.10.4"),
 				UserAgent: "UserAgent",
@@ -113,20 +146,61 @@ func TestModule_Session(t *testing.T) {
 			Token: app.Token{
 				Value: token,
 			},
-			UserID: uuid.Must(uuid.NewV4()),
+			UserID:    uuid.Must(uuid.NewV4()),
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
 		}
+	}
+
+	var (
+		token          = "token"
+		session        = newSession(token, now.Add(-2*lifetime.Idle), now.Add(-lifetime.Idle/2))
+		successSubject = app.Subject{SessionID: session.ID, Kind: app.TokenAccess}
+
+		recentToken   = "recentToken"
+		recentSession = newSession(recentToken, now.Add(-lifetime.Idle), now)
+
+		idleToken   = "idleToken"
+		idleSession = newSession(idleToken, now.Add(-2*lifetime.Idle), now.Add(-2*lifetime.Idle))
+
+		oldToken   = "oldToken"
+		oldSession = newSession(oldToken, now.Add(-2*lifetime.Absolute), now)
 
 		tokenNotFound           = "tokenNotFound"
-		subjectForNotFoundToken = app.Subject{SessionID: uuid.Must(uuid.NewV4())}
+		subjectForNotFoundToken = app.Subject{SessionID: uuid.Must(uuid.NewV4()), Kind: app.TokenAccess}
 
 		notValidToken = "notValidToken"
+		expiredToken  = "expiredToken"
+		refreshToken  = "refreshToken"
+
+		touchToken   = "touchToken"
+		touchSession = newSession(touchToken, now.Add(-2*lifetime.Idle), now.Add(-lifetime.Idle/2))
 	)
 
+	copySession := func(s app.Session) *app.Session {
+		return &s
+	}
+	access := func(s app.Session) *app.Subject {
+		return &app.Subject{SessionID: s.ID, Kind: app.TokenAccess}
+	}
+
 	mocks.auth.EXPECT().Subject(token).Return(&successSubject, nil)
+	mocks.auth.EXPECT().Subject(recentToken).Return(access(recentSession), nil)
+	mocks.auth.EXPECT().Subject(idleToken).Return(access(idleSession), nil)
+	mocks.auth.EXPECT().Subject(oldToken).Return(access(oldSession), nil)
 	mocks.auth.EXPECT().Subject(tokenNotFound).Return(&subjectForNotFoundToken, nil)
 	mocks.auth.EXPECT().Subject(notValidToken).Return(nil, app.ErrInvalidToken)
-	mocks.repo.EXPECT().ByID(ctx, successSubject.SessionID).Return(&session, nil)
+	mocks.auth.EXPECT().Subject(expiredToken).Return(nil, app.ErrExpired)
+	mocks.auth.EXPECT().Subject(refreshToken).Return(&app.Subject{SessionID: session.ID, Kind: app.TokenRefresh}, nil)
+	mocks.auth.EXPECT().Subject(touchToken).Return(access(touchSession), nil)
+	mocks.repo.EXPECT().ByID(ctx, session.ID).Return(copySession(session), nil)
+	mocks.repo.EXPECT().ByID(ctx, recentSession.ID).Return(copySession(recentSession), nil)
+	mocks.repo.EXPECT().ByID(ctx, idleSession.ID).Return(copySession(idleSession), nil)
+	mocks.repo.EXPECT().ByID(ctx, oldSession.ID).Return(copySession(oldSession), nil)
 	mocks.repo.EXPECT().ByID(ctx, subjectForNotFoundToken.SessionID).Return(nil, app.ErrNotFound)
+	mocks.repo.EXPECT().ByID(ctx, touchSession.ID).Return(copySession(touchSession), nil)
+	mocks.repo.EXPECT().Touch(ctx, session.ID, app.Origin{}).Return(nil)
+	mocks.repo.EXPECT().Touch(ctx, touchSession.ID, app.Origin{}).Return(errAny)
 
 	testCases := []struct {
 		name    string
@@ -135,16 +209,314 @@ func TestModule_Session(t *testing.T) {
 		wantErr error
 	}{
 		{"success", token, &session, nil},
+		{"success_recently_used", recentToken, &recentSession, nil},
+		{"err_idle", idleToken, nil, app.ErrExpired},
+		{"err_absolute", oldToken, nil, app.ErrExpired},
 		{"err_not_found", tokenNotFound, nil, app.ErrNotFound},
 		{"err_invalid_token", notValidToken, nil, app.ErrInvalidToken},
+		{"err_expired_token", expiredToken, nil, app.ErrExpired},
+		{"err_refresh_token", refreshToken, nil, app.ErrInvalidToken},
+		{"err_touch", touchToken, nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.Session(ctx, tc.token, app.Origin{})
+			assert.ErrorIs(err, tc.wantErr)
+			if tc.want == nil {
+				assert.Nil(res)
+
+				return
+			}
+
+			assert.WithinDuration(time.Now(), res.UpdatedAt, time.Minute)
+			res.UpdatedAt = tc.want.UpdatedAt
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_Refresh(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := start(t)
+
+	var (
+		now       = time.Now()
+		refreshID = uuid.Must(uuid.NewV4())
+		newID     = uuid.Must(uuid.NewV4())
+		session   = app.Session{
+			ID:        uuid.Must(uuid.NewV4()),
+			Token:     app.Token{Value: "token"},
+			RefreshID: refreshID,
+			UserID:    uuid.Must(uuid.NewV4()),
+			CreatedAt: now.Add(-lifetime.Absolute + lifetime.Access/2),
+			UpdatedAt: now.Add(-lifetime.Idle / 2),
+		}
+		subject = app.Subject{
+			SessionID: session.ID,
+			Kind:      app.TokenRefresh,
+			TokenID:   refreshID,
+		}
+		reusedSubject = app.Subject{
+			SessionID: session.ID,
+			Kind:      app.TokenRefresh,
+			TokenID:   uuid.Must(uuid.NewV4()),
+		}
+		idleSession = app.Session{
+			ID:        session.ID,
+			RefreshID: refreshID,
+			CreatedAt: now.Add(-2 * lifetime.Idle),
+			UpdatedAt: now.Add(-2 * lifetime.Idle),
+		}
+		tokens = app.TokenPair{
+			Access:  app.Token{Value: "newToken"},
+			Refresh: app.Token{Value: "newRefresh"},
+		}
+	)
+
+	// Both tokens expire with session because it's close to absolute lifetime.
+	sessionExpiresAt := session.CreatedAt.Add(lifetime.Absolute)
+	gomock.InOrder(
+		mocks.auth.EXPECT().Subject("refresh").Return(&subject, nil),
+		mocks.repo.EXPECT().ByID(ctx, session.ID).Return(&session, nil),
+		mocks.auth.EXPECT().Token(subjectMatcher{app.Subject{
+			SessionID: session.ID,
+			UserID:    session.UserID,
+			Kind:      app.TokenAccess,
+			ExpiresAt: sessionExpiresAt,
+		}}).Return(&tokens.Access, nil),
+		mocks.id.EXPECT().New().Return(newID),
+		mocks.auth.EXPECT().Token(subjectMatcher{app.Subject{
+			SessionID: session.ID,
+			UserID:    session.UserID,
+			Kind:      app.TokenRefresh,
+			TokenID:   newID,
+			ExpiresAt: sessionExpiresAt,
+		}}).Return(&tokens.Refresh, nil),
+		mocks.repo.EXPECT().Rotate(ctx, session.ID, refreshID, newID, tokens.Access).Return(nil),
+		mocks.auth.EXPECT().Subject("access").Return(&app.Subject{SessionID: session.ID, Kind: app.TokenAccess}, nil),
+		mocks.auth.EXPECT().Subject("reused").Return(&reusedSubject, nil),
+		mocks.repo.EXPECT().ByID(ctx, session.ID).Return(&session, nil),
+		mocks.repo.EXPECT().Delete(ctx, session.ID).Return(nil),
+		mocks.auth.EXPECT().Subject("idle").Return(&subject, nil),
+		mocks.repo.EXPECT().ByID(ctx, session.ID).Return(&idleSession, nil),
+		mocks.auth.EXPECT().Subject("concurrent").Return(&subject, nil),
+		mocks.repo.EXPECT().ByID(ctx, session.ID).Return(&session, nil),
+		mocks.auth.EXPECT().Token(gomock.Any()).Return(&tokens.Access, nil),
+		mocks.id.EXPECT().New().Return(newID),
+		mocks.auth.EXPECT().Token(gomock.Any()).Return(&tokens.Refresh, nil),
+		mocks.repo.EXPECT().Rotate(ctx, session.ID, refreshID, newID, tokens.Access).Return(app.ErrNotFound),
+		mocks.auth.EXPECT().Subject("expired").Return(nil, app.ErrExpired),
+	)
+
+	testCases := []struct {
+		name    string
+		token   string
+		want    *app.TokenPair
+		wantErr error
+	}{
+		{"success", "refresh", &tokens, nil},
+		{"err_access_token", "access", nil, app.ErrInvalidToken},
+		{"err_reused", "reused", nil, app.ErrReused},
+		{"err_idle", "idle", nil, app.ErrExpired},
+		{"err_concurrent", "concurrent", nil, app.ErrNotFound},
+		{"err_expired_token", "expired", nil, app.ErrExpired},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			res, err := module.Session(ctx, tc.token)
+			res, err := module.Refresh(ctx, tc.token)
 			assert.Equal(tc.want, res)
 			assert.ErrorIs(err, tc.wantErr)
 		})
 	}
 }
+
+func TestModule_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := start(t)
+
+	var (
+		now    = time.Now()
+		userID = uuid.Must(uuid.NewV4())
+		active = app.Session{
+			ID:        uuid.Must(uuid.NewV4()),
+			UserID:    userID,
+			CreatedAt: now.Add(-lifetime.Idle),
+			UpdatedAt: now,
+		}
+		idle = app.Session{
+			ID:        uuid.Must(uuid.NewV4()),
+			UserID:    userID,
+			CreatedAt: now.Add(-2 * lifetime.Idle),
+			UpdatedAt: now.Add(-2 * lifetime.Idle),
+		}
+		old = app.Session{
+			ID:        uuid.Must(uuid.NewV4()),
+			UserID:    userID,
+			CreatedAt: now.Add(-2 * lifetime.Absolute),
+			UpdatedAt: now,
+		}
+	)
+
+	gomock.InOrder(
+		mocks.repo.EXPECT().ListByUser(ctx, userID).Return([]app.Session{active, idle, old}, nil),
+		mocks.repo.EXPECT().ListByUser(ctx, userID).Return(nil, errAny),
+	)
+
+	testCases := []struct {
+		name    string
+		want    []app.Session
+		wantErr error
+	}{
+		{"success", []app.Session{active}, nil},
+		{"err_any", nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.ListSessions(ctx, userID)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_RemoveAllSessions(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := start(t)
+
+	var (
+		userID    = uuid.Must(uuid.NewV4())
+		currentID = uuid.Must(uuid.NewV4())
+	)
+
+	gomock.InOrder(
+		mocks.repo.EXPECT().DeleteByUser(ctx, userID, currentID).Return(make([]uuid.UUID, 3), nil),
+		mocks.repo.EXPECT().DeleteByUser(ctx, userID, uuid.Nil).Return(nil, errAny),
+	)
+
+	testCases := []struct {
+		name    string
+		except  uuid.UUID
+		want    int
+		wantErr error
+	}{
+		{"success", currentID, 3, nil},
+		{"err_any", uuid.Nil, 0, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.RemoveAllSessions(ctx, userID, tc.except)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_DeleteExpired(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := start(t)
+
+	const batchSize = 2
+
+	expired := func(createdBefore, usedBefore time.Time) bool {
+		now := time.Now()
+		createdDiff := now.Add(-lifetime.Absolute).Sub(createdBefore)
+		usedDiff := now.Add(-lifetime.Idle).Sub(usedBefore)
+
+		return createdDiff >= 0 && createdDiff < time.Minute && usedDiff >= 0 && usedDiff < time.Minute
+	}
+	deleteExpired := func(deleted int, err error) func(context.Context, time.Time, time.Time, int) (int, error) {
+		return func(_ context.Context, createdBefore, usedBefore time.Time, _ int) (int, error) {
+			assert.True(expired(createdBefore, usedBefore))
+
+			return deleted, err
+		}
+	}
+
+	gomock.InOrder(
+		mocks.repo.EXPECT().DeleteExpired(ctx, gomock.Any(), gomock.Any(), batchSize).DoAndReturn(deleteExpired(batchSize, nil)),
+		mocks.repo.EXPECT().DeleteExpired(ctx, gomock.Any(), gomock.Any(), batchSize).DoAndReturn(deleteExpired(1, nil)),
+		mocks.repo.EXPECT().DeleteExpired(ctx, gomock.Any(), gomock.Any(), batchSize).DoAndReturn(deleteExpired(batchSize, nil)),
+		mocks.repo.EXPECT().DeleteExpired(ctx, gomock.Any(), gomock.Any(), batchSize).DoAndReturn(deleteExpired(0, errAny)),
+	)
+
+	testCases := []struct {
+		name    string
+		want    int
+		wantErr error
+	}{
+		{"success", 3, nil},
+		{"err_any", 2, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.DeleteExpired(ctx, batchSize)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	res, err := module.DeleteExpired(canceled, batchSize)
+	assert.ErrorIs(err, context.Canceled)
+	assert.Zero(res)
+}
+
+func TestModule_DeleteEvents(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := start(t)
+
+	const (
+		batchSize = 2
+		retention = 24 * time.Hour
+	)
+
+	deleteEvents := func(deleted int, err error) func(context.Context, time.Time, int) (int, error) {
+		return func(_ context.Context, createdBefore time.Time, _ int) (int, error) {
+			diff := time.Now().Add(-retention).Sub(createdBefore)
+			assert.True(diff >= 0 && diff < time.Minute)
+
+			return deleted, err
+		}
+	}
+
+	gomock.InOrder(
+		mocks.repo.EXPECT().DeleteEvents(ctx, gomock.Any(), batchSize).DoAndReturn(deleteEvents(batchSize, nil)),
+		mocks.repo.EXPECT().DeleteEvents(ctx, gomock.Any(), batchSize).DoAndReturn(deleteEvents(1, nil)),
+		mocks.repo.EXPECT().DeleteEvents(ctx, gomock.Any(), batchSize).DoAndReturn(deleteEvents(batchSize, nil)),
+		mocks.repo.EXPECT().DeleteEvents(ctx, gomock.Any(), batchSize).DoAndReturn(deleteEvents(0, errAny)),
+	)
+
+	testCases := []struct {
+		name    string
+		want    int
+		wantErr error
+	}{
+		{"success", 3, nil},
+		{"err_any", 2, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.DeleteEvents(ctx, retention, batchSize)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
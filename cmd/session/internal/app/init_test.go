@@ -3,7 +3,9 @@ package app_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -12,14 +14,46 @@ import (
 )
 
 var (
-	ctx    = context.Background()
-	errAny = errors.New("any error")
+	ctx      = context.Background()
+	errAny   = errors.New("any error")
+	lifetime = app.Lifetime{
+		Idle:     time.Hour,
+		Absolute: 24 * time.Hour,
+		Access:   15 * time.Minute,
+	}
 )
 
+var _ gomock.Matcher = &subjectMatcher{}
+
+// subjectMatcher matches subject of issued token, expiration time is compared approximately.
+type subjectMatcher struct {
+	subject app.Subject
+}
+
+// Matches for implements gomock.Matcher.
+func (s subjectMatcher) Matches(x interface{}) bool {
+	subject, ok := x.(app.Subject)
+	if !ok {
+		return false
+	}
+
+	diff := subject.ExpiresAt.Sub(s.subject.ExpiresAt)
+	subject.ExpiresAt = s.subject.ExpiresAt
+
+	return subject == s.subject && diff > -time.Minute && diff < time.Minute
+}
+
+// String for implements gomock.Matcher.
+func (s subjectMatcher) String() string {
+	return fmt.Sprintf("%+v", s.subject)
+}
+
 type mocks struct {
-	repo *MockRepo
-	id   *MockID
-	auth *MockAuth
+	repo  *MockRepo
+	id    *MockID
+	auth  *MockAuth
+	cache *MockCache
+	geo   *MockGeoIP
 }
 
 func start(t *testing.T) (*app.Module, *mocks, *require.Assertions) {
@@ -30,7 +64,7 @@ func start(t *testing.T) (*app.Module, *mocks, *require.Assertions) {
 	mockID := NewMockID(ctrl)
 	mockAuth := NewMockAuth(ctrl)
 
-	module := app.New(mockRepo, mockAuth, mockID)
+	module := app.New(mockRepo, mockAuth, mockID, lifetime, nil, nil, app.Policy{})
 
 	mocks := &mocks{
 		repo: mockRepo,
@@ -40,3 +74,35 @@ func start(t *testing.T) (*app.Module, *mocks, *require.Assertions) {
 
 	return module, mocks, require.New(t)
 }
+
+func startCached(t *testing.T) (*app.Module, *mocks, *require.Assertions) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+
+	mocks := &mocks{
+		repo:  NewMockRepo(ctrl),
+		id:    NewMockID(ctrl),
+		auth:  NewMockAuth(ctrl),
+		cache: NewMockCache(ctrl),
+	}
+
+	module := app.New(mocks.repo, mocks.auth, mocks.id, lifetime, mocks.cache, nil, app.Policy{})
+
+	return module, mocks, require.New(t)
+}
+
+func startPolicy(t *testing.T, policy app.Policy) (*app.Module, *mocks, *require.Assertions) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+
+	mocks := &mocks{
+		repo: NewMockRepo(ctrl),
+		id:   NewMockID(ctrl),
+		auth: NewMockAuth(ctrl),
+		geo:  NewMockGeoIP(ctrl),
+	}
+
+	module := app.New(mocks.repo, mocks.auth, mocks.id, lifetime, nil, mocks.geo, policy)
+
+	return module, mocks, require.New(t)
+}
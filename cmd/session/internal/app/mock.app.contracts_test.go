@@ -6,7 +6,9 @@ package app_test
 
 import (
 	context "context"
+	net "net"
 	reflect "reflect"
+	time "time"
 
 	app "github.com/Meat-Hook/back-template/cmd/session/internal/app"
 	uuid "github.com/gofrs/uuid"
@@ -65,6 +67,95 @@ func (mr *MockRepoMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepo)(nil).Delete), arg0, arg1)
 }
 
+// DeleteByUser mocks base method.
+func (m *MockRepo) DeleteByUser(ctx context.Context, userID, exceptSessionID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUser", ctx, userID, exceptSessionID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByUser indicates an expected call of DeleteByUser.
+func (mr *MockRepoMockRecorder) DeleteByUser(ctx, userID, exceptSessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUser", reflect.TypeOf((*MockRepo)(nil).DeleteByUser), ctx, userID, exceptSessionID)
+}
+
+// DeleteEvents mocks base method.
+func (m *MockRepo) DeleteEvents(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvents", ctx, createdBefore, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvents indicates an expected call of DeleteEvents.
+func (mr *MockRepoMockRecorder) DeleteEvents(ctx, createdBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvents", reflect.TypeOf((*MockRepo)(nil).DeleteEvents), ctx, createdBefore, limit)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockRepo) DeleteExpired(ctx context.Context, createdBefore, usedBefore time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, createdBefore, usedBefore, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockRepoMockRecorder) DeleteExpired(ctx, createdBefore, usedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockRepo)(nil).DeleteExpired), ctx, createdBefore, usedBefore, limit)
+}
+
+// ListByUser mocks base method.
+func (m *MockRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]app.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID)
+	ret0, _ := ret[0].([]app.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockRepoMockRecorder) ListByUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockRepo)(nil).ListByUser), ctx, userID)
+}
+
+// ListEvents mocks base method.
+func (m *MockRepo) ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]app.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, userID, limit)
+	ret0, _ := ret[0].([]app.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockRepoMockRecorder) ListEvents(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockRepo)(nil).ListEvents), ctx, userID, limit)
+}
+
+// Rotate mocks base method.
+func (m *MockRepo) Rotate(ctx context.Context, sessionID, oldRefreshID, newRefreshID uuid.UUID, token app.Token) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, sessionID, oldRefreshID, newRefreshID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockRepoMockRecorder) Rotate(ctx, sessionID, oldRefreshID, newRefreshID, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockRepo)(nil).Rotate), ctx, sessionID, oldRefreshID, newRefreshID, token)
+}
+
 // Save mocks base method.
 func (m *MockRepo) Save(arg0 context.Context, arg1 app.Session) error {
 	m.ctrl.T.Helper()
@@ -79,6 +170,143 @@ func (mr *MockRepoMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRepo)(nil).Save), arg0, arg1)
 }
 
+// SaveEvent mocks base method.
+func (m *MockRepo) SaveEvent(arg0 context.Context, arg1 app.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEvent indicates an expected call of SaveEvent.
+func (mr *MockRepoMockRecorder) SaveEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEvent", reflect.TypeOf((*MockRepo)(nil).SaveEvent), arg0, arg1)
+}
+
+// Touch mocks base method.
+func (m *MockRepo) Touch(ctx context.Context, sessionID uuid.UUID, origin app.Origin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, sessionID, origin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockRepoMockRecorder) Touch(ctx, sessionID, origin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockRepo)(nil).Touch), ctx, sessionID, origin)
+}
+
+// MockGeoIP is a mock of GeoIP interface.
+type MockGeoIP struct {
+	ctrl     *gomock.Controller
+	recorder *MockGeoIPMockRecorder
+}
+
+// MockGeoIPMockRecorder is the mock recorder for MockGeoIP.
+type MockGeoIPMockRecorder struct {
+	mock *MockGeoIP
+}
+
+// NewMockGeoIP creates a new mock instance.
+func NewMockGeoIP(ctrl *gomock.Controller) *MockGeoIP {
+	mock := &MockGeoIP{ctrl: ctrl}
+	mock.recorder = &MockGeoIPMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGeoIP) EXPECT() *MockGeoIPMockRecorder {
+	return m.recorder
+}
+
+// Country mocks base method.
+func (m *MockGeoIP) Country(arg0 net.IP) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Country", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Country indicates an expected call of Country.
+func (mr *MockGeoIPMockRecorder) Country(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Country", reflect.TypeOf((*MockGeoIP)(nil).Country), arg0)
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder struct {
+	mock *MockCache
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache(ctrl *gomock.Controller) *MockCache {
+	mock := &MockCache{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache) EXPECT() *MockCacheMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCache) Get(ctx context.Context, sessionID uuid.UUID) (*app.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, sessionID)
+	ret0, _ := ret[0].(*app.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCacheMockRecorder) Get(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCache)(nil).Get), ctx, sessionID)
+}
+
+// Revoke mocks base method.
+func (m *MockCache) Revoke(ctx context.Context, sessionIDs ...uuid.UUID) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range sessionIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Revoke", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockCacheMockRecorder) Revoke(ctx interface{}, sessionIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, sessionIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockCache)(nil).Revoke), varargs...)
+}
+
+// Set mocks base method.
+func (m *MockCache) Set(arg0 context.Context, arg1 app.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockCacheMockRecorder) Set(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCache)(nil).Set), arg0, arg1)
+}
+
 // MockAuth is a mock of Auth interface.
 type MockAuth struct {
 	ctrl     *gomock.Controller
@@ -102,6 +330,20 @@ func (m *MockAuth) EXPECT() *MockAuthMockRecorder {
 	return m.recorder
 }
 
+// PublicKeys mocks base method.
+func (m *MockAuth) PublicKeys() []app.PublicKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys")
+	ret0, _ := ret[0].([]app.PublicKey)
+	return ret0
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockAuthMockRecorder) PublicKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockAuth)(nil).PublicKeys))
+}
+
 // Subject mocks base method.
 func (m *MockAuth) Subject(token string) (*app.Subject, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,209 @@
+package app_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+)
+
+func TestModule_SessionOrigin(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := startPolicy(t, app.Policy{
+		BindUserAgent: true,
+		Subnet:        app.ActionFlag,
+		IPv4Prefix:    24,
+		IPv6Prefix:    48,
+		Country:       app.ActionReject,
+	})
+
+	const (
+		chrome91 = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36"
+		chrome92 = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/92.0.4515.107 Safari/537.36"
+		firefox  = "Mozilla/5.0 (X11; Linux x86_64; rv:90.0) Gecko/20100101 Firefox/90.0"
+	)
+
+	var (
+		created = app.Origin{IP: net.ParseIP("192.100.10.4"), UserAgent: chrome91}
+		updated = app.Origin{IP: net.ParseIP("192.100.10.5"), UserAgent: chrome92}
+		subnet  = app.Origin{IP: net.ParseIP("192.100.11.4"), UserAgent: chrome91}
+		browser = app.Origin{IP: net.ParseIP("192.100.10.4"), UserAgent: firefox}
+		country = app.Origin{IP: net.ParseIP("8.8.8.8"), UserAgent: chrome91}
+
+		countries = map[string]string{
+			"192.100.10.4": "FR",
+			"192.100.10.5": "FR",
+			"192.100.11.4": "FR",
+			"8.8.8.8":      "US",
+		}
+	)
+
+	now := time.Now()
+	newSession := func(lastOrigin app.Origin) *app.Session {
+		return &app.Session{
+			ID:         uuid.Must(uuid.NewV4()),
+			Origin:     created,
+			UserID:     uuid.Must(uuid.NewV4()),
+			LastOrigin: lastOrigin,
+			CreatedAt:  now.Add(-time.Hour),
+			UpdatedAt:  now,
+		}
+	}
+	event := func(kind app.EventKind, s *app.Session, origin app.Origin, reasons ...app.Reason) app.Event {
+		return app.Event{
+			Kind:      kind,
+			UserID:    s.UserID,
+			SessionID: s.ID,
+			Origin:    origin,
+			Country:   countries[origin.IP.String()],
+			Reasons:   reasons,
+		}
+	}
+
+	var (
+		sameSession     = newSession(created)
+		updatedSession  = newSession(created)
+		subnetSession   = newSession(created)
+		flaggedSession  = newSession(subnet)
+		browserSession  = newSession(created)
+		countrySession  = newSession(created)
+		emptySession    = newSession(created)
+		errEventSession = newSession(created)
+	)
+
+	mocks.geo.EXPECT().Country(gomock.Any()).DoAndReturn(func(ip net.IP) (string, error) {
+		return countries[ip.String()], nil
+	}).AnyTimes()
+
+	for token, s := range map[string]*app.Session{
+		"same":     sameSession,
+		"updated":  updatedSession,
+		"subnet":   subnetSession,
+		"flagged":  flaggedSession,
+		"browser":  browserSession,
+		"country":  countrySession,
+		"empty":    emptySession,
+		"errEvent": errEventSession,
+	} {
+		mocks.auth.EXPECT().Subject(token).Return(&app.Subject{SessionID: s.ID, Kind: app.TokenAccess}, nil)
+		mocks.repo.EXPECT().ByID(ctx, s.ID).Return(s, nil)
+	}
+
+	mocks.repo.EXPECT().Touch(ctx, updatedSession.ID, updated).Return(nil)
+	mocks.repo.EXPECT().SaveEvent(ctx, event(app.EventSuspicious, subnetSession, subnet, app.ReasonSubnet)).Return(nil)
+	mocks.repo.EXPECT().Touch(ctx, subnetSession.ID, subnet).Return(nil)
+	mocks.repo.EXPECT().SaveEvent(ctx, event(app.EventRejected, browserSession, browser, app.ReasonUserAgent)).Return(nil)
+	mocks.repo.EXPECT().SaveEvent(ctx, event(app.EventRejected, countrySession, country, app.ReasonCountry, app.ReasonSubnet)).Return(nil)
+	mocks.repo.EXPECT().SaveEvent(ctx, event(app.EventSuspicious, errEventSession, subnet, app.ReasonSubnet)).Return(errAny)
+
+	testCases := []struct {
+		name           string
+		token          string
+		origin         app.Origin
+		wantLastOrigin app.Origin
+		wantErr        error
+	}{
+		{"success_same_origin", "same", created, created, nil},
+		{"success_updated_browser", "updated", updated, updated, nil},
+		{"success_flagged_subnet", "subnet", subnet, subnet, nil},
+		{"success_flagged_once", "flagged", subnet, subnet, nil},
+		{"success_empty_origin", "empty", app.Origin{}, created, nil},
+		{"err_other_browser", "browser", browser, app.Origin{}, app.ErrOriginMismatch},
+		{"err_other_country", "country", country, app.Origin{}, app.ErrOriginMismatch},
+		{"err_save_event", "errEvent", subnet, app.Origin{}, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.Session(ctx, tc.token, tc.origin)
+			assert.ErrorIs(err, tc.wantErr)
+			if tc.wantErr != nil {
+				assert.Nil(res)
+
+				return
+			}
+
+			assert.Equal(tc.wantLastOrigin, res.LastOrigin)
+		})
+	}
+}
+
+func TestModule_SessionOriginEventOnce(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := startPolicy(t, app.Policy{BindUserAgent: true})
+
+	var (
+		created = app.Origin{IP: net.ParseIP("192.100.10.4"), UserAgent: "curl/7.68.0"}
+		other   = app.Origin{IP: net.ParseIP("192.100.10.4"), UserAgent: "Wget/1.20.3"}
+		otherIP = app.Origin{IP: net.ParseIP("192.100.10.5"), UserAgent: "Wget/1.20.3"}
+		session = &app.Session{
+			ID:        uuid.Must(uuid.NewV4()),
+			Origin:    created,
+			UserID:    uuid.Must(uuid.NewV4()),
+			CreatedAt: time.Now().Add(-time.Hour),
+			UpdatedAt: time.Now(),
+		}
+	)
+	event := func(origin app.Origin) app.Event {
+		return app.Event{
+			Kind:      app.EventRejected,
+			UserID:    session.UserID,
+			SessionID: session.ID,
+			Origin:    origin,
+			Reasons:   []app.Reason{app.ReasonUserAgent},
+		}
+	}
+
+	mocks.auth.EXPECT().Subject("token").Return(&app.Subject{SessionID: session.ID, Kind: app.TokenAccess}, nil).AnyTimes()
+	mocks.repo.EXPECT().ByID(ctx, session.ID).Return(session, nil).AnyTimes()
+	mocks.geo.EXPECT().Country(gomock.Any()).Return("", nil).AnyTimes()
+	// Failed event is saved again.
+	mocks.repo.EXPECT().SaveEvent(ctx, event(other)).Return(errAny)
+	mocks.repo.EXPECT().SaveEvent(ctx, event(other)).Return(nil)
+	mocks.repo.EXPECT().SaveEvent(ctx, event(otherIP)).Return(nil)
+
+	testCases := []struct {
+		name    string
+		origin  app.Origin
+		wantErr error
+	}{
+		{"err_save_event", other, errAny},
+		{"err_rejected", other, app.ErrOriginMismatch},
+		{"err_rejected_again", other, app.ErrOriginMismatch},
+		{"err_rejected_other_ip", otherIP, app.ErrOriginMismatch},
+		{"err_rejected_other_ip_again", otherIP, app.ErrOriginMismatch},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.Session(ctx, "token", tc.origin)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Nil(res)
+		})
+	}
+}
+
+func TestModule_ListEvents(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := start(t)
+
+	var (
+		userID = uuid.Must(uuid.NewV4())
+		events = []app.Event{{ID: uuid.Must(uuid.NewV4()), Kind: app.EventNewSignIn, UserID: userID}}
+	)
+
+	mocks.repo.EXPECT().ListEvents(ctx, userID, 10).Return(events, nil)
+
+	res, err := module.ListEvents(ctx, userID, 10)
+	assert.NoError(err)
+	assert.Equal(events, res)
+}
@@ -3,63 +3,228 @@
 package auth
 
 import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
 
-	"github.com/gofrs/uuid"
 	"github.com/o1egl/paseto/v2"
+	"golang.org/x/crypto/chacha20poly1305"
 
 	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+	"github.com/Meat-Hook/back-template/cmd/session/token"
 )
 
 var _ app.Auth = &Auth{}
 
+// Errors.
+var (
+	ErrUnknownKey     = errors.New("unknown key")
+	ErrNotValidKey    = errors.New("not valid key")
+	ErrUnknownPurpose = errors.New("unknown token purpose")
+)
+
+// Purpose of tokens.
+type Purpose string
+
+// Token purposes.
+const (
+	// PurposeLocal tokens are encrypted by symmetric key, only session service can read them.
+	PurposeLocal Purpose = "local"
+	// PurposePublic tokens are signed by Ed25519 key, anyone can verify them by public key.
+	PurposePublic Purpose = "public"
+)
+
+// KeyRing contains secret keys by their id.
+// Tokens are encrypted by active key, other keys are used only for decrypting
+// tokens issued before rotation.
+// For public tokens keys contain Ed25519 seeds.
+type KeyRing struct {
+	ActiveKeyID string            `json:"active_key_id"`
+	Keys        map[string]string `json:"keys"`
+}
+
+// ReadKeyFile reads key ring from JSON file.
+func ReadKeyFile(path string) (*KeyRing, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	ring := &KeyRing{}
+	err = json.Unmarshal(buf, ring)
+	if err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return ring, nil
+}
+
 // Auth is an implements app.Auth.
 // Responsible for working with authorization tokens, be it cookies or jwt.
 type Auth struct {
-	key []byte
+	purpose Purpose
+
+	mu          sync.RWMutex
+	activeKeyID string
+	// keys contains symmetric keys for local tokens and ed25519.PrivateKey for public tokens.
+	keys map[string][]byte
 }
 
 // New creates and returns new instance auth.
-func New(secretKey string) *Auth {
-	return &Auth{
-		key: []byte(secretKey),
+func New(purpose Purpose, ring KeyRing) (*Auth, error) {
+	if purpose != PurposeLocal && purpose != PurposePublic {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPurpose, purpose)
 	}
+
+	a := &Auth{purpose: purpose}
+	err := a.Reload(ring)
+	if err != nil {
+		return nil, fmt.Errorf("a.Reload: %w", err)
+	}
+
+	return a, nil
 }
 
-type jsonToken struct {
-	SessionID uuid.UUID `json:"session_id"`
+// Reload replaces keys, e.g. after rotation. Tokens encrypted by removed keys become invalid.
+// Keys are kept unchanged if new key ring isn't valid.
+func (a *Auth) Reload(ring KeyRing) error {
+	keys := make(map[string][]byte, len(ring.Keys))
+	for id, key := range ring.Keys {
+		// Ed25519 seed has the same size.
+		if len(key) != chacha20poly1305.KeySize {
+			return fmt.Errorf("%w: key %q must be %d bytes", ErrNotValidKey, id, chacha20poly1305.KeySize)
+		}
+
+		keys[id] = []byte(key)
+		if a.purpose == PurposePublic {
+			keys[id] = ed25519.NewKeyFromSeed(keys[id])
+		}
+	}
+
+	if _, ok := keys[ring.ActiveKeyID]; !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownKey, ring.ActiveKeyID)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.activeKeyID = ring.ActiveKeyID
+	a.keys = keys
+
+	return nil
+}
+
+// PublicKeys need for implements app.Auth.
+// Keys are sorted by id.
+func (a *Auth) PublicKeys() []app.PublicKey {
+	if a.purpose != PurposePublic {
+		return nil
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	res := make([]app.PublicKey, 0, len(a.keys))
+	for id, key := range a.keys {
+		res = append(res, app.PublicKey{
+			ID:  id,
+			Key: ed25519.PrivateKey(key).Public().(ed25519.PublicKey),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+
+	return res
 }
 
 // Token need for implements app.Auth.
+// Token format is described by token.JSON.
 func (a *Auth) Token(subject app.Subject) (*app.Token, error) {
-	t := jsonToken{
-		SessionID: subject.SessionID,
-	}
+	t := token.JSON(subject, time.Now())
 
-	value, err := paseto.Encrypt(a.key, t, "")
-	if err != nil {
-		return nil, fmt.Errorf("paseto.Encrypt: %w", err)
+	a.mu.RLock()
+	keyID, key := a.activeKeyID, a.keys[a.activeKeyID]
+	a.mu.RUnlock()
+
+	var (
+		value string
+		err   error
+	)
+	switch a.purpose {
+	case PurposePublic:
+		value, err = paseto.Sign(ed25519.PrivateKey(key), t, token.Footer{KeyID: keyID})
+		if err != nil {
+			return nil, fmt.Errorf("paseto.Sign: %w", err)
+		}
+	default:
+		value, err = paseto.Encrypt(key, t, token.Footer{KeyID: keyID})
+		if err != nil {
+			return nil, fmt.Errorf("paseto.Encrypt: %w", err)
+		}
 	}
 
 	res := &app.Token{
-		Value: value,
+		Value:     value,
+		ExpiresAt: subject.ExpiresAt,
 	}
 
 	return res, nil
 }
 
 // Subject need for implements app.Auth.
-func (a *Auth) Subject(token string) (*app.Subject, error) {
-	t := jsonToken{}
+// Claims are validated by token.Parse.
+func (a *Auth) Subject(value string) (*app.Subject, error) {
+	t := paseto.JSONToken{}
 
-	err := paseto.Decrypt(token, a.key, &t, nil)
+	err := a.decrypt(value, &t)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", app.ErrInvalidToken, err)
 	}
 
-	sub := &app.Subject{
-		SessionID: t.SessionID,
+	return token.Parse(t)
+}
+
+// decrypt decrypts or verifies token by key from its footer.
+// Tokens without footer were issued before key rotation was added,
+// so they are decrypted by any known key.
+func (a *Auth) decrypt(value string, t *paseto.JSONToken) error {
+	keyID, err := token.KeyID(value)
+	if err != nil {
+		return fmt.Errorf("token.KeyID: %w", err)
+	}
+
+	a.mu.RLock()
+	keys := make([][]byte, 0, len(a.keys))
+	if keyID != "" {
+		key, ok := a.keys[keyID]
+		if ok {
+			keys = append(keys, key)
+		}
+	} else {
+		for _, key := range a.keys {
+			keys = append(keys, key)
+		}
+	}
+	a.mu.RUnlock()
+
+	if len(keys) == 0 {
+		return fmt.Errorf("%w: %q", ErrUnknownKey, keyID)
+	}
+
+	for _, key := range keys {
+		switch a.purpose {
+		case PurposePublic:
+			err = paseto.Verify(value, ed25519.PrivateKey(key).Public(), t, nil)
+		default:
+			err = paseto.Decrypt(value, key, t, nil)
+		}
+		if err == nil {
+			return nil
+		}
 	}
 
-	return sub, nil
+	return fmt.Errorf("paseto: %w", err)
 }
@@ -1,27 +1,272 @@
 package auth_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/o1egl/paseto/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
 	"github.com/Meat-Hook/back-template/cmd/session/internal/auth"
+	"github.com/Meat-Hook/back-template/cmd/session/token"
 )
 
+const (
+	keyID    = "2026-10"
+	key      = "super-duper-secret-key-qwertyuio"
+	otherKey = "other-super-duper-secret-key-qwe"
+)
+
+func newAuth(t *testing.T, activeKeyID string, keys map[string]string) *auth.Auth {
+	t.Helper()
+
+	a, err := auth.New(auth.PurposeLocal, auth.KeyRing{ActiveKeyID: activeKeyID, Keys: keys})
+	require.NoError(t, err)
+
+	return a
+}
+
 func TestAuth_TokenAndSubject(t *testing.T) {
 	t.Parallel()
 
 	assert := require.New(t)
-	a := auth.New("super-duper-secret-key-qwertyuio")
+	a := newAuth(t, keyID, map[string]string{keyID: key})
+
+	testCases := []struct {
+		name    string
+		subject app.Subject
+	}{
+		{"access", app.Subject{
+			SessionID: uuid.Must(uuid.NewV4()),
+			UserID:    uuid.Must(uuid.NewV4()),
+			Kind:      app.TokenAccess,
+			ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second).UTC(),
+		}},
+		{"refresh", app.Subject{
+			SessionID: uuid.Must(uuid.NewV4()),
+			Kind:      app.TokenRefresh,
+			TokenID:   uuid.Must(uuid.NewV4()),
+			ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second).UTC(),
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			appToken, err := a.Token(tc.subject)
+			assert.NoError(err)
+			assert.Equal(tc.subject.ExpiresAt, appToken.ExpiresAt)
+
+			res, err := a.Subject(appToken.Value)
+			assert.NoError(err)
+			assert.True(tc.subject.ExpiresAt.Equal(res.ExpiresAt))
+			res.ExpiresAt = tc.subject.ExpiresAt
+			assert.Equal(&tc.subject, res)
+		})
+	}
+}
+
+func TestAuth_Subject(t *testing.T) {
+	t.Parallel()
+
+	a := newAuth(t, keyID, map[string]string{keyID: key})
+	sessionID := uuid.Must(uuid.NewV4())
+
+	encrypt := func(t *testing.T, claims interface{}) string {
+		t.Helper()
+
+		token, err := paseto.Encrypt([]byte(key), claims, "")
+		require.NoError(t, err)
+
+		return token
+	}
+
+	token := func(t *testing.T, expiration, notBefore time.Time) string {
+		t.Helper()
+
+		claims := paseto.JSONToken{Expiration: expiration, NotBefore: notBefore}
+		claims.Set("session_id", sessionID.String())
+
+		return encrypt(t, claims)
+	}
+
+	testCases := []struct {
+		name    string
+		token   func(t *testing.T) string
+		want    uuid.UUID
+		wantErr error
+	}{
+		{"success_legacy", func(t *testing.T) string {
+			return encrypt(t, map[string]string{"session_id": sessionID.String()})
+		}, sessionID, nil},
+		{"err_token_id", func(t *testing.T) string {
+			claims := paseto.JSONToken{Jti: "not-uuid"}
+			claims.Set("session_id", sessionID.String())
+
+			return encrypt(t, claims)
+		}, uuid.Nil, app.ErrInvalidToken},
+		{"err_expired", func(t *testing.T) string {
+			return token(t, time.Now().Add(-time.Minute), time.Time{})
+		}, uuid.Nil, app.ErrExpired},
+		{"err_not_before", func(t *testing.T) string {
+			return token(t, time.Now().Add(time.Hour), time.Now().Add(time.Minute))
+		}, uuid.Nil, app.ErrInvalidToken},
+		{"err_unknown_key", func(t *testing.T) string {
+			res, err := newAuth(t, "other", map[string]string{"other": key}).Token(app.Subject{SessionID: sessionID})
+			require.NoError(t, err)
+
+			return res.Value
+		}, uuid.Nil, app.ErrInvalidToken},
+		{"err_without_session", func(t *testing.T) string {
+			return encrypt(t, paseto.JSONToken{Expiration: time.Now().Add(time.Hour)})
+		}, uuid.Nil, app.ErrInvalidToken},
+		{"err_other_key", func(t *testing.T) string {
+			res, err := newAuth(t, keyID, map[string]string{keyID: otherKey}).Token(app.Subject{SessionID: sessionID})
+			require.NoError(t, err)
+
+			return res.Value
+		}, uuid.Nil, app.ErrInvalidToken},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert := require.New(t)
+
+			res, err := a.Subject(tc.token(t))
+			assert.ErrorIs(err, tc.wantErr)
+			if tc.wantErr != nil {
+				assert.Nil(res)
+
+				return
+			}
+
+			assert.Equal(tc.want, res.SessionID)
+			assert.Equal(app.TokenAccess, res.Kind)
+		})
+	}
+}
+
+func TestAuth_Reload(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+	a := newAuth(t, keyID, map[string]string{keyID: key})
+	subject := app.Subject{SessionID: uuid.Must(uuid.NewV4()), Kind: app.TokenAccess}
 
-	subject := app.Subject{SessionID: uuid.Must(uuid.NewV4())}
-	appToken, err := a.Token(subject)
+	oldToken, err := a.Token(subject)
 	assert.NoError(err)
-	assert.NotNil(appToken)
 
-	res, err := a.Subject(appToken.Value)
+	// Rotation: new key is active, old one is kept for issued tokens.
+	err = a.Reload(auth.KeyRing{ActiveKeyID: "2026-11", Keys: map[string]string{keyID: key, "2026-11": otherKey}})
 	assert.NoError(err)
+
+	newToken, err := a.Token(subject)
+	assert.NoError(err)
+
+	footer := ""
+	err = paseto.ParseFooter(newToken.Value, &footer)
+	assert.NoError(err)
+	assert.JSONEq(`{"kid":"2026-11"}`, footer)
+
+	for _, token := range []string{oldToken.Value, newToken.Value} {
+		res, err := a.Subject(token)
+		assert.NoError(err)
+		assert.Equal(subject.SessionID, res.SessionID)
+	}
+
+	// Not valid key ring is ignored.
+	err = a.Reload(auth.KeyRing{ActiveKeyID: "2026-12", Keys: map[string]string{"2026-11": otherKey}})
+	assert.ErrorIs(err, auth.ErrUnknownKey)
+	err = a.Reload(auth.KeyRing{ActiveKeyID: "2026-12", Keys: map[string]string{"2026-12": "short"}})
+	assert.ErrorIs(err, auth.ErrNotValidKey)
+
+	_, err = a.Subject(oldToken.Value)
+	assert.NoError(err)
+
+	// Old key is removed.
+	err = a.Reload(auth.KeyRing{ActiveKeyID: "2026-11", Keys: map[string]string{"2026-11": otherKey}})
+	assert.NoError(err)
+
+	_, err = a.Subject(oldToken.Value)
+	assert.ErrorIs(err, app.ErrInvalidToken)
+	_, err = a.Subject(newToken.Value)
+	assert.NoError(err)
+}
+
+func TestReadKeyFile(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+	path := filepath.Join(t.TempDir(), "keys.json")
+
+	_, err := auth.ReadKeyFile(path)
+	assert.ErrorIs(err, os.ErrNotExist)
+
+	err = os.WriteFile(path, []byte(`{"active_key_id": "2026-10", "keys": {"2026-10": "`+key+`"}}`), 0o600)
+	assert.NoError(err)
+
+	res, err := auth.ReadKeyFile(path)
+	assert.NoError(err)
+	assert.Equal(&auth.KeyRing{ActiveKeyID: keyID, Keys: map[string]string{keyID: key}}, res)
+}
+
+func TestAuth_Public(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+	ring := auth.KeyRing{ActiveKeyID: "2026-11", Keys: map[string]string{keyID: key, "2026-11": otherKey}}
+	a, err := auth.New(auth.PurposePublic, ring)
+	assert.NoError(err)
+
+	_, err = auth.New("other", ring)
+	assert.ErrorIs(err, auth.ErrUnknownPurpose)
+	assert.Empty(newAuth(t, keyID, map[string]string{keyID: key}).PublicKeys())
+
+	subject := app.Subject{
+		SessionID: uuid.Must(uuid.NewV4()),
+		UserID:    uuid.Must(uuid.NewV4()),
+		Kind:      app.TokenAccess,
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second).UTC(),
+	}
+
+	issued, err := a.Token(subject)
+	assert.NoError(err)
+
+	res, err := a.Subject(issued.Value)
+	assert.NoError(err)
+	assert.True(subject.ExpiresAt.Equal(res.ExpiresAt))
+	res.ExpiresAt = subject.ExpiresAt
 	assert.Equal(&subject, res)
+
+	keys := a.PublicKeys()
+	assert.Len(keys, 2)
+	assert.Equal(keyID, keys[0].ID)
+	assert.Equal("2026-11", keys[1].ID)
+
+	tokenKeyID, err := token.KeyID(issued.Value)
+	assert.NoError(err)
+	assert.Equal("2026-11", tokenKeyID)
+
+	res, err = token.Verify(issued.Value, keys[1].Key)
+	assert.NoError(err)
+	assert.Equal(subject.SessionID, res.SessionID)
+	assert.Equal(subject.UserID, res.UserID)
+
+	_, err = token.Verify(issued.Value, keys[0].Key)
+	assert.ErrorIs(err, app.ErrInvalidToken)
+
+	// Local token isn't accepted as public.
+	local, err := newAuth(t, "2026-11", map[string]string{"2026-11": otherKey}).Token(subject)
+	assert.NoError(err)
+	_, err = a.Subject(local.Value)
+	assert.ErrorIs(err, app.ErrInvalidToken)
+	_, err = token.Verify(local.Value, keys[1].Key)
+	assert.ErrorIs(err, app.ErrInvalidToken)
 }
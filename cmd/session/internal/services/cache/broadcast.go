@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/gofrs/uuid"
+	"github.com/rs/zerolog"
+)
+
+// channel contains name of Redis channel for revoked session ids.
+const channel = "sessions:revoked"
+
+// Broadcaster delivers ids of revoked sessions to all service instances by Redis pub/sub.
+// Messages published while instance is disconnected are lost, such sessions are
+// removed from its cache after ttl.
+type Broadcaster struct {
+	client redis.UniversalClient
+}
+
+// NewBroadcaster build and returns new Broadcaster.
+func NewBroadcaster(client redis.UniversalClient) *Broadcaster {
+	return &Broadcaster{client: client}
+}
+
+// Publish sends ids of revoked sessions to all instances, including current one.
+func (b *Broadcaster) Publish(ctx context.Context, sessionIDs ...uuid.UUID) error {
+	ids := make([]string, len(sessionIDs))
+	for i := range sessionIDs {
+		ids[i] = sessionIDs[i].String()
+	}
+
+	err := b.client.Publish(ctx, channel, strings.Join(ids, ",")).Err()
+	if err != nil {
+		return fmt.Errorf("b.client.Publish: %w", err)
+	}
+
+	return nil
+}
+
+// Listen calls f with ids of revoked sessions until ctx.Done.
+// Not valid messages are logged and skipped.
+func (b *Broadcaster) Listen(ctx context.Context, f func(sessionIDs ...uuid.UUID)) error {
+	sub := b.client.Subscribe(ctx, channel)
+	defer func() {
+		err := sub.Close()
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Msg("sub.Close")
+		}
+	}()
+
+	// Wait for confirmation, so subscription errors aren't hidden.
+	_, err := sub.Receive(ctx)
+	if err != nil {
+		return fmt.Errorf("sub.Receive: %w", err)
+	}
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			sessionIDs, err := parseIDs(msg.Payload)
+			if err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Str("payload", msg.Payload).Msg("not valid revoked sessions")
+
+				continue
+			}
+
+			f(sessionIDs...)
+		}
+	}
+}
+
+func parseIDs(payload string) ([]uuid.UUID, error) {
+	parts := strings.Split(payload, ",")
+	sessionIDs := make([]uuid.UUID, len(parts))
+	for i := range parts {
+		var err error
+		sessionIDs[i], err = uuid.FromString(parts[i])
+		if err != nil {
+			return nil, fmt.Errorf("uuid.FromString: %w", err)
+		}
+	}
+
+	return sessionIDs, nil
+}
@@ -0,0 +1,86 @@
+// Package cache contains implements for app.Cache.
+// Sessions are kept for short ttl, so changes made by other service instances
+// are seen after ttl at most, revoked sessions are removed from caches of all instances.
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics contains metrics of session cache.
+type Metrics struct {
+	hitsTotal   prometheus.Counter
+	missesTotal prometheus.Counter
+}
+
+// NewMetrics registers and returns session cache metrics.
+func NewMetrics(reg *prometheus.Registry, namespace string) *Metrics {
+	metric := newMetrics(namespace)
+	reg.MustRegister(metric.hitsTotal, metric.missesTotal)
+
+	return metric
+}
+
+func newMetrics(namespace string) *Metrics {
+	const subsystem = "session_cache"
+
+	return &Metrics{
+		hitsTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "hits_total",
+				Help:      "Amount of sessions found in cache.",
+			},
+		),
+		missesTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "misses_total",
+				Help:      "Amount of sessions not found in cache.",
+			},
+		),
+	}
+}
+
+// observe counts cache lookup.
+func (m *Metrics) observe(hit bool) {
+	if hit {
+		m.hitsTotal.Inc()
+	} else {
+		m.missesTotal.Inc()
+	}
+}
+
+// Option for building cache.
+type Option func(*options)
+
+type options struct {
+	metric      *Metrics
+	broadcaster *Broadcaster
+}
+
+func buildOptions(opts []Option) options {
+	o := options{metric: newMetrics("")}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// Metric option for sets metrics of cache lookups.
+func Metric(m *Metrics) Option {
+	return func(o *options) {
+		o.metric = m
+	}
+}
+
+// Broadcast option for sets broadcaster of revoked sessions,
+// it's needed by Memory cache if service has several instances.
+func Broadcast(b *Broadcaster) Option {
+	return func(o *options) {
+		o.broadcaster = b
+	}
+}
@@ -0,0 +1,78 @@
+package cache_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+	"github.com/Meat-Hook/back-template/cmd/session/internal/services/cache"
+)
+
+const namespace = "test"
+
+var ctx = context.Background()
+
+func newSession() app.Session {
+	return app.Session{
+		ID: uuid.Must(uuid.NewV4()),
+		Origin: app.Origin{
+			IP:        net.ParseIP("192.100.10.4"),
+			UserAgent: "Mozilla/5.0",
+		},
+		Token:     app.Token{Value: "token", ExpiresAt: time.Now().Add(time.Minute).UTC()},
+		RefreshID: uuid.Must(uuid.NewV4()),
+		UserID:    uuid.Must(uuid.NewV4()),
+		LastOrigin: app.Origin{
+			IP:        net.ParseIP("2001:db8::1"),
+			UserAgent: "curl/7.68.0",
+		},
+		CreatedAt: time.Now().Add(-time.Hour).UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+}
+
+func newMetric(t *testing.T) (cache.Option, func(name string) float64) {
+	t.Helper()
+
+	reg := prometheus.NewPedanticRegistry()
+	metric := cache.Metric(cache.NewMetrics(reg, namespace))
+
+	counter := func(name string) float64 {
+		t.Helper()
+
+		families, err := reg.Gather()
+		require.NoError(t, err)
+
+		for _, family := range families {
+			if family.GetName() == namespace+"_session_cache_"+name {
+				return family.GetMetric()[0].GetCounter().GetValue()
+			}
+		}
+
+		return 0
+	}
+
+	return metric, counter
+}
+
+// startRedis runs local stand-in of Redis.
+func startRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+
+	srv, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(srv.Close)
+
+	client := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	return srv, client
+}
@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+)
+
+var _ app.Cache = &Memory{}
+
+// Memory keeps sessions in process memory, least recently used sessions are evicted
+// when cache is full. Revoked sessions are removed from other instances by broadcaster.
+type Memory struct {
+	size        int
+	ttl         time.Duration
+	metric      *Metrics
+	broadcaster *Broadcaster
+
+	mu      sync.Mutex
+	items   map[uuid.UUID]*list.Element
+	recency *list.List // Front contains most recently used item.
+}
+
+type memoryItem struct {
+	session   app.Session
+	expiresAt time.Time
+}
+
+// NewMemory build and returns cache of up to size sessions kept for ttl.
+func NewMemory(size int, ttl time.Duration, options ...Option) *Memory {
+	o := buildOptions(options)
+
+	return &Memory{
+		size:        size,
+		ttl:         ttl,
+		metric:      o.metric,
+		broadcaster: o.broadcaster,
+		items:       make(map[uuid.UUID]*list.Element, size),
+		recency:     list.New(),
+	}
+}
+
+// Get for implements app.Cache.
+func (m *Memory) Get(_ context.Context, sessionID uuid.UUID) (*app.Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.items[sessionID]
+	if ok && time.Now().After(elem.Value.(*memoryItem).expiresAt) {
+		m.remove(elem)
+		ok = false
+	}
+	m.metric.observe(ok)
+	if !ok {
+		return nil, app.ErrNotFound
+	}
+
+	m.recency.MoveToFront(elem)
+	session := elem.Value.(*memoryItem).session
+
+	return &session, nil
+}
+
+// Set for implements app.Cache.
+func (m *Memory) Set(_ context.Context, session app.Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item := &memoryItem{session: session, expiresAt: time.Now().Add(m.ttl)}
+
+	elem, ok := m.items[session.ID]
+	if ok {
+		elem.Value = item
+		m.recency.MoveToFront(elem)
+
+		return nil
+	}
+
+	m.items[session.ID] = m.recency.PushFront(item)
+	if m.recency.Len() > m.size {
+		m.remove(m.recency.Back())
+	}
+
+	return nil
+}
+
+// Revoke for implements app.Cache.
+func (m *Memory) Revoke(ctx context.Context, sessionIDs ...uuid.UUID) error {
+	m.Remove(sessionIDs...)
+
+	if m.broadcaster == nil {
+		return nil
+	}
+
+	err := m.broadcaster.Publish(ctx, sessionIDs...)
+	if err != nil {
+		return fmt.Errorf("m.broadcaster.Publish: %w", err)
+	}
+
+	return nil
+}
+
+// Remove removes sessions from cache of this instance only,
+// e.g. sessions revoked by other instance.
+func (m *Memory) Remove(sessionIDs ...uuid.UUID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, sessionID := range sessionIDs {
+		elem, ok := m.items[sessionID]
+		if ok {
+			m.remove(elem)
+		}
+	}
+}
+
+// remove must be called under lock.
+func (m *Memory) remove(elem *list.Element) {
+	m.recency.Remove(elem)
+	delete(m.items, elem.Value.(*memoryItem).session.ID)
+}
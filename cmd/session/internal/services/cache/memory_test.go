@@ -0,0 +1,122 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+	"github.com/Meat-Hook/back-template/cmd/session/internal/services/cache"
+)
+
+func TestMemory_Smoke(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+	metric, counter := newMetric(t)
+	c := cache.NewMemory(2, time.Hour, metric)
+
+	sessions := []app.Session{newSession(), newSession(), newSession()}
+
+	_, err := c.Get(ctx, sessions[0].ID)
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	for i := range sessions[:2] {
+		err = c.Set(ctx, sessions[i])
+		assert.NoError(err)
+	}
+
+	res, err := c.Get(ctx, sessions[0].ID)
+	assert.NoError(err)
+	assert.Equal(sessions[0], *res)
+
+	// Cached session isn't changed by caller.
+	res.UpdatedAt = time.Now().Add(time.Hour)
+	res, err = c.Get(ctx, sessions[0].ID)
+	assert.NoError(err)
+	assert.Equal(sessions[0], *res)
+
+	// Least recently used session is evicted.
+	err = c.Set(ctx, sessions[2])
+	assert.NoError(err)
+
+	_, err = c.Get(ctx, sessions[1].ID)
+	assert.ErrorIs(err, app.ErrNotFound)
+	_, err = c.Get(ctx, sessions[2].ID)
+	assert.NoError(err)
+
+	err = c.Revoke(ctx, sessions[0].ID, sessions[2].ID, uuid.Must(uuid.NewV4()))
+	assert.NoError(err)
+
+	for i := range sessions {
+		_, err = c.Get(ctx, sessions[i].ID)
+		assert.ErrorIs(err, app.ErrNotFound)
+	}
+
+	assert.Equal(float64(3), counter("hits_total"))
+	assert.Equal(float64(5), counter("misses_total"))
+}
+
+func TestMemory_TTL(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+	c := cache.NewMemory(10, time.Millisecond)
+	session := newSession()
+
+	err := c.Set(ctx, session)
+	assert.NoError(err)
+
+	time.Sleep(10 * time.Millisecond)
+
+	_, err = c.Get(ctx, session.ID)
+	assert.ErrorIs(err, app.ErrNotFound)
+}
+
+func TestMemory_Broadcast(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+	_, client := startRedis(t)
+	broadcaster := cache.NewBroadcaster(client)
+
+	// Two service instances.
+	first := cache.NewMemory(10, time.Hour, cache.Broadcast(broadcaster))
+	second := cache.NewMemory(10, time.Hour, cache.Broadcast(broadcaster))
+
+	listenCtx, cancel := context.WithCancel(ctx)
+	errc := make(chan error, 1)
+	go func() { errc <- broadcaster.Listen(listenCtx, second.Remove) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(<-errc)
+	})
+
+	sessions := []app.Session{newSession(), newSession()}
+	for i := range sessions {
+		assert.NoError(first.Set(ctx, sessions[i]))
+		assert.NoError(second.Set(ctx, sessions[i]))
+	}
+
+	// Wait for subscription.
+	assert.Eventually(func() bool {
+		n, err := client.PubSubNumSub(ctx, "sessions:revoked").Result()
+		return err == nil && n["sessions:revoked"] == 1
+	}, time.Second, 10*time.Millisecond)
+
+	err := first.Revoke(ctx, sessions[0].ID)
+	assert.NoError(err)
+
+	_, err = first.Get(ctx, sessions[0].ID)
+	assert.ErrorIs(err, app.ErrNotFound)
+	assert.Eventually(func() bool {
+		_, err := second.Get(ctx, sessions[0].ID)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	_, err = second.Get(ctx, sessions[1].ID)
+	assert.NoError(err)
+}
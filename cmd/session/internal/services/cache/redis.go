@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/gofrs/uuid"
+
+	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+)
+
+var _ app.Cache = &Redis{}
+
+// keyPrefix contains prefix of Redis keys with sessions.
+const keyPrefix = "session:"
+
+// Redis keeps sessions in Redis shared by all service instances,
+// so revoked sessions don't need broadcasting.
+type Redis struct {
+	client redis.UniversalClient
+	ttl    time.Duration
+	metric *Metrics
+}
+
+type redisSession struct {
+	ID             uuid.UUID `json:"id"`
+	IP             net.IP    `json:"ip"`
+	UserAgent      string    `json:"user_agent"`
+	Token          string    `json:"token"`
+	TokenExpiresAt time.Time `json:"token_expires_at"`
+	RefreshID      uuid.UUID `json:"refresh_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	LastIP         net.IP    `json:"last_ip"`
+	LastUserAgent  string    `json:"last_user_agent"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// NewRedis build and returns cache of sessions kept for ttl.
+func NewRedis(client redis.UniversalClient, ttl time.Duration, options ...Option) *Redis {
+	o := buildOptions(options)
+
+	return &Redis{
+		client: client,
+		ttl:    ttl,
+		metric: o.metric,
+	}
+}
+
+// Get for implements app.Cache.
+func (r *Redis) Get(ctx context.Context, sessionID uuid.UUID) (*app.Session, error) {
+	buf, err := r.client.Get(ctx, keyPrefix+sessionID.String()).Bytes()
+	r.metric.observe(err == nil)
+	switch {
+	case errors.Is(err, redis.Nil):
+		return nil, app.ErrNotFound
+	case err != nil:
+		return nil, fmt.Errorf("r.client.Get: %w", err)
+	}
+
+	s := redisSession{}
+	err = json.Unmarshal(buf, &s)
+	if err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return &app.Session{
+		ID:         s.ID,
+		Origin:     app.Origin{IP: s.IP, UserAgent: s.UserAgent},
+		Token:      app.Token{Value: s.Token, ExpiresAt: s.TokenExpiresAt},
+		RefreshID:  s.RefreshID,
+		UserID:     s.UserID,
+		LastOrigin: app.Origin{IP: s.LastIP, UserAgent: s.LastUserAgent},
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}, nil
+}
+
+// Set for implements app.Cache.
+func (r *Redis) Set(ctx context.Context, session app.Session) error {
+	buf, err := json.Marshal(redisSession{
+		ID:             session.ID,
+		IP:             session.Origin.IP,
+		UserAgent:      session.Origin.UserAgent,
+		Token:          session.Token.Value,
+		TokenExpiresAt: session.Token.ExpiresAt,
+		RefreshID:      session.RefreshID,
+		UserID:         session.UserID,
+		LastIP:         session.LastOrigin.IP,
+		LastUserAgent:  session.LastOrigin.UserAgent,
+		CreatedAt:      session.CreatedAt,
+		UpdatedAt:      session.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	err = r.client.Set(ctx, keyPrefix+session.ID.String(), buf, r.ttl).Err()
+	if err != nil {
+		return fmt.Errorf("r.client.Set: %w", err)
+	}
+
+	return nil
+}
+
+// Revoke for implements app.Cache.
+func (r *Redis) Revoke(ctx context.Context, sessionIDs ...uuid.UUID) error {
+	keys := make([]string, len(sessionIDs))
+	for i := range sessionIDs {
+		keys[i] = keyPrefix + sessionIDs[i].String()
+	}
+
+	err := r.client.Del(ctx, keys...).Err()
+	if err != nil {
+		return fmt.Errorf("r.client.Del: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+	"github.com/Meat-Hook/back-template/cmd/session/internal/services/cache"
+)
+
+func TestRedis_Smoke(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+	srv, client := startRedis(t)
+	metric, counter := newMetric(t)
+	c := cache.NewRedis(client, time.Minute, metric)
+
+	sessions := []app.Session{newSession(), newSession()}
+
+	_, err := c.Get(ctx, sessions[0].ID)
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	for i := range sessions {
+		err = c.Set(ctx, sessions[i])
+		assert.NoError(err)
+	}
+
+	res, err := c.Get(ctx, sessions[0].ID)
+	assert.NoError(err)
+	assert.Equal(sessions[0], *res)
+
+	err = c.Revoke(ctx, sessions[0].ID, uuid.Must(uuid.NewV4()))
+	assert.NoError(err)
+
+	_, err = c.Get(ctx, sessions[0].ID)
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	// Session expires after ttl.
+	srv.FastForward(2 * time.Minute)
+	_, err = c.Get(ctx, sessions[1].ID)
+	assert.ErrorIs(err, app.ErrNotFound)
+
+	srv.SetError("unavailable")
+	_, err = c.Get(ctx, sessions[1].ID)
+	assert.Error(err)
+	assert.NotErrorIs(err, app.ErrNotFound)
+
+	assert.Equal(float64(1), counter("hits_total"))
+	assert.Equal(float64(4), counter("misses_total"))
+}
@@ -0,0 +1,102 @@
+// Package geoip contains implements for app.GeoIP.
+// Resolve country by offline database of IP ranges.
+package geoip
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+
+	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
+)
+
+var _ app.GeoIP = &DB{}
+
+// ErrNotValidRange is returned when database contains not valid IP range.
+var ErrNotValidRange = errors.New("not valid IP range")
+
+// unknownCountry is used by database for ranges without country, e.g. reserved.
+const unknownCountry = "ZZ"
+
+type (
+	// DB keeps IP ranges in memory sorted by first IP.
+	DB struct {
+		ranges []ipRange
+	}
+
+	ipRange struct {
+		// first and last contain 16-byte IPs, so IPv4 and IPv6 ranges are compared the same way.
+		first, last net.IP
+		country     string
+	}
+)
+
+// Open reads database file in CSV format, every line contains first and last IP
+// of range and country code, e.g. "1.0.0.0,1.0.0.255,AU", as in free DB-IP country database.
+func Open(path string) (*DB, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	ranges, err := parse(bytes.NewReader(buf))
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+
+	return &DB{ranges: ranges}, nil
+}
+
+func parse(r io.Reader) ([]ipRange, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.ReuseRecord = true
+
+	var ranges []ipRange
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reader.Read: %w", err)
+		}
+
+		first, last := net.ParseIP(record[0]), net.ParseIP(record[1])
+		if first == nil || last == nil || (first.To4() == nil) != (last.To4() == nil) ||
+			bytes.Compare(first.To16(), last.To16()) > 0 {
+			return nil, fmt.Errorf("%w: %q", ErrNotValidRange, record)
+		}
+
+		country := record[2]
+		if country == unknownCountry {
+			country = ""
+		}
+
+		ranges = append(ranges, ipRange{first: first.To16(), last: last.To16(), country: country})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return bytes.Compare(ranges[i].first, ranges[j].first) < 0 })
+
+	return ranges, nil
+}
+
+// Country for implements app.GeoIP.
+func (db *DB) Country(ip net.IP) (string, error) {
+	ip = ip.To16()
+	if ip == nil {
+		return "", nil
+	}
+
+	i := sort.Search(len(db.ranges), func(i int) bool { return bytes.Compare(db.ranges[i].last, ip) >= 0 })
+	if i == len(db.ranges) || bytes.Compare(db.ranges[i].first, ip) > 0 {
+		return "", nil
+	}
+
+	return db.ranges[i].country, nil
+}
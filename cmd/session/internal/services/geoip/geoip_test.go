@@ -0,0 +1,91 @@
+package geoip_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/session/internal/services/geoip"
+)
+
+const database = `2.0.0.0,2.15.255.255,FR
+1.0.0.0,1.0.0.255,AU
+10.0.0.0,10.255.255.255,ZZ
+2001:67c:2e8::,2001:67c:2e8:ffff:ffff:ffff:ffff:ffff,NL
+`
+
+func TestDB_Country(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "geoip.csv")
+	assert.NoError(os.WriteFile(path, []byte(database), 0o600))
+
+	db, err := geoip.Open(path)
+	assert.NoError(err)
+
+	testCases := []struct {
+		name string
+		ip   string
+		want string
+	}{
+		{"first", "1.0.0.0", "AU"},
+		{"last", "1.0.0.255", "AU"},
+		{"unsorted", "2.3.4.5", "FR"},
+		{"between", "1.0.1.0", ""},
+		{"before", "0.0.0.1", ""},
+		{"after", "200.0.0.1", ""},
+		{"unknown", "10.1.1.1", ""},
+		{"ipv6", "2001:67c:2e8:22::c100:68b", "NL"},
+		{"ipv6_unknown", "2001:db8::1", ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := db.Country(net.ParseIP(tc.ip))
+			require.NoError(t, err)
+			require.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	testCases := []struct {
+		name     string
+		database string
+		wantErr  error
+	}{
+		{"success", database, nil},
+		{"err_reversed_range", "1.0.0.255,1.0.0.0,AU\n", geoip.ErrNotValidRange},
+		{"err_mixed_range", "1.0.0.0,2001:db8::1,AU\n", geoip.ErrNotValidRange},
+		{"err_not_valid_ip", "1.0.0,1.0.0.255,AU\n", geoip.ErrNotValidRange},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+
+			path := filepath.Join(dir, tc.name+".csv")
+			assert.NoError(os.WriteFile(path, []byte(tc.database), 0o600))
+
+			_, err := geoip.Open(path)
+			assert.ErrorIs(err, tc.wantErr)
+		})
+	}
+
+	_, err := geoip.Open(filepath.Join(dir, "not_exist.csv"))
+	require.Error(t, err)
+}
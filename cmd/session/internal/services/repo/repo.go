@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/jackc/pgtype"
@@ -24,13 +25,28 @@ type (
 	}
 
 	session struct {
+		ID            pgtype.UUID      `db:"id"`
+		Token         string           `db:"token"`
+		RefreshID     pgtype.UUID      `db:"refresh_id"`
+		IP            pgtype.Inet      `db:"ip"`
+		UserAgent     string           `db:"user_agent"`
+		UserID        pgtype.UUID      `db:"user_id"`
+		LastIP        pgtype.Inet      `db:"last_ip"`
+		LastUserAgent string           `db:"last_user_agent"`
+		CreatedAt     pgtype.Timestamp `db:"created_at"`
+		UpdatedAt     pgtype.Timestamp `db:"updated_at"`
+	}
+
+	event struct {
 		ID        pgtype.UUID      `db:"id"`
-		Token     string           `db:"token"`
+		Kind      string           `db:"kind"`
+		UserID    pgtype.UUID      `db:"user_id"`
+		SessionID pgtype.UUID      `db:"session_id"`
 		IP        pgtype.Inet      `db:"ip"`
 		UserAgent string           `db:"user_agent"`
-		UserID    pgtype.UUID      `db:"user_id"`
+		Country   string           `db:"country"`
+		Reasons   pgtype.TextArray `db:"reasons"`
 		CreatedAt pgtype.Timestamp `db:"created_at"`
-		UpdatedAt pgtype.Timestamp `db:"updated_at"`
 	}
 )
 
@@ -47,18 +63,29 @@ func convert(s app.Session) (*session, error) {
 		return nil, fmt.Errorf("parse ip: %w", err)
 	}
 
+	lastIP, err := inet(s.LastOrigin.IP)
+	if err != nil {
+		return nil, fmt.Errorf("parse last ip: %w", err)
+	}
+
 	return &session{
 		ID: pgtype.UUID{
 			Bytes:  s.ID,
 			Status: pgtype.Present,
 		},
-		Token:     s.Token.Value,
+		Token: s.Token.Value,
+		RefreshID: pgtype.UUID{
+			Bytes:  s.RefreshID,
+			Status: pgtype.Present,
+		},
 		IP:        *ip,
 		UserAgent: s.Origin.UserAgent,
 		UserID: pgtype.UUID{
 			Bytes:  s.UserID,
 			Status: pgtype.Present,
 		},
+		LastIP:        *lastIP,
+		LastUserAgent: s.LastOrigin.UserAgent,
 		CreatedAt: pgtype.Timestamp{
 			Time:             s.CreatedAt,
 			Status:           pgtype.Present,
@@ -76,18 +103,87 @@ func (s session) convert() *app.Session {
 	return &app.Session{
 		ID: s.ID.Bytes,
 		Origin: app.Origin{
-			IP:        s.IP.IPNet.IP,
+			IP:        ipOf(s.IP),
 			UserAgent: s.UserAgent,
 		},
 		Token: app.Token{
 			Value: s.Token,
 		},
+		RefreshID: s.RefreshID.Bytes,
 		UserID:    s.UserID.Bytes,
+		LastOrigin: app.Origin{
+			IP:        ipOf(s.LastIP),
+			UserAgent: s.LastUserAgent,
+		},
 		CreatedAt: s.CreatedAt.Time,
 		UpdatedAt: s.UpdatedAt.Time,
 	}
 }
 
+func convertEvent(e app.Event) (*event, error) {
+	ip, err := inet(e.Origin.IP)
+	if err != nil {
+		return nil, fmt.Errorf("parse ip: %w", err)
+	}
+
+	reasons := make([]string, len(e.Reasons))
+	for i := range e.Reasons {
+		reasons[i] = string(e.Reasons[i])
+	}
+
+	res := &event{
+		Kind: string(e.Kind),
+		UserID: pgtype.UUID{
+			Bytes:  e.UserID,
+			Status: pgtype.Present,
+		},
+		SessionID: pgtype.UUID{
+			Bytes:  e.SessionID,
+			Status: pgtype.Present,
+		},
+		IP:        *ip,
+		UserAgent: e.Origin.UserAgent,
+		Country:   e.Country,
+	}
+
+	err = res.Reasons.Set(reasons)
+	if err != nil {
+		return nil, fmt.Errorf("res.Reasons.Set: %w", err)
+	}
+
+	return res, nil
+}
+
+func (e event) convert() app.Event {
+	reasons := make([]app.Reason, len(e.Reasons.Elements))
+	for i := range e.Reasons.Elements {
+		reasons[i] = app.Reason(e.Reasons.Elements[i].String)
+	}
+
+	return app.Event{
+		ID:        e.ID.Bytes,
+		Kind:      app.EventKind(e.Kind),
+		UserID:    e.UserID.Bytes,
+		SessionID: e.SessionID.Bytes,
+		Origin: app.Origin{
+			IP:        ipOf(e.IP),
+			UserAgent: e.UserAgent,
+		},
+		Country:   e.Country,
+		Reasons:   reasons,
+		CreatedAt: e.CreatedAt.Time,
+	}
+}
+
+// ipOf returns IP of nullable column, nil for null.
+func ipOf(inet pgtype.Inet) net.IP {
+	if inet.Status != pgtype.Present {
+		return nil
+	}
+
+	return inet.IPNet.IP
+}
+
 func inet(ip net.IP) (*pgtype.Inet, error) {
 	inet := &pgtype.Inet{}
 	if ip == nil || ip.IsUnspecified() {
@@ -116,9 +212,9 @@ func (r *Repo) Save(ctx context.Context, session app.Session) error {
 		const query = `
 		insert into 
 		sessions 
-		    (id, token, ip, user_agent, user_id) 
+		    (id, token, refresh_id, ip, user_agent, user_id, last_ip, last_user_agent) 
 		values 
-			(:id, :token, :ip, :user_agent, :user_id)
+			(:id, :token, :refresh_id, :ip, :user_agent, :user_id, :last_ip, :last_user_agent)
 		`
 
 		_, err = db.NamedExecContext(ctx, query, newSession)
@@ -168,3 +264,225 @@ func (r *Repo) Delete(ctx context.Context, sessionID uuid.UUID) error {
 		return nil
 	})
 }
+
+// ListByUser for implements app.Repo.
+func (r *Repo) ListByUser(ctx context.Context, userID uuid.UUID) (sessions []app.Session, err error) {
+	err = r.repo.NoTx(func(db *sqlx.DB) error {
+		const query = `select * from sessions where user_id = $1 order by updated_at desc`
+
+		var res []session
+		err = db.SelectContext(ctx, &res, query, userID)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		sessions = make([]app.Session, len(res))
+		for i := range res {
+			sessions[i] = *res[i].convert()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// DeleteByUser for implements app.Repo.
+func (r *Repo) DeleteByUser(ctx context.Context, userID, exceptSessionID uuid.UUID) (removed []uuid.UUID, err error) {
+	err = r.repo.NoTx(func(db *sqlx.DB) error {
+		const query = `
+		delete
+		from sessions
+		where user_id = $1 and id != $2
+		returning id`
+
+		err := db.SelectContext(ctx, &removed, query, userID, exceptSessionID)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return removed, nil
+}
+
+// DeleteExpired for implements app.Repo.
+// Concurrent calls may select the same sessions, already removed sessions aren't counted.
+func (r *Repo) DeleteExpired(ctx context.Context, createdBefore, usedBefore time.Time, limit int) (deleted int, err error) {
+	err = r.repo.NoTx(func(db *sqlx.DB) error {
+		const query = `
+		delete
+		from sessions
+		where id in (
+			select id
+			from sessions
+			where created_at < $1 or updated_at < $2
+			limit $3
+		)`
+
+		result, err := db.ExecContext(ctx, query, createdBefore, usedBefore, limit)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+		deleted = int(rowsAffected)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+// Touch for implements app.Repo.
+func (r *Repo) Touch(ctx context.Context, sessionID uuid.UUID, origin app.Origin) error {
+	return r.repo.NoTx(func(db *sqlx.DB) error {
+		ip, err := inet(origin.IP)
+		if err != nil {
+			return fmt.Errorf("parse ip: %w", err)
+		}
+
+		const query = `
+		update sessions
+		set updated_at = now(), last_ip = $2, last_user_agent = $3
+		where id = $1`
+
+		result, err := db.ExecContext(ctx, query, sessionID, ip, origin.UserAgent)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return app.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// Rotate for implements app.Repo.
+func (r *Repo) Rotate(ctx context.Context, sessionID, oldRefreshID, newRefreshID uuid.UUID, token app.Token) error {
+	return r.repo.NoTx(func(db *sqlx.DB) error {
+		const query = `
+		update sessions
+		set refresh_id = $3, token = $4, updated_at = now()
+		where id = $1 and refresh_id = $2`
+
+		result, err := db.ExecContext(ctx, query, sessionID, oldRefreshID, newRefreshID, token.Value)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return app.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// SaveEvent for implements app.Repo.
+func (r *Repo) SaveEvent(ctx context.Context, e app.Event) error {
+	return r.repo.NoTx(func(db *sqlx.DB) error {
+		newEvent, err := convertEvent(e)
+		if err != nil {
+			return fmt.Errorf("convert event: %w", err)
+		}
+
+		const query = `
+		insert into 
+		session_events 
+		    (kind, user_id, session_id, ip, user_agent, country, reasons) 
+		values 
+			(:kind, :user_id, :session_id, :ip, :user_agent, :country, :reasons)
+		`
+
+		_, err = db.NamedExecContext(ctx, query, newEvent)
+		if err != nil {
+			return fmt.Errorf("db.NamedExecContext: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DeleteEvents for implements app.Repo.
+func (r *Repo) DeleteEvents(ctx context.Context, createdBefore time.Time, limit int) (deleted int, err error) {
+	err = r.repo.NoTx(func(db *sqlx.DB) error {
+		const query = `
+		delete
+		from session_events
+		where id in (
+			select id
+			from session_events
+			where created_at < $1
+			limit $2
+		)`
+
+		result, err := db.ExecContext(ctx, query, createdBefore, limit)
+		if err != nil {
+			return fmt.Errorf("db.ExecContext: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("result.RowsAffected: %w", err)
+		}
+		deleted = int(rowsAffected)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+// ListEvents for implements app.Repo.
+func (r *Repo) ListEvents(ctx context.Context, userID uuid.UUID, limit int) (events []app.Event, err error) {
+	err = r.repo.NoTx(func(db *sqlx.DB) error {
+		const query = `select * from session_events where user_id = $1 order by created_at desc limit $2`
+
+		var res []event
+		err = db.SelectContext(ctx, &res, query, userID, limit)
+		if err != nil {
+			return fmt.Errorf("db.SelectContext: %w", err)
+		}
+
+		events = make([]app.Event, len(res))
+		for i := range res {
+			events[i] = res[i].convert()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -24,6 +24,7 @@ func TestRepo_Smoke(t *testing.T) {
 		Token: app.Token{
 			Value: "token",
 		},
+		RefreshID: uuid.Must(uuid.NewV4()),
 		UserID:    uuid.Must(uuid.NewV4()),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -40,10 +41,237 @@ func TestRepo_Smoke(t *testing.T) {
 		session.Origin.IP = res.Origin.IP
 	}
 	assert.Equal(session, *res)
+
+	lastOrigin := app.Origin{
+		IP:        net.ParseIP("2001:db8::1"),
+		UserAgent: "curl/7.68.0",
+	}
+	err = r.Touch(ctx, session.ID, lastOrigin)
+	assert.NoError(err)
+
+	touched, err := r.ByID(ctx, session.ID)
+	assert.NoError(err)
+	assert.Equal(res.CreatedAt, touched.CreatedAt)
+	assert.False(touched.UpdatedAt.Before(res.UpdatedAt))
+	assert.True(lastOrigin.Equal(touched.LastOrigin))
+
+	newRefreshID := uuid.Must(uuid.NewV4())
+	newToken := app.Token{Value: "newToken"}
+	err = r.Rotate(ctx, session.ID, session.RefreshID, newRefreshID, newToken)
+	assert.NoError(err)
+
+	rotated, err := r.ByID(ctx, session.ID)
+	assert.NoError(err)
+	assert.Equal(newRefreshID, rotated.RefreshID)
+	assert.Equal(newToken, rotated.Token)
+
+	err = r.Rotate(ctx, session.ID, session.RefreshID, uuid.Must(uuid.NewV4()), app.Token{Value: "reusedToken"})
+	assert.ErrorIs(err, app.ErrNotFound)
+
 	err = r.Delete(ctx, session.ID)
 	assert.NoError(err)
 
 	res, err = r.ByID(ctx, session.ID)
 	assert.Nil(res)
 	assert.ErrorIs(err, app.ErrNotFound)
+
+	err = r.Touch(ctx, session.ID, lastOrigin)
+	assert.ErrorIs(err, app.ErrNotFound)
+}
+
+func TestRepo_SessionWithoutOrigin(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, assert := start(t)
+
+	session := app.Session{
+		ID:        uuid.Must(uuid.NewV4()),
+		Token:     app.Token{Value: "token"},
+		RefreshID: uuid.Must(uuid.NewV4()),
+		UserID:    uuid.Must(uuid.NewV4()),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	err := r.Save(ctx, session)
+	assert.NoError(err)
+
+	res, err := r.ByID(ctx, session.ID)
+	assert.NoError(err)
+	assert.Nil(res.Origin.IP)
+	assert.Nil(res.LastOrigin.IP)
+
+	sessions, err := r.ListByUser(ctx, session.UserID)
+	assert.NoError(err)
+	assert.Len(sessions, 1)
+	assert.Nil(sessions[0].Origin.IP)
+}
+
+func TestRepo_ListAndDeleteByUser(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, assert := start(t)
+
+	var (
+		userID   = uuid.Must(uuid.NewV4())
+		sessions = make([]app.Session, 3)
+	)
+
+	for i := range sessions {
+		sessions[i] = app.Session{
+			ID: uuid.Must(uuid.NewV4()),
+			Origin: app.Origin{
+				IP:        net.ParseIP("192.100.10.4"),
+				UserAgent: "Mozilla/5.0",
+			},
+			Token: app.Token{
+				Value: uuid.Must(uuid.NewV4()).String(),
+			},
+			RefreshID: uuid.Must(uuid.NewV4()),
+			UserID:    userID,
+		}
+
+		err := r.Save(ctx, sessions[i])
+		assert.NoError(err)
+	}
+
+	// Last used session goes first.
+	err := r.Touch(ctx, sessions[1].ID, sessions[1].Origin)
+	assert.NoError(err)
+
+	res, err := r.ByID(ctx, sessions[1].ID)
+	assert.NoError(err)
+
+	list, err := r.ListByUser(ctx, userID)
+	assert.NoError(err)
+	assert.Len(list, len(sessions))
+	assert.Equal(res.ID, list[0].ID)
+
+	removed, err := r.DeleteByUser(ctx, userID, sessions[1].ID)
+	assert.NoError(err)
+	assert.ElementsMatch([]uuid.UUID{sessions[0].ID, sessions[2].ID}, removed)
+
+	list, err = r.ListByUser(ctx, userID)
+	assert.NoError(err)
+	assert.Len(list, 1)
+	assert.Equal(sessions[1].ID, list[0].ID)
+
+	removed, err = r.DeleteByUser(ctx, userID, uuid.Nil)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{sessions[1].ID}, removed)
+
+	list, err = r.ListByUser(ctx, userID)
+	assert.NoError(err)
+	assert.Empty(list)
+}
+
+func TestRepo_DeleteExpired(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, assert := start(t)
+
+	sessions := make([]app.Session, 3)
+	for i := range sessions {
+		sessions[i] = app.Session{
+			ID:        uuid.Must(uuid.NewV4()),
+			Token:     app.Token{Value: uuid.Must(uuid.NewV4()).String()},
+			RefreshID: uuid.Must(uuid.NewV4()),
+			UserID:    uuid.Must(uuid.NewV4()),
+		}
+
+		err := r.Save(ctx, sessions[i])
+		assert.NoError(err)
+	}
+
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+
+	deleted, err := r.DeleteExpired(ctx, past, past, 2)
+	assert.NoError(err)
+	assert.Zero(deleted)
+
+	deleted, err = r.DeleteExpired(ctx, future, past, 2)
+	assert.NoError(err)
+	assert.Equal(2, deleted)
+
+	deleted, err = r.DeleteExpired(ctx, past, future, 2)
+	assert.NoError(err)
+	assert.Equal(1, deleted)
+
+	for i := range sessions {
+		_, err = r.ByID(ctx, sessions[i].ID)
+		assert.ErrorIs(err, app.ErrNotFound)
+	}
+}
+
+func TestRepo_Events(t *testing.T) {
+	t.Parallel()
+
+	ctx, r, assert := start(t)
+
+	var (
+		userID    = uuid.Must(uuid.NewV4())
+		sessionID = uuid.Must(uuid.NewV4())
+		signIn    = app.Event{
+			Kind:      app.EventNewSignIn,
+			UserID:    userID,
+			SessionID: sessionID,
+			Origin: app.Origin{
+				IP:        net.ParseIP("192.100.10.4"),
+				UserAgent: "Mozilla/5.0",
+			},
+			Country: "FR",
+			Reasons: []app.Reason{},
+		}
+		suspicious = app.Event{
+			Kind:      app.EventSuspicious,
+			UserID:    userID,
+			SessionID: sessionID,
+			Origin: app.Origin{
+				IP:        net.ParseIP("10.0.0.1"),
+				UserAgent: "Mozilla/5.0",
+			},
+			Reasons: []app.Reason{app.ReasonSubnet, app.ReasonCountry},
+		}
+	)
+
+	err := r.SaveEvent(ctx, signIn)
+	assert.NoError(err)
+	err = r.SaveEvent(ctx, suspicious)
+	assert.NoError(err)
+	err = r.SaveEvent(ctx, app.Event{Kind: app.EventNewSignIn, UserID: uuid.Must(uuid.NewV4())})
+	assert.NoError(err)
+
+	events, err := r.ListEvents(ctx, userID, 10)
+	assert.NoError(err)
+	assert.Len(events, 2)
+	for i, want := range []app.Event{suspicious, signIn} {
+		assert.NotEqual(uuid.Nil, events[i].ID)
+		assert.False(events[i].CreatedAt.IsZero())
+		assert.True(want.Origin.Equal(events[i].Origin))
+		want.ID, want.CreatedAt, want.Origin = events[i].ID, events[i].CreatedAt, events[i].Origin
+		assert.Equal(want, events[i])
+	}
+
+	events, err = r.ListEvents(ctx, userID, 1)
+	assert.NoError(err)
+	assert.Len(events, 1)
+	assert.Equal(app.EventSuspicious, events[0].Kind)
+
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+
+	deleted, err := r.DeleteEvents(ctx, past, 2)
+	assert.NoError(err)
+	assert.Zero(deleted)
+
+	deleted, err = r.DeleteEvents(ctx, future, 2)
+	assert.NoError(err)
+	assert.Equal(2, deleted)
+
+	deleted, err = r.DeleteEvents(ctx, future, 2)
+	assert.NoError(err)
+	assert.Equal(1, deleted)
+
+	events, err = r.ListEvents(ctx, userID, 10)
+	assert.NoError(err)
+	assert.Empty(events)
 }
@@ -0,0 +1,42 @@
+package session
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// janitorMetrics contains metrics of removed expired sessions and old security events.
+type janitorMetrics struct {
+	sessions prometheus.Counter
+	events   prometheus.Counter
+}
+
+func newJanitorMetrics(reg *prometheus.Registry, namespace string) *janitorMetrics {
+	const subsystem = "janitor"
+
+	metric := &janitorMetrics{
+		sessions: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "sessions_total",
+				Help:      "Amount of removed expired sessions.",
+			},
+		),
+		events: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "events_total",
+				Help:      "Amount of removed old security events.",
+			},
+		),
+	}
+	reg.MustRegister(metric.sessions, metric.events)
+
+	return metric
+}
+
+func (m *janitorMetrics) observe(sessions, events int) {
+	m.sessions.Add(float64(sessions))
+	m.events.Add(float64(events))
+}
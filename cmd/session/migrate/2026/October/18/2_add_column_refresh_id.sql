@@ -0,0 +1,5 @@
+--up
+ALTER TABLE sessions ADD COLUMN refresh_id UUID;
+
+--down
+ALTER TABLE sessions DROP COLUMN refresh_id;
@@ -0,0 +1,5 @@
+--up
+CREATE INDEX sessions_user_id_idx ON sessions (user_id, updated_at DESC);
+
+--down
+DROP INDEX sessions@sessions_user_id_idx;
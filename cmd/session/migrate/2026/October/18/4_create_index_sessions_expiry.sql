@@ -0,0 +1,7 @@
+--up
+CREATE INDEX sessions_created_at_idx ON sessions (created_at);
+CREATE INDEX sessions_updated_at_idx ON sessions (updated_at);
+
+--down
+DROP INDEX sessions@sessions_updated_at_idx;
+DROP INDEX sessions@sessions_created_at_idx;
@@ -0,0 +1,7 @@
+--up
+ALTER TABLE sessions ADD COLUMN last_ip INET;
+ALTER TABLE sessions ADD COLUMN last_user_agent TEXT NOT NULL DEFAULT '';
+
+--down
+ALTER TABLE sessions DROP COLUMN last_user_agent;
+ALTER TABLE sessions DROP COLUMN last_ip;
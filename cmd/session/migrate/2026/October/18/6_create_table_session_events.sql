@@ -0,0 +1,19 @@
+--up
+CREATE TABLE session_events
+(
+    id         UUID      NOT NULL DEFAULT gen_random_uuid(),
+    kind       TEXT      NOT NULL,
+    user_id    UUID      NOT NULL,
+    session_id UUID      NOT NULL,
+    ip         INET,
+    user_agent TEXT      NOT NULL,
+    country    TEXT      NOT NULL,
+    reasons    TEXT[]    NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (id)
+);
+CREATE INDEX session_events_user_id_idx ON session_events (user_id, created_at DESC);
+
+--down
+DROP TABLE session_events;
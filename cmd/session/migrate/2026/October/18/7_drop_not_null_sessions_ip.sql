@@ -0,0 +1,5 @@
+--up
+ALTER TABLE sessions ALTER COLUMN ip DROP NOT NULL;
+
+--down
+ALTER TABLE sessions ALTER COLUMN ip SET NOT NULL;
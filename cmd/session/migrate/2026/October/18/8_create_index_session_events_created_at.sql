@@ -0,0 +1,5 @@
+--up
+CREATE INDEX session_events_created_at_idx ON session_events (created_at);
+
+--down
+DROP INDEX session_events@session_events_created_at_idx;
@@ -3,8 +3,11 @@ package session
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/gofrs/uuid"
 	_ "github.com/lib/pq"
 	"github.com/prometheus/client_golang/prometheus"
@@ -13,6 +16,8 @@ import (
 	"github.com/Meat-Hook/back-template/cmd/session/internal/api/rpc"
 	"github.com/Meat-Hook/back-template/cmd/session/internal/app"
 	"github.com/Meat-Hook/back-template/cmd/session/internal/auth"
+	"github.com/Meat-Hook/back-template/cmd/session/internal/services/cache"
+	"github.com/Meat-Hook/back-template/cmd/session/internal/services/geoip"
 	"github.com/Meat-Hook/back-template/cmd/session/internal/services/repo"
 	"github.com/Meat-Hook/back-template/libs/db"
 	"github.com/Meat-Hook/back-template/libs/log"
@@ -34,11 +39,75 @@ type config struct {
 			Metric int `json:"metric"`
 		} `json:"port"`
 	} `json:"server"`
+	Auth struct {
+		// Purpose contains "local" for encrypted tokens or "public" for signed tokens,
+		// which can be verified by other services without calling session service.
+		Purpose auth.Purpose `json:"purpose"`
+		auth.KeyRing
+		KeyFile        string `json:"key_file"`
+		ReloadInterval string `json:"reload_interval"`
+	} `json:"auth"`
+	Lifetime struct {
+		Idle     string `json:"idle"`
+		Absolute string `json:"absolute"`
+		Access   string `json:"access"`
+	} `json:"lifetime"`
+	Janitor struct {
+		Interval  string `json:"interval"`
+		BatchSize int    `json:"batch_size"`
+		// EventRetention contains duration after which security events are removed.
+		EventRetention string `json:"event_retention"`
+	} `json:"janitor"`
+	Cache struct {
+		// Type contains "memory", "redis" or empty string for disabled cache.
+		Type  string `json:"type"`
+		Size  int    `json:"size"`
+		TTL   string `json:"ttl"`
+		Redis struct {
+			Addr     string `json:"addr"`
+			Password string `json:"password"`
+			DB       int    `json:"db"`
+		} `json:"redis"`
+	} `json:"cache"`
+	Origin struct {
+		BindUserAgent bool `json:"bind_user_agent"`
+		// Subnet and Country contain "flag", "reject" or empty string for disabled check.
+		Subnet     app.Action `json:"subnet"`
+		IPv4Prefix int        `json:"ipv4_prefix"`
+		IPv6Prefix int        `json:"ipv6_prefix"`
+		Country    app.Action `json:"country"`
+		// GeoIPFile contains path of CSV database of IP ranges by country,
+		// countries aren't checked and recorded if it's empty.
+		GeoIPFile string `json:"geoip_file"`
+	} `json:"origin"`
+	// AuthKey is legacy secret key, it's used as single active key if auth keys aren't set.
 	AuthKey string `json:"auth_key"`
 }
 
+// Cache types.
+const (
+	cacheMemory = "memory"
+	cacheRedis  = "redis"
+)
+
 const version = "v0.1.0"
 
+// legacyKeyID is id of key set by legacy auth_key setting.
+const legacyKeyID = "legacy"
+
+var (
+	errNotValidLifetime  = errors.New("not valid session lifetime")
+	errNotValidBatchSize = errors.New("not valid batch size")
+	errNotValidRetention = errors.New("not valid event retention")
+	errNotValidInterval  = errors.New("not valid interval")
+	errUnknownCache      = errors.New("unknown cache type")
+	errNotValidCacheSize = errors.New("not valid cache size")
+	errEmptyRedisAddr    = errors.New("empty redis address")
+	errUnknownAction     = errors.New("unknown origin action")
+	errNotValidPrefix    = errors.New("not valid subnet prefix")
+	errEmptyGeoIPFile    = errors.New("empty GeoIP file")
+)
+
 // Service module implementation.
 type Service struct {
 	cfg config
@@ -50,7 +119,9 @@ func (s *Service) Name() string {
 }
 
 // UnmarshalConfig implements main.embeddedService.
+// Settings missing in buf are kept default, so config of previous versions is still valid.
 func (s *Service) UnmarshalConfig(buf json.RawMessage) error {
+	s.cfg = defaultConfig()
 	err := json.Unmarshal(buf, &s.cfg)
 	if err != nil {
 		return fmt.Errorf("json.Unmarshal: %w", err)
@@ -63,6 +134,47 @@ func (s *Service) UnmarshalConfig(buf json.RawMessage) error {
 func (s *Service) RunServe(ctx context.Context, reg *prometheus.Registry, namespace string) error {
 	logger := zerolog.Ctx(ctx).With().Str(log.Version, version).Logger()
 
+	idle, err := time.ParseDuration(s.cfg.Lifetime.Idle)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	absolute, err := time.ParseDuration(s.cfg.Lifetime.Absolute)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	access, err := time.ParseDuration(s.cfg.Lifetime.Access)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	if idle <= 0 || absolute < idle || access <= 0 {
+		return fmt.Errorf("%w: idle %s, absolute %s, access %s", errNotValidLifetime, idle, absolute, access)
+	}
+
+	janitorInterval, err := time.ParseDuration(s.cfg.Janitor.Interval)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	if janitorInterval <= 0 {
+		return fmt.Errorf("%w: janitor %s", errNotValidInterval, janitorInterval)
+	}
+
+	if s.cfg.Janitor.BatchSize <= 0 {
+		return fmt.Errorf("%w: %d", errNotValidBatchSize, s.cfg.Janitor.BatchSize)
+	}
+
+	eventRetention, err := time.ParseDuration(s.cfg.Janitor.EventRetention)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	if eventRetention <= 0 {
+		return fmt.Errorf("%w: %s", errNotValidRetention, eventRetention)
+	}
+
 	dbMetric := db.NewMetrics(reg, namespace, &repo.Repo{})
 	pg, err := db.Postgres(logger.WithContext(ctx), db.PostgresConfig{
 		DSN:        s.cfg.DB.DSN,
@@ -75,17 +187,90 @@ func (s *Service) RunServe(ctx context.Context, reg *prometheus.Registry, namesp
 
 	// Build contracts.
 	r := repo.New(pg)
-	authModule := auth.New(s.cfg.AuthKey)
+	ring, err := s.keyRing()
+	if err != nil {
+		return fmt.Errorf("s.keyRing: %w", err)
+	}
+
+	authModule, err := auth.New(s.cfg.Auth.Purpose, *ring)
+	if err != nil {
+		return fmt.Errorf("auth.New: %w", err)
+	}
 
-	module := app.New(r, authModule, idGenerator{})
+	sessionCache, listen, err := s.sessionCache(logger, reg, namespace)
+	if err != nil {
+		return fmt.Errorf("s.sessionCache: %w", err)
+	}
+
+	policy, geo, err := s.originPolicy()
+	if err != nil {
+		return fmt.Errorf("s.originPolicy: %w", err)
+	}
+
+	module := app.New(r, authModule, idGenerator{}, app.Lifetime{
+		Idle:     idle,
+		Absolute: absolute,
+		Access:   access,
+	}, sessionCache, geo, *policy)
 
 	grpcAPI := rpc.New(ctx, module, librpc.NewServerMetrics(reg, namespace))
+	janitorMetric := newJanitorMetrics(reg, namespace)
 
-	err = serve.Start(
-		ctx,
+	services := []func(context.Context) error{
 		serve.Metrics(logger.With().Str(log.Subsystem, "metric").Logger(), s.cfg.Server.Host, s.cfg.Server.Port.Metric, reg),
 		serve.GRPC(logger.With().Str(log.Subsystem, "grpc").Logger(), s.cfg.Server.Host, s.cfg.Server.Port.GRPC, grpcAPI),
-	)
+		serve.Periodic(logger.With().Str(log.Subsystem, "janitor").Logger(), janitorInterval, func(ctx context.Context) error {
+			deleted, err := module.DeleteExpired(ctx, s.cfg.Janitor.BatchSize)
+			janitorMetric.observe(deleted, 0)
+			zerolog.Ctx(ctx).Info().Int("deleted", deleted).Msg("expired sessions removed")
+			if err != nil {
+				return fmt.Errorf("module.DeleteExpired: %w", err)
+			}
+
+			deleted, err = module.DeleteEvents(ctx, eventRetention, s.cfg.Janitor.BatchSize)
+			janitorMetric.observe(0, deleted)
+			zerolog.Ctx(ctx).Info().Int("deleted", deleted).Msg("old security events removed")
+			if err != nil {
+				return fmt.Errorf("module.DeleteEvents: %w", err)
+			}
+
+			return nil
+		}),
+	}
+
+	if listen != nil {
+		services = append(services, listen)
+	}
+
+	// Keys from file are reloaded, so they can be rotated without restart.
+	if s.cfg.Auth.KeyFile != "" {
+		reloadInterval, err := time.ParseDuration(s.cfg.Auth.ReloadInterval)
+		if err != nil {
+			return fmt.Errorf("time.ParseDuration: %w", err)
+		}
+
+		if reloadInterval <= 0 {
+			return fmt.Errorf("%w: reload %s", errNotValidInterval, reloadInterval)
+		}
+
+		services = append(services, serve.Periodic(logger.With().Str(log.Subsystem, "auth_keys").Logger(), reloadInterval, func(ctx context.Context) error {
+			ring, err := auth.ReadKeyFile(s.cfg.Auth.KeyFile)
+			if err != nil {
+				return fmt.Errorf("auth.ReadKeyFile: %w", err)
+			}
+
+			err = authModule.Reload(*ring)
+			if err != nil {
+				return fmt.Errorf("authModule.Reload: %w", err)
+			}
+
+			zerolog.Ctx(ctx).Debug().Str("active_key_id", ring.ActiveKeyID).Int("keys", len(ring.Keys)).Msg("auth keys reloaded")
+
+			return nil
+		}))
+	}
+
+	err = serve.Start(ctx, services...)
 	if err != nil {
 		return fmt.Errorf("serve.Start: %w", err)
 	}
@@ -93,6 +278,142 @@ func (s *Service) RunServe(ctx context.Context, reg *prometheus.Registry, namesp
 	return nil
 }
 
+// keyRing returns keys from key file if it's set, otherwise from config.
+// Legacy auth key is used if config has no keys.
+func (s *Service) keyRing() (*auth.KeyRing, error) {
+	switch {
+	case s.cfg.Auth.KeyFile == "" && len(s.cfg.Auth.Keys) == 0 && s.cfg.AuthKey != "":
+		return &auth.KeyRing{
+			ActiveKeyID: legacyKeyID,
+			Keys:        map[string]string{legacyKeyID: s.cfg.AuthKey},
+		}, nil
+	case s.cfg.Auth.KeyFile == "":
+		return &s.cfg.Auth.KeyRing, nil
+	}
+
+	ring, err := auth.ReadKeyFile(s.cfg.Auth.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("auth.ReadKeyFile: %w", err)
+	}
+
+	return ring, nil
+}
+
+// sessionCache returns cache chosen by config, nil if cache is disabled.
+// Returned service removes sessions revoked by other instances from memory cache,
+// it's nil if there is nothing to listen.
+func (s *Service) sessionCache(logger zerolog.Logger, reg *prometheus.Registry, namespace string) (app.Cache, func(context.Context) error, error) {
+	if s.cfg.Cache.Type == "" {
+		return nil, nil, nil
+	}
+
+	ttl, err := time.ParseDuration(s.cfg.Cache.TTL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	var client *redis.Client
+	if s.cfg.Cache.Redis.Addr != "" {
+		client = redis.NewClient(&redis.Options{
+			Addr:     s.cfg.Cache.Redis.Addr,
+			Password: s.cfg.Cache.Redis.Password,
+			DB:       s.cfg.Cache.Redis.DB,
+		})
+	}
+
+	metric := cache.Metric(cache.NewMetrics(reg, namespace))
+
+	switch s.cfg.Cache.Type {
+	case cacheMemory:
+		if s.cfg.Cache.Size <= 0 {
+			return nil, nil, fmt.Errorf("%w: %d", errNotValidCacheSize, s.cfg.Cache.Size)
+		}
+
+		// Single instance doesn't need broadcasting of revoked sessions.
+		if client == nil {
+			return cache.NewMemory(s.cfg.Cache.Size, ttl, metric), nil, nil
+		}
+
+		broadcaster := cache.NewBroadcaster(client)
+		memory := cache.NewMemory(s.cfg.Cache.Size, ttl, metric, cache.Broadcast(broadcaster))
+		logger = logger.With().Str(log.Subsystem, "cache_revoke").Logger()
+		listen := func(ctx context.Context) error {
+			logger.Info().Msg("started")
+			defer logger.Info().Msg("shutdown")
+
+			return broadcaster.Listen(logger.WithContext(ctx), memory.Remove)
+		}
+
+		return memory, listen, nil
+	case cacheRedis:
+		if client == nil {
+			return nil, nil, errEmptyRedisAddr
+		}
+
+		return cache.NewRedis(client, ttl, metric), nil, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %q", errUnknownCache, s.cfg.Cache.Type)
+	}
+}
+
+// originPolicy returns policy of checking session origin and GeoIP database,
+// GeoIP is nil if its file isn't set.
+func (s *Service) originPolicy() (*app.Policy, app.GeoIP, error) {
+	cfg := s.cfg.Origin
+	for _, action := range []app.Action{cfg.Subnet, cfg.Country} {
+		if action != app.ActionNone && action != app.ActionFlag && action != app.ActionReject {
+			return nil, nil, fmt.Errorf("%w: %q", errUnknownAction, action)
+		}
+	}
+
+	if cfg.Subnet != app.ActionNone && (cfg.IPv4Prefix <= 0 || cfg.IPv4Prefix > 32 || cfg.IPv6Prefix <= 0 || cfg.IPv6Prefix > 128) {
+		return nil, nil, fmt.Errorf("%w: ipv4 %d, ipv6 %d", errNotValidPrefix, cfg.IPv4Prefix, cfg.IPv6Prefix)
+	}
+
+	policy := &app.Policy{
+		BindUserAgent: cfg.BindUserAgent,
+		Subnet:        cfg.Subnet,
+		IPv4Prefix:    cfg.IPv4Prefix,
+		IPv6Prefix:    cfg.IPv6Prefix,
+		Country:       cfg.Country,
+	}
+
+	if cfg.GeoIPFile == "" {
+		if cfg.Country != app.ActionNone {
+			return nil, nil, errEmptyGeoIPFile
+		}
+
+		return policy, nil, nil
+	}
+
+	geo, err := geoip.Open(cfg.GeoIPFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("geoip.Open: %w", err)
+	}
+
+	return policy, geo, nil
+}
+
+// defaultConfig returns config with defaults of settings added after auth key rotation,
+// session cache and origin checks are disabled by default.
+func defaultConfig() config {
+	cfg := config{}
+	cfg.Auth.Purpose = auth.PurposeLocal
+	cfg.Auth.ReloadInterval = "1m"
+	cfg.Lifetime.Idle = "72h"
+	cfg.Lifetime.Absolute = "720h"
+	cfg.Lifetime.Access = "15m"
+	cfg.Janitor.Interval = "10m"
+	cfg.Janitor.BatchSize = 500
+	cfg.Janitor.EventRetention = "2160h"
+	cfg.Cache.Size = 10000
+	cfg.Cache.TTL = "30s"
+	cfg.Origin.IPv4Prefix = 24
+	cfg.Origin.IPv6Prefix = 64
+
+	return cfg
+}
+
 var _ app.ID = &idGenerator{}
 
 type idGenerator struct{}
@@ -0,0 +1,167 @@
+// Package token contains format of session tokens shared by session service and its clients,
+// so public tokens can be verified without calling session service.
+package token
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/o1egl/paseto/v2"
+)
+
+// Errors.
+var (
+	ErrInvalid = errors.New("not valid auth")
+	ErrExpired = errors.New("session expired")
+)
+
+// Kind contains purpose of token.
+type Kind string
+
+// Token kinds.
+const (
+	Access  Kind = "access"
+	Refresh Kind = "refresh"
+)
+
+// Claims contains info saved in token.
+type Claims struct {
+	SessionID uuid.UUID
+	// UserID contains owner of session, so token can be verified without repository.
+	UserID uuid.UUID
+	Kind   Kind
+	// TokenID contains id of refresh token, empty for access token.
+	TokenID uuid.UUID
+	// ExpiresAt contains time after which token isn't accepted.
+	ExpiresAt time.Time
+}
+
+// Footer is kept unencrypted in token and contains id of key which token is encrypted by.
+type Footer struct {
+	KeyID string `json:"kid"`
+}
+
+// Keys of custom claims.
+const (
+	sessionClaim = "session_id"
+	userClaim    = "user_id"
+	kindClaim    = "kind"
+)
+
+// JSON returns paseto token with claims issued at now.
+// Token contains "exp", "iat" and "nbf" claims besides session id, user id and token kind,
+// refresh token id is kept in "jti" claim.
+func JSON(claims Claims, now time.Time) paseto.JSONToken {
+	t := paseto.JSONToken{
+		Expiration: claims.ExpiresAt,
+		IssuedAt:   now,
+		NotBefore:  now,
+	}
+	t.Set(sessionClaim, claims.SessionID.String())
+	t.Set(kindClaim, string(claims.Kind))
+	if claims.UserID != uuid.Nil {
+		t.Set(userClaim, claims.UserID.String())
+	}
+	if claims.TokenID != uuid.Nil {
+		t.Jti = claims.TokenID.String()
+	}
+
+	return t
+}
+
+// Parse validates token claims and returns them.
+// Tokens without time claims are accepted, their lifetime is limited by session.
+// Tokens without kind are access tokens.
+// Errors: ErrInvalid, ErrExpired.
+func Parse(t paseto.JSONToken) (*Claims, error) {
+	now := time.Now()
+	if !t.Expiration.IsZero() && now.After(t.Expiration) {
+		return nil, fmt.Errorf("%w: token expired at %s", ErrExpired, t.Expiration)
+	}
+
+	err := t.Validate(paseto.ValidAt(now))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+
+	var claim string
+	err = t.Get(sessionClaim, &claim)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+
+	sessionID, err := uuid.FromString(claim)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+
+	// Tokens issued before public tokens were added don't contain user id.
+	userID := uuid.Nil
+	err = t.Get(userClaim, &claim)
+	switch {
+	case errors.Is(err, paseto.ErrClaimNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	default:
+		userID, err = uuid.FromString(claim)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+	}
+
+	kind := Access
+	err = t.Get(kindClaim, &claim)
+	switch {
+	case errors.Is(err, paseto.ErrClaimNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	default:
+		kind = Kind(claim)
+	}
+
+	tokenID := uuid.Nil
+	if t.Jti != "" {
+		tokenID, err = uuid.FromString(t.Jti)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+		}
+	}
+
+	claims := &Claims{
+		SessionID: sessionID,
+		UserID:    userID,
+		Kind:      kind,
+		TokenID:   tokenID,
+		ExpiresAt: t.Expiration,
+	}
+
+	return claims, nil
+}
+
+// KeyID returns id of key which token is encrypted or signed by,
+// empty for tokens issued before key rotation was added.
+func KeyID(token string) (string, error) {
+	f := Footer{}
+	err := paseto.ParseFooter(token, &f)
+	if err != nil {
+		return "", fmt.Errorf("paseto.ParseFooter: %w", err)
+	}
+
+	return f.KeyID, nil
+}
+
+// Verify verifies public token by key outside session service and returns its claims.
+// Errors: ErrInvalid, ErrExpired.
+func Verify(token string, key ed25519.PublicKey) (*Claims, error) {
+	t := paseto.JSONToken{}
+
+	err := paseto.Verify(token, key, &t, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+
+	return Parse(t)
+}
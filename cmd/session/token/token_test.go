@@ -0,0 +1,92 @@
+package token_test
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/o1egl/paseto/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Meat-Hook/back-template/cmd/session/token"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second).UTC()
+	claims := token.Claims{
+		SessionID: uuid.Must(uuid.NewV4()),
+		UserID:    uuid.Must(uuid.NewV4()),
+		Kind:      token.Refresh,
+		TokenID:   uuid.Must(uuid.NewV4()),
+		ExpiresAt: now.Add(time.Hour),
+	}
+	expired := claims
+	expired.ExpiresAt = now.Add(-time.Hour)
+	// Tokens issued before public tokens were added.
+	legacy := paseto.JSONToken{}
+	legacy.Set("session_id", claims.SessionID.String())
+	noSession := paseto.JSONToken{Expiration: claims.ExpiresAt}
+	notValidUser := token.JSON(claims, now)
+	notValidUser.Set("user_id", "not valid")
+	notYetValid := token.JSON(claims, now.Add(time.Hour))
+
+	testCases := []struct {
+		name    string
+		token   paseto.JSONToken
+		want    *token.Claims
+		wantErr error
+	}{
+		{"success", token.JSON(claims, now), &claims, nil},
+		{"success_legacy", legacy, &token.Claims{SessionID: claims.SessionID, Kind: token.Access}, nil},
+		{"err_expired", token.JSON(expired, now), nil, token.ErrExpired},
+		{"err_not_yet_valid", notYetValid, nil, token.ErrInvalid},
+		{"err_no_session", noSession, nil, token.ErrInvalid},
+		{"err_not_valid_user", notValidUser, nil, token.ErrInvalid},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert := require.New(t)
+
+			res, err := token.Parse(tc.token)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	assert := require.New(t)
+	public, private, err := ed25519.GenerateKey(nil)
+	assert.NoError(err)
+	otherPublic, _, err := ed25519.GenerateKey(nil)
+	assert.NoError(err)
+
+	claims := token.Claims{
+		SessionID: uuid.Must(uuid.NewV4()),
+		UserID:    uuid.Must(uuid.NewV4()),
+		Kind:      token.Access,
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second).UTC(),
+	}
+	value, err := paseto.Sign(private, token.JSON(claims, time.Now()), token.Footer{KeyID: "2026-10"})
+	assert.NoError(err)
+
+	keyID, err := token.KeyID(value)
+	assert.NoError(err)
+	assert.Equal("2026-10", keyID)
+
+	res, err := token.Verify(value, public)
+	assert.NoError(err)
+	assert.Equal(&claims, res)
+
+	_, err = token.Verify(value, otherPublic)
+	assert.ErrorIs(err, token.ErrInvalid)
+}
@@ -9,7 +9,6 @@ import (
 	"net/http"
 	"path"
 	"strings"
-	"time"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime"
@@ -38,15 +37,21 @@ type (
 		ListUserByUsername(ctx context.Context, session app.Session, username string, page app.SearchParams) ([]app.User, int, error)
 		UpdateUsername(ctx context.Context, session app.Session, username string) error
 		UpdatePassword(ctx context.Context, session app.Session, oldPass string, newPass string) error
-		Login(ctx context.Context, email, password string, origin app.Origin) (*app.Token, error)
+		Login(ctx context.Context, email, password string, origin app.Origin) (*app.TokenPair, error)
+		Refresh(ctx context.Context, refreshToken string) (*app.TokenPair, error)
 		Logout(ctx context.Context, session app.Session) error
-		Auth(ctx context.Context, token string) (*app.Session, error)
+		ListSessions(ctx context.Context, session app.Session) ([]app.SessionInfo, error)
+		RemoveOtherSessions(ctx context.Context, session app.Session) error
+		ListSecurityEvents(ctx context.Context, session app.Session, limit int) ([]app.SecurityEvent, error)
+		Auth(ctx context.Context, token string, origin app.Origin) (*app.Session, error)
 		UploadAvatar(ctx context.Context, session app.Session, file io.Reader) error
 		DeleteAvatar(ctx context.Context, session app.Session, fileID uuid.UUID) error
 	}
 
 	service struct {
 		app application
+		// refreshPath limits refresh token cookie to refresh endpoint.
+		refreshPath string
 	}
 	// Config for start server.
 	Config struct {
@@ -57,10 +62,6 @@ type (
 
 // New returns Swagger server configured to listen on the TCP network.
 func New(ctx context.Context, module application, m *web.Metric, cfg Config) (*restapi.Server, error) {
-	svc := &service{
-		app: module,
-	}
-
 	logger := zerolog.Ctx(ctx)
 
 	swaggerSpec, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
@@ -68,6 +69,11 @@ func New(ctx context.Context, module application, m *web.Metric, cfg Config) (*r
 		return nil, fmt.Errorf("load embedded swagger spec: %w", err)
 	}
 
+	svc := &service{
+		app:         module,
+		refreshPath: path.Join(swaggerSpec.BasePath(), "/refresh"),
+	}
+
 	api := operations.NewUserServiceAPI(swaggerSpec)
 	swaggerLogger := logger.With().Str(log.Subsystem, "swagger").Logger()
 	api.Logger = swaggerLogger.Printf
@@ -87,7 +93,11 @@ func New(ctx context.Context, module application, m *web.Metric, cfg Config) (*r
 	api.UpdateUsernameHandler = operations.UpdateUsernameHandlerFunc(svc.updateUsername)
 	api.GetUsersHandler = operations.GetUsersHandlerFunc(svc.getUsers)
 	api.LoginHandler = operations.LoginHandlerFunc(svc.login)
+	api.RefreshHandler = operations.RefreshHandlerFunc(svc.refresh)
 	api.LogoutHandler = operations.LogoutHandlerFunc(svc.logout)
+	api.ListSessionsHandler = operations.ListSessionsHandlerFunc(svc.listSessions)
+	api.RemoveOtherSessionsHandler = operations.RemoveOtherSessionsHandlerFunc(svc.removeOtherSessions)
+	api.ListSecurityEventsHandler = operations.ListSecurityEventsHandlerFunc(svc.listSecurityEvents)
 	api.NewAvatarHandler = operations.NewAvatarHandlerFunc(svc.uploadAvatar)
 	api.DeleteAvatarHandler = operations.DeleteAvatarHandlerFunc(svc.deleteAvatar)
 
@@ -128,13 +138,13 @@ func fromRequest(r *http.Request, session *app.Session) (context.Context, zerolo
 	return ctx, logger, net.ParseIP(remoteIP)
 }
 
-func generateCookie(token string) *http.Cookie {
+func generateCookie(name, path string, token app.Token) *http.Cookie {
 	cookie := &http.Cookie{
-		Name:       cookieTokenName,
-		Value:      token,
-		Path:       "/",
+		Name:       name,
+		Value:      token.Value,
+		Path:       path,
 		Domain:     "",
-		Expires:    time.Time{},
+		Expires:    token.ExpiresAt,
 		RawExpires: "",
 		MaxAge:     0,
 		Secure:     true,
@@ -147,6 +157,38 @@ func generateCookie(token string) *http.Cookie {
 	return cookie
 }
 
+// tokensResponder sets cookies with session auth and refresh token
+// before writing response, because generated response sets one cookie only.
+type tokensResponder struct {
+	swag_middleware.Responder
+	cookies []*http.Cookie
+}
+
+func (s *service) withTokens(r swag_middleware.Responder, tokens app.TokenPair) tokensResponder {
+	return tokensResponder{
+		Responder: r,
+		cookies: []*http.Cookie{
+			generateCookie(cookieTokenName, "/", tokens.Access),
+			generateCookie(cookieRefreshName, s.refreshPath, tokens.Refresh),
+		},
+	}
+}
+
+// WriteResponse implements swag_middleware.Responder.
+func (t tokensResponder) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	for _, cookie := range t.cookies {
+		http.SetCookie(rw, cookie)
+	}
+
+	t.Responder.WriteResponse(rw, producer)
+}
+
+// LoginResponder implements operations.LoginResponder.
+func (tokensResponder) LoginResponder() {}
+
+// RefreshResponder implements operations.RefreshResponder.
+func (tokensResponder) RefreshResponder() {}
+
 func (s *service) authorizerFunc(name, in string, _ security.TokenAuthentication) runtime.Authenticator {
 	const (
 		query  = "query"
@@ -173,7 +215,11 @@ func (s *service) authorizerFunc(name, in string, _ security.TokenAuthentication
 			return false, nil, nil
 		}
 
-		p, err := s.cookieKeyAuth(r.Context(), token)
+		_, _, remoteIP := fromRequest(r, nil)
+		p, err := s.cookieKeyAuth(r.Context(), token, app.Origin{
+			IP:        remoteIP,
+			UserAgent: r.Header.Get("User-Agent"),
+		})
 
 		return true, p, err
 	})
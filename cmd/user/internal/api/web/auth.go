@@ -12,11 +12,12 @@ import (
 )
 
 const (
-	cookieTokenName = "authKey"
+	cookieTokenName   = "authKey"
+	cookieRefreshName = "refreshKey"
 )
 
-func (s *service) cookieKeyAuth(ctx context.Context, raw string) (*app.Session, error) {
-	session, err := s.app.Auth(ctx, parseToken(raw))
+func (s *service) cookieKeyAuth(ctx context.Context, raw string, origin app.Origin) (*app.Session, error) {
+	session, err := s.app.Auth(ctx, parseToken(raw), origin)
 	switch {
 	case errors.Is(err, app.ErrNotFound):
 		return nil, unautnError.Unauthenticated("user")
@@ -28,10 +29,14 @@ func (s *service) cookieKeyAuth(ctx context.Context, raw string) (*app.Session,
 }
 
 func parseToken(raw string) string {
+	return parseCookie(raw, cookieTokenName)
+}
+
+func parseCookie(raw, name string) string {
 	header := http.Header{}
 	header.Add("Cookie", raw)
 	request := http.Request{Header: header}
-	cookieKey, err := request.Cookie(cookieTokenName)
+	cookieKey, err := request.Cookie(name)
 	if err != nil {
 		return ""
 	}
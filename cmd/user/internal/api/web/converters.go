@@ -51,3 +51,75 @@ func User(u *app.User) *models.User {
 		Avatars:  avatars,
 	}
 }
+
+// Sessions conversion []app.SessionInfo => []*models.Session.
+func Sessions(s []app.SessionInfo) []*models.Session {
+	sessions := make([]*models.Session, len(s))
+
+	for i := range sessions {
+		sessions[i] = Session(&s[i])
+	}
+
+	return sessions
+}
+
+// Session conversion app.SessionInfo => models.Session.
+func Session(s *app.SessionInfo) *models.Session {
+	id := strfmt.UUID(s.ID.String())
+	createdAt := strfmt.DateTime(s.CreatedAt)
+	lastSeenAt := strfmt.DateTime(s.LastSeenAt)
+
+	ip := ""
+	if s.IP != nil {
+		ip = s.IP.String()
+	}
+
+	return &models.Session{
+		ID:         &id,
+		IP:         &ip,
+		UserAgent:  swag.String(s.UserAgent),
+		CreatedAt:  &createdAt,
+		LastSeenAt: &lastSeenAt,
+		Current:    swag.Bool(s.Current),
+	}
+}
+
+// SecurityEvents conversion []app.SecurityEvent => []*models.SecurityEvent.
+func SecurityEvents(e []app.SecurityEvent) []*models.SecurityEvent {
+	events := make([]*models.SecurityEvent, len(e))
+
+	for i := range events {
+		events[i] = SecurityEvent(&e[i])
+	}
+
+	return events
+}
+
+// SecurityEvent conversion app.SecurityEvent => models.SecurityEvent.
+func SecurityEvent(e *app.SecurityEvent) *models.SecurityEvent {
+	id := strfmt.UUID(e.ID.String())
+	sessionID := strfmt.UUID(e.SessionID.String())
+	createdAt := strfmt.DateTime(e.CreatedAt)
+
+	ip := ""
+	if e.IP != nil {
+		ip = e.IP.String()
+	}
+
+	reasons := e.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+
+	return &models.SecurityEvent{
+		ID:        &id,
+		Kind:      swag.String(string(e.Kind)),
+		SessionID: &sessionID,
+		IP:        &ip,
+		UserAgent: swag.String(e.UserAgent),
+		Country:   swag.String(e.Country),
+		Reasons:   reasons,
+		CreatedAt: &createdAt,
+		Current:   swag.Bool(e.Current),
+	}
+}
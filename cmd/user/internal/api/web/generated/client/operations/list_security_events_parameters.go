@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListSecurityEventsParams creates a new ListSecurityEventsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListSecurityEventsParams() *ListSecurityEventsParams {
+	return &ListSecurityEventsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListSecurityEventsParamsWithTimeout creates a new ListSecurityEventsParams object
+// with the ability to set a timeout on a request.
+func NewListSecurityEventsParamsWithTimeout(timeout time.Duration) *ListSecurityEventsParams {
+	return &ListSecurityEventsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListSecurityEventsParamsWithContext creates a new ListSecurityEventsParams object
+// with the ability to set a context for a request.
+func NewListSecurityEventsParamsWithContext(ctx context.Context) *ListSecurityEventsParams {
+	return &ListSecurityEventsParams{
+		Context: ctx,
+	}
+}
+
+// NewListSecurityEventsParamsWithHTTPClient creates a new ListSecurityEventsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListSecurityEventsParamsWithHTTPClient(client *http.Client) *ListSecurityEventsParams {
+	return &ListSecurityEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListSecurityEventsParams contains all the parameters to send to the API endpoint
+   for the list security events operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListSecurityEventsParams struct {
+
+	// Limit.
+	//
+	// Format: int32
+	// Default: 20
+	Limit *int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list security events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListSecurityEventsParams) WithDefaults() *ListSecurityEventsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list security events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListSecurityEventsParams) SetDefaults() {
+	var (
+		limitDefault = int32(20)
+	)
+
+	val := ListSecurityEventsParams{
+		Limit: &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the list security events params
+func (o *ListSecurityEventsParams) WithTimeout(timeout time.Duration) *ListSecurityEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list security events params
+func (o *ListSecurityEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list security events params
+func (o *ListSecurityEventsParams) WithContext(ctx context.Context) *ListSecurityEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list security events params
+func (o *ListSecurityEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list security events params
+func (o *ListSecurityEventsParams) WithHTTPClient(client *http.Client) *ListSecurityEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list security events params
+func (o *ListSecurityEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the list security events params
+func (o *ListSecurityEventsParams) WithLimit(limit *int32) *ListSecurityEventsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list security events params
+func (o *ListSecurityEventsParams) SetLimit(limit *int32) {
+	o.Limit = limit
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListSecurityEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int32
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt32(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
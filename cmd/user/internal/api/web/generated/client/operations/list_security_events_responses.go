@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/api/web/generated/models"
+)
+
+// ListSecurityEventsReader is a Reader for the ListSecurityEvents structure.
+type ListSecurityEventsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListSecurityEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListSecurityEventsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListSecurityEventsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListSecurityEventsOK creates a ListSecurityEventsOK with default headers values
+func NewListSecurityEventsOK() *ListSecurityEventsOK {
+	return &ListSecurityEventsOK{}
+}
+
+/* ListSecurityEventsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListSecurityEventsOK struct {
+	Payload []*models.SecurityEvent
+}
+
+func (o *ListSecurityEventsOK) Error() string {
+	return fmt.Sprintf("[GET /security-events][%d] listSecurityEventsOK  %+v", 200, o.Payload)
+}
+func (o *ListSecurityEventsOK) GetPayload() []*models.SecurityEvent {
+	return o.Payload
+}
+
+func (o *ListSecurityEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListSecurityEventsDefault creates a ListSecurityEventsDefault with default headers values
+func NewListSecurityEventsDefault(code int) *ListSecurityEventsDefault {
+	return &ListSecurityEventsDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListSecurityEventsDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type ListSecurityEventsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list security events default response
+func (o *ListSecurityEventsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListSecurityEventsDefault) Error() string {
+	return fmt.Sprintf("[GET /security-events][%d] listSecurityEvents default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListSecurityEventsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListSecurityEventsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListSessionsParams creates a new ListSessionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListSessionsParams() *ListSessionsParams {
+	return &ListSessionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListSessionsParamsWithTimeout creates a new ListSessionsParams object
+// with the ability to set a timeout on a request.
+func NewListSessionsParamsWithTimeout(timeout time.Duration) *ListSessionsParams {
+	return &ListSessionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListSessionsParamsWithContext creates a new ListSessionsParams object
+// with the ability to set a context for a request.
+func NewListSessionsParamsWithContext(ctx context.Context) *ListSessionsParams {
+	return &ListSessionsParams{
+		Context: ctx,
+	}
+}
+
+// NewListSessionsParamsWithHTTPClient creates a new ListSessionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListSessionsParamsWithHTTPClient(client *http.Client) *ListSessionsParams {
+	return &ListSessionsParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListSessionsParams contains all the parameters to send to the API endpoint
+   for the list sessions operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListSessionsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListSessionsParams) WithDefaults() *ListSessionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListSessionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list sessions params
+func (o *ListSessionsParams) WithTimeout(timeout time.Duration) *ListSessionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list sessions params
+func (o *ListSessionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list sessions params
+func (o *ListSessionsParams) WithContext(ctx context.Context) *ListSessionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list sessions params
+func (o *ListSessionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list sessions params
+func (o *ListSessionsParams) WithHTTPClient(client *http.Client) *ListSessionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list sessions params
+func (o *ListSessionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListSessionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/api/web/generated/models"
+)
+
+// ListSessionsReader is a Reader for the ListSessions structure.
+type ListSessionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListSessionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListSessionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListSessionsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListSessionsOK creates a ListSessionsOK with default headers values
+func NewListSessionsOK() *ListSessionsOK {
+	return &ListSessionsOK{}
+}
+
+/* ListSessionsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListSessionsOK struct {
+	Payload []*models.Session
+}
+
+func (o *ListSessionsOK) Error() string {
+	return fmt.Sprintf("[GET /sessions][%d] listSessionsOK  %+v", 200, o.Payload)
+}
+func (o *ListSessionsOK) GetPayload() []*models.Session {
+	return o.Payload
+}
+
+func (o *ListSessionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListSessionsDefault creates a ListSessionsDefault with default headers values
+func NewListSessionsDefault(code int) *ListSessionsDefault {
+	return &ListSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListSessionsDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type ListSessionsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list sessions default response
+func (o *ListSessionsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListSessionsDefault) Error() string {
+	return fmt.Sprintf("[GET /sessions][%d] listSessions default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListSessionsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListSessionsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -52,7 +52,7 @@ OK
 */
 type LoginOK struct {
 
-	/* Session auth.
+	/* Session auth and refresh token, each in its own header.
 	 */
 	SetCookie string
 }
@@ -38,12 +38,20 @@ type ClientService interface {
 
 	GetUsers(params *GetUsersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUsersOK, error)
 
+	ListSecurityEvents(params *ListSecurityEventsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListSecurityEventsOK, error)
+
+	ListSessions(params *ListSessionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListSessionsOK, error)
+
 	Login(params *LoginParams, opts ...ClientOption) (*LoginOK, error)
 
 	Logout(params *LogoutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*LogoutNoContent, error)
 
 	NewAvatar(params *NewAvatarParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NewAvatarNoContent, error)
 
+	Refresh(params *RefreshParams, opts ...ClientOption) (*RefreshOK, error)
+
+	RemoveOtherSessions(params *RemoveOtherSessionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RemoveOtherSessionsNoContent, error)
+
 	UpdatePassword(params *UpdatePasswordParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdatePasswordNoContent, error)
 
 	UpdateUsername(params *UpdateUsernameParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateUsernameNoContent, error)
@@ -244,6 +252,82 @@ func (a *Client) GetUsers(params *GetUsersParams, authInfo runtime.ClientAuthInf
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  ListSecurityEvents List last security events of user, e.g. new sign-ins, most recent first.
+*/
+func (a *Client) ListSecurityEvents(params *ListSecurityEventsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListSecurityEventsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListSecurityEventsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listSecurityEvents",
+		Method:             "GET",
+		PathPattern:        "/security-events",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListSecurityEventsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListSecurityEventsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListSecurityEventsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListSessions List active sessions of user, most recently used first.
+*/
+func (a *Client) ListSessions(params *ListSessionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListSessionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListSessionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listSessions",
+		Method:             "GET",
+		PathPattern:        "/sessions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListSessionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListSessionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListSessionsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   Login Login for user.
 */
@@ -357,6 +441,83 @@ func (a *Client) NewAvatar(params *NewAvatarParams, authInfo runtime.ClientAuthI
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  Refresh Exchange refresh token for new session auth and refresh token.
+Every refresh token can be used once, reused refresh token ends session.
+
+*/
+func (a *Client) Refresh(params *RefreshParams, opts ...ClientOption) (*RefreshOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRefreshParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "refresh",
+		Method:             "POST",
+		PathPattern:        "/refresh",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RefreshReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RefreshOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RefreshDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  RemoveOtherSessions Sign out everywhere except current session, e.g. after password change.
+*/
+func (a *Client) RemoveOtherSessions(params *RemoveOtherSessionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RemoveOtherSessionsNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRemoveOtherSessionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "removeOtherSessions",
+		Method:             "DELETE",
+		PathPattern:        "/sessions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RemoveOtherSessionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RemoveOtherSessionsNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RemoveOtherSessionsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   UpdatePassword Change password.
 */
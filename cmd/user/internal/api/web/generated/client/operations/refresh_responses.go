@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/api/web/generated/models"
+)
+
+// RefreshReader is a Reader for the Refresh structure.
+type RefreshReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RefreshReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRefreshOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRefreshDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRefreshOK creates a RefreshOK with default headers values
+func NewRefreshOK() *RefreshOK {
+	return &RefreshOK{}
+}
+
+/* RefreshOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RefreshOK struct {
+
+	/* Session auth and refresh token, each in its own header.
+	 */
+	SetCookie string
+}
+
+func (o *RefreshOK) Error() string {
+	return fmt.Sprintf("[POST /refresh][%d] refreshOK ", 200)
+}
+
+func (o *RefreshOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Set-Cookie
+	hdrSetCookie := response.GetHeader("Set-Cookie")
+
+	if hdrSetCookie != "" {
+		o.SetCookie = hdrSetCookie
+	}
+
+	return nil
+}
+
+// NewRefreshDefault creates a RefreshDefault with default headers values
+func NewRefreshDefault(code int) *RefreshDefault {
+	return &RefreshDefault{
+		_statusCode: code,
+	}
+}
+
+/* RefreshDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type RefreshDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the refresh default response
+func (o *RefreshDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RefreshDefault) Error() string {
+	return fmt.Sprintf("[POST /refresh][%d] refresh default  %+v", o._statusCode, o.Payload)
+}
+func (o *RefreshDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RefreshDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewRemoveOtherSessionsParams creates a new RemoveOtherSessionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRemoveOtherSessionsParams() *RemoveOtherSessionsParams {
+	return &RemoveOtherSessionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRemoveOtherSessionsParamsWithTimeout creates a new RemoveOtherSessionsParams object
+// with the ability to set a timeout on a request.
+func NewRemoveOtherSessionsParamsWithTimeout(timeout time.Duration) *RemoveOtherSessionsParams {
+	return &RemoveOtherSessionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewRemoveOtherSessionsParamsWithContext creates a new RemoveOtherSessionsParams object
+// with the ability to set a context for a request.
+func NewRemoveOtherSessionsParamsWithContext(ctx context.Context) *RemoveOtherSessionsParams {
+	return &RemoveOtherSessionsParams{
+		Context: ctx,
+	}
+}
+
+// NewRemoveOtherSessionsParamsWithHTTPClient creates a new RemoveOtherSessionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRemoveOtherSessionsParamsWithHTTPClient(client *http.Client) *RemoveOtherSessionsParams {
+	return &RemoveOtherSessionsParams{
+		HTTPClient: client,
+	}
+}
+
+/* RemoveOtherSessionsParams contains all the parameters to send to the API endpoint
+   for the remove other sessions operation.
+
+   Typically these are written to a http.Request.
+*/
+type RemoveOtherSessionsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the remove other sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RemoveOtherSessionsParams) WithDefaults() *RemoveOtherSessionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the remove other sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RemoveOtherSessionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the remove other sessions params
+func (o *RemoveOtherSessionsParams) WithTimeout(timeout time.Duration) *RemoveOtherSessionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the remove other sessions params
+func (o *RemoveOtherSessionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the remove other sessions params
+func (o *RemoveOtherSessionsParams) WithContext(ctx context.Context) *RemoveOtherSessionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the remove other sessions params
+func (o *RemoveOtherSessionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the remove other sessions params
+func (o *RemoveOtherSessionsParams) WithHTTPClient(client *http.Client) *RemoveOtherSessionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the remove other sessions params
+func (o *RemoveOtherSessionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RemoveOtherSessionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
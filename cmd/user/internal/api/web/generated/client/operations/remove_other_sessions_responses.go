@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/api/web/generated/models"
+)
+
+// RemoveOtherSessionsReader is a Reader for the RemoveOtherSessions structure.
+type RemoveOtherSessionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RemoveOtherSessionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewRemoveOtherSessionsNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRemoveOtherSessionsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRemoveOtherSessionsNoContent creates a RemoveOtherSessionsNoContent with default headers values
+func NewRemoveOtherSessionsNoContent() *RemoveOtherSessionsNoContent {
+	return &RemoveOtherSessionsNoContent{}
+}
+
+/* RemoveOtherSessionsNoContent describes a response with status code 204, with default header values.
+
+The server successfully processed the request and is not returning any content.
+*/
+type RemoveOtherSessionsNoContent struct {
+}
+
+func (o *RemoveOtherSessionsNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /sessions][%d] removeOtherSessionsNoContent ", 204)
+}
+
+func (o *RemoveOtherSessionsNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRemoveOtherSessionsDefault creates a RemoveOtherSessionsDefault with default headers values
+func NewRemoveOtherSessionsDefault(code int) *RemoveOtherSessionsDefault {
+	return &RemoveOtherSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+/* RemoveOtherSessionsDefault describes a response with status code -1, with default header values.
+
+Generic error response.
+*/
+type RemoveOtherSessionsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the remove other sessions default response
+func (o *RemoveOtherSessionsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RemoveOtherSessionsDefault) Error() string {
+	return fmt.Sprintf("[DELETE /sessions][%d] removeOtherSessions default  %+v", o._statusCode, o.Payload)
+}
+func (o *RemoveOtherSessionsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RemoveOtherSessionsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,291 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SecurityEvent security event
+//
+// swagger:model SecurityEvent
+type SecurityEvent struct {
+
+	// ISO 3166-1 alpha-2 code of country session was used from, empty if unknown.
+	// Required: true
+	Country *string `json:"country"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// Set for events of session of request.
+	// Required: true
+	Current *bool `json:"current"`
+
+	// id
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id"`
+
+	// IP address session was used from, empty if unknown.
+	// Required: true
+	IP *string `json:"ip"`
+
+	// "new_sign_in" for new session, "suspicious" for session usage from unusual origin, "rejected" for rejected session usage, e.g. from other browser.
+	//
+	// Required: true
+	// Enum: [new_sign_in suspicious rejected]
+	Kind *string `json:"kind"`
+
+	// Failed origin checks of suspicious and rejected usage.
+	// Required: true
+	Reasons []string `json:"reasons"`
+
+	// session Id
+	// Required: true
+	// Format: uuid
+	SessionID *strfmt.UUID `json:"sessionId"`
+
+	// Client session was used by.
+	// Required: true
+	UserAgent *string `json:"userAgent"`
+}
+
+// Validate validates this security event
+func (m *SecurityEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCountry(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCurrent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIP(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReasons(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSessionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUserAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SecurityEvent) validateCountry(formats strfmt.Registry) error {
+
+	if err := validate.Required("country", "body", m.Country); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SecurityEvent) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SecurityEvent) validateCurrent(formats strfmt.Registry) error {
+
+	if err := validate.Required("current", "body", m.Current); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SecurityEvent) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SecurityEvent) validateIP(formats strfmt.Registry) error {
+
+	if err := validate.Required("ip", "body", m.IP); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var securityEventTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["new_sign_in","suspicious","rejected"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		securityEventTypeKindPropEnum = append(securityEventTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// SecurityEventKindNewSignIn captures enum value "new_sign_in"
+	SecurityEventKindNewSignIn string = "new_sign_in"
+
+	// SecurityEventKindSuspicious captures enum value "suspicious"
+	SecurityEventKindSuspicious string = "suspicious"
+
+	// SecurityEventKindRejected captures enum value "rejected"
+	SecurityEventKindRejected string = "rejected"
+)
+
+// prop value enum
+func (m *SecurityEvent) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, securityEventTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SecurityEvent) validateKind(formats strfmt.Registry) error {
+
+	if err := validate.Required("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", *m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var securityEventReasonsItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["user_agent","subnet","country"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		securityEventReasonsItemsEnum = append(securityEventReasonsItemsEnum, v)
+	}
+}
+
+func (m *SecurityEvent) validateReasonsItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, securityEventReasonsItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SecurityEvent) validateReasons(formats strfmt.Registry) error {
+
+	if err := validate.Required("reasons", "body", m.Reasons); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Reasons); i++ {
+
+		// value enum
+		if err := m.validateReasonsItemsEnum("reasons"+"."+strconv.Itoa(i), "body", m.Reasons[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *SecurityEvent) validateSessionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("sessionId", "body", m.SessionID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("sessionId", "body", "uuid", m.SessionID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SecurityEvent) validateUserAgent(formats strfmt.Registry) error {
+
+	if err := validate.Required("userAgent", "body", m.UserAgent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this security event based on context it is used
+func (m *SecurityEvent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SecurityEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SecurityEvent) UnmarshalBinary(b []byte) error {
+	var res SecurityEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -78,6 +78,16 @@ func configureAPI(api *operations.UserServiceAPI) http.Handler {
 			return operations.GetUsersNotImplemented()
 		})
 	}
+	if api.ListSecurityEventsHandler == nil {
+		api.ListSecurityEventsHandler = operations.ListSecurityEventsHandlerFunc(func(params operations.ListSecurityEventsParams, principal *app.Session) operations.ListSecurityEventsResponder {
+			return operations.ListSecurityEventsNotImplemented()
+		})
+	}
+	if api.ListSessionsHandler == nil {
+		api.ListSessionsHandler = operations.ListSessionsHandlerFunc(func(params operations.ListSessionsParams, principal *app.Session) operations.ListSessionsResponder {
+			return operations.ListSessionsNotImplemented()
+		})
+	}
 	if api.LoginHandler == nil {
 		api.LoginHandler = operations.LoginHandlerFunc(func(params operations.LoginParams) operations.LoginResponder {
 			return operations.LoginNotImplemented()
@@ -93,6 +103,16 @@ func configureAPI(api *operations.UserServiceAPI) http.Handler {
 			return operations.NewAvatarNotImplemented()
 		})
 	}
+	if api.RefreshHandler == nil {
+		api.RefreshHandler = operations.RefreshHandlerFunc(func(params operations.RefreshParams) operations.RefreshResponder {
+			return operations.RefreshNotImplemented()
+		})
+	}
+	if api.RemoveOtherSessionsHandler == nil {
+		api.RemoveOtherSessionsHandler = operations.RemoveOtherSessionsHandlerFunc(func(params operations.RemoveOtherSessionsParams, principal *app.Session) operations.RemoveOtherSessionsResponder {
+			return operations.RemoveOtherSessionsNotImplemented()
+		})
+	}
 	if api.UpdatePasswordHandler == nil {
 		api.UpdatePasswordHandler = operations.UpdatePasswordHandlerFunc(func(params operations.UpdatePasswordParams, principal *app.Session) operations.UpdatePasswordResponder {
 			return operations.UpdatePasswordNotImplemented()
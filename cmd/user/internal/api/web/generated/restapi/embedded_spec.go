@@ -130,7 +130,7 @@ func init() {
             "headers": {
               "Set-Cookie": {
                 "type": "string",
-                "description": "Session auth."
+                "description": "Session auth and refresh token, each in its own header."
               }
             }
           },
@@ -154,6 +154,99 @@ func init() {
         }
       }
     },
+    "/refresh": {
+      "post": {
+        "security": [],
+        "description": "Exchange refresh token for new session auth and refresh token.\nEvery refresh token can be used once, reused refresh token ends session.\n",
+        "operationId": "refresh",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Contains refresh token cookie.",
+            "name": "Cookie",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "headers": {
+              "Set-Cookie": {
+                "type": "string",
+                "description": "Session auth and refresh token, each in its own header."
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/security-events": {
+      "get": {
+        "description": "List last security events of user, e.g. new sign-ins, most recent first.",
+        "operationId": "listSecurityEvents",
+        "parameters": [
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SecurityEvent"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
+    "/sessions": {
+      "get": {
+        "description": "List active sessions of user, most recently used first.",
+        "operationId": "listSessions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Session"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      },
+      "delete": {
+        "description": "Sign out everywhere except current session, e.g. after password change.",
+        "operationId": "removeOtherSessions",
+        "responses": {
+          "204": {
+            "$ref": "#/responses/NoContent"
+          },
+          "default": {
+            "$ref": "#/responses/GenericError"
+          }
+        }
+      }
+    },
     "/user": {
       "get": {
         "description": "Open user profile by id. If id not set returns self info.",
@@ -423,6 +516,109 @@ func init() {
       "maxLength": 100,
       "minLength": 8
     },
+    "SecurityEvent": {
+      "type": "object",
+      "required": [
+        "id",
+        "kind",
+        "sessionId",
+        "ip",
+        "userAgent",
+        "country",
+        "reasons",
+        "createdAt",
+        "current"
+      ],
+      "properties": {
+        "country": {
+          "description": "ISO 3166-1 alpha-2 code of country session was used from, empty if unknown.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "current": {
+          "description": "Set for events of session of request.",
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip": {
+          "description": "IP address session was used from, empty if unknown.",
+          "type": "string"
+        },
+        "kind": {
+          "description": "\"new_sign_in\" for new session, \"suspicious\" for session usage from unusual origin, \"rejected\" for rejected session usage, e.g. from other browser.\n",
+          "type": "string",
+          "enum": [
+            "new_sign_in",
+            "suspicious",
+            "rejected"
+          ]
+        },
+        "reasons": {
+          "description": "Failed origin checks of suspicious and rejected usage.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "user_agent",
+              "subnet",
+              "country"
+            ]
+          }
+        },
+        "sessionId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "userAgent": {
+          "description": "Client session was used by.",
+          "type": "string"
+        }
+      }
+    },
+    "Session": {
+      "type": "object",
+      "required": [
+        "id",
+        "ip",
+        "userAgent",
+        "createdAt",
+        "lastSeenAt",
+        "current"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "current": {
+          "description": "Set for session of request.",
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip": {
+          "description": "IP address session was created from, empty if unknown.",
+          "type": "string"
+        },
+        "lastSeenAt": {
+          "description": "Time of last session usage.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "userAgent": {
+          "description": "Client session was created by.",
+          "type": "string"
+        }
+      }
+    },
     "UpdatePassword": {
       "type": "object",
       "required": [
@@ -621,7 +817,7 @@ func init() {
             "headers": {
               "Set-Cookie": {
                 "type": "string",
-                "description": "Session auth."
+                "description": "Session auth and refresh token, each in its own header."
               }
             }
           },
@@ -651,6 +847,111 @@ func init() {
         }
       }
     },
+    "/refresh": {
+      "post": {
+        "security": [],
+        "description": "Exchange refresh token for new session auth and refresh token.\nEvery refresh token can be used once, reused refresh token ends session.\n",
+        "operationId": "refresh",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Contains refresh token cookie.",
+            "name": "Cookie",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "headers": {
+              "Set-Cookie": {
+                "type": "string",
+                "description": "Session auth and refresh token, each in its own header."
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/security-events": {
+      "get": {
+        "description": "List last security events of user, e.g. new sign-ins, most recent first.",
+        "operationId": "listSecurityEvents",
+        "parameters": [
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SecurityEvent"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/sessions": {
+      "get": {
+        "description": "List active sessions of user, most recently used first.",
+        "operationId": "listSessions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Session"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Sign out everywhere except current session, e.g. after password change.",
+        "operationId": "removeOtherSessions",
+        "responses": {
+          "204": {
+            "description": "The server successfully processed the request and is not returning any content."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/user": {
       "get": {
         "description": "Open user profile by id. If id not set returns self info.",
@@ -942,6 +1243,109 @@ func init() {
       "maxLength": 100,
       "minLength": 8
     },
+    "SecurityEvent": {
+      "type": "object",
+      "required": [
+        "id",
+        "kind",
+        "sessionId",
+        "ip",
+        "userAgent",
+        "country",
+        "reasons",
+        "createdAt",
+        "current"
+      ],
+      "properties": {
+        "country": {
+          "description": "ISO 3166-1 alpha-2 code of country session was used from, empty if unknown.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "current": {
+          "description": "Set for events of session of request.",
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip": {
+          "description": "IP address session was used from, empty if unknown.",
+          "type": "string"
+        },
+        "kind": {
+          "description": "\"new_sign_in\" for new session, \"suspicious\" for session usage from unusual origin, \"rejected\" for rejected session usage, e.g. from other browser.\n",
+          "type": "string",
+          "enum": [
+            "new_sign_in",
+            "suspicious",
+            "rejected"
+          ]
+        },
+        "reasons": {
+          "description": "Failed origin checks of suspicious and rejected usage.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "user_agent",
+              "subnet",
+              "country"
+            ]
+          }
+        },
+        "sessionId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "userAgent": {
+          "description": "Client session was used by.",
+          "type": "string"
+        }
+      }
+    },
+    "Session": {
+      "type": "object",
+      "required": [
+        "id",
+        "ip",
+        "userAgent",
+        "createdAt",
+        "lastSeenAt",
+        "current"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "current": {
+          "description": "Set for session of request.",
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip": {
+          "description": "IP address session was created from, empty if unknown.",
+          "type": "string"
+        },
+        "lastSeenAt": {
+          "description": "Time of last session usage.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "userAgent": {
+          "description": "Client session was created by.",
+          "type": "string"
+        }
+      }
+    },
     "UpdatePassword": {
       "type": "object",
       "required": [
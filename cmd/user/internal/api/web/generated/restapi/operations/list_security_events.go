@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/app"
+)
+
+// ListSecurityEventsHandlerFunc turns a function with the right signature into a list security events handler
+type ListSecurityEventsHandlerFunc func(ListSecurityEventsParams, *app.Session) ListSecurityEventsResponder
+
+// Handle executing the request and returning a response
+func (fn ListSecurityEventsHandlerFunc) Handle(params ListSecurityEventsParams, principal *app.Session) ListSecurityEventsResponder {
+	return fn(params, principal)
+}
+
+// ListSecurityEventsHandler interface for that can handle valid list security events params
+type ListSecurityEventsHandler interface {
+	Handle(ListSecurityEventsParams, *app.Session) ListSecurityEventsResponder
+}
+
+// NewListSecurityEvents creates a new http.Handler for the list security events operation
+func NewListSecurityEvents(ctx *middleware.Context, handler ListSecurityEventsHandler) *ListSecurityEvents {
+	return &ListSecurityEvents{Context: ctx, Handler: handler}
+}
+
+/* ListSecurityEvents swagger:route GET /security-events listSecurityEvents
+
+List last security events of user, e.g. new sign-ins, most recent first.
+
+*/
+type ListSecurityEvents struct {
+	Context *middleware.Context
+	Handler ListSecurityEventsHandler
+}
+
+func (o *ListSecurityEvents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListSecurityEventsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,143 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/api/web/generated/models"
+)
+
+// ListSecurityEventsOKCode is the HTTP code returned for type ListSecurityEventsOK
+const ListSecurityEventsOKCode int = 200
+
+/*ListSecurityEventsOK OK
+
+swagger:response listSecurityEventsOK
+*/
+type ListSecurityEventsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.SecurityEvent `json:"body,omitempty"`
+}
+
+// NewListSecurityEventsOK creates ListSecurityEventsOK with default headers values
+func NewListSecurityEventsOK() *ListSecurityEventsOK {
+
+	return &ListSecurityEventsOK{}
+}
+
+// WithPayload adds the payload to the list security events o k response
+func (o *ListSecurityEventsOK) WithPayload(payload []*models.SecurityEvent) *ListSecurityEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list security events o k response
+func (o *ListSecurityEventsOK) SetPayload(payload []*models.SecurityEvent) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListSecurityEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.SecurityEvent, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+func (o *ListSecurityEventsOK) ListSecurityEventsResponder() {}
+
+/*ListSecurityEventsDefault Generic error response.
+
+swagger:response listSecurityEventsDefault
+*/
+type ListSecurityEventsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListSecurityEventsDefault creates ListSecurityEventsDefault with default headers values
+func NewListSecurityEventsDefault(code int) *ListSecurityEventsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListSecurityEventsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list security events default response
+func (o *ListSecurityEventsDefault) WithStatusCode(code int) *ListSecurityEventsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list security events default response
+func (o *ListSecurityEventsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list security events default response
+func (o *ListSecurityEventsDefault) WithPayload(payload *models.Error) *ListSecurityEventsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list security events default response
+func (o *ListSecurityEventsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListSecurityEventsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *ListSecurityEventsDefault) ListSecurityEventsResponder() {}
+
+type ListSecurityEventsNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*ListSecurityEventsNotImplementedResponder) ListSecurityEventsResponder() {}
+
+func ListSecurityEventsNotImplemented() ListSecurityEventsResponder {
+	return &ListSecurityEventsNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.ListSecurityEvents has not yet been implemented",
+		),
+	}
+}
+
+type ListSecurityEventsResponder interface {
+	middleware.Responder
+	ListSecurityEventsResponder()
+}
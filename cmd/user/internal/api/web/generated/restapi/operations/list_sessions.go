@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/app"
+)
+
+// ListSessionsHandlerFunc turns a function with the right signature into a list sessions handler
+type ListSessionsHandlerFunc func(ListSessionsParams, *app.Session) ListSessionsResponder
+
+// Handle executing the request and returning a response
+func (fn ListSessionsHandlerFunc) Handle(params ListSessionsParams, principal *app.Session) ListSessionsResponder {
+	return fn(params, principal)
+}
+
+// ListSessionsHandler interface for that can handle valid list sessions params
+type ListSessionsHandler interface {
+	Handle(ListSessionsParams, *app.Session) ListSessionsResponder
+}
+
+// NewListSessions creates a new http.Handler for the list sessions operation
+func NewListSessions(ctx *middleware.Context, handler ListSessionsHandler) *ListSessions {
+	return &ListSessions{Context: ctx, Handler: handler}
+}
+
+/* ListSessions swagger:route GET /sessions listSessions
+
+List active sessions of user, most recently used first.
+
+*/
+type ListSessions struct {
+	Context *middleware.Context
+	Handler ListSessionsHandler
+}
+
+func (o *ListSessions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListSessionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,143 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/api/web/generated/models"
+)
+
+// ListSessionsOKCode is the HTTP code returned for type ListSessionsOK
+const ListSessionsOKCode int = 200
+
+/*ListSessionsOK OK
+
+swagger:response listSessionsOK
+*/
+type ListSessionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Session `json:"body,omitempty"`
+}
+
+// NewListSessionsOK creates ListSessionsOK with default headers values
+func NewListSessionsOK() *ListSessionsOK {
+
+	return &ListSessionsOK{}
+}
+
+// WithPayload adds the payload to the list sessions o k response
+func (o *ListSessionsOK) WithPayload(payload []*models.Session) *ListSessionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list sessions o k response
+func (o *ListSessionsOK) SetPayload(payload []*models.Session) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListSessionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Session, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+func (o *ListSessionsOK) ListSessionsResponder() {}
+
+/*ListSessionsDefault Generic error response.
+
+swagger:response listSessionsDefault
+*/
+type ListSessionsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListSessionsDefault creates ListSessionsDefault with default headers values
+func NewListSessionsDefault(code int) *ListSessionsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list sessions default response
+func (o *ListSessionsDefault) WithStatusCode(code int) *ListSessionsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list sessions default response
+func (o *ListSessionsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list sessions default response
+func (o *ListSessionsDefault) WithPayload(payload *models.Error) *ListSessionsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list sessions default response
+func (o *ListSessionsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListSessionsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *ListSessionsDefault) ListSessionsResponder() {}
+
+type ListSessionsNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*ListSessionsNotImplementedResponder) ListSessionsResponder() {}
+
+func ListSessionsNotImplemented() ListSessionsResponder {
+	return &ListSessionsNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.ListSessions has not yet been implemented",
+		),
+	}
+}
+
+type ListSessionsResponder interface {
+	middleware.Responder
+	ListSessionsResponder()
+}
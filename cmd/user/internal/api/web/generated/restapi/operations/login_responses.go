@@ -22,7 +22,7 @@ const LoginOKCode int = 200
 swagger:response loginOK
 */
 type LoginOK struct {
-	/*Session auth.
+	/*Session auth and refresh token, each in its own header.
 
 	 */
 	SetCookie string `json:"Set-Cookie"`
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RefreshHandlerFunc turns a function with the right signature into a refresh handler
+type RefreshHandlerFunc func(RefreshParams) RefreshResponder
+
+// Handle executing the request and returning a response
+func (fn RefreshHandlerFunc) Handle(params RefreshParams) RefreshResponder {
+	return fn(params)
+}
+
+// RefreshHandler interface for that can handle valid refresh params
+type RefreshHandler interface {
+	Handle(RefreshParams) RefreshResponder
+}
+
+// NewRefresh creates a new http.Handler for the refresh operation
+func NewRefresh(ctx *middleware.Context, handler RefreshHandler) *Refresh {
+	return &Refresh{Context: ctx, Handler: handler}
+}
+
+/* Refresh swagger:route POST /refresh refresh
+
+Exchange refresh token for new session auth and refresh token.
+Every refresh token can be used once, reused refresh token ends session.
+
+
+*/
+type Refresh struct {
+	Context *middleware.Context
+	Handler RefreshHandler
+}
+
+func (o *Refresh) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRefreshParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
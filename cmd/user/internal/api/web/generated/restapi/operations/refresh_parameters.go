@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewRefreshParams creates a new RefreshParams object
+//
+// There are no default values defined in the spec.
+func NewRefreshParams() RefreshParams {
+
+	return RefreshParams{}
+}
+
+// RefreshParams contains all the bound params for the refresh operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters refresh
+type RefreshParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Contains refresh token cookie.
+	  Required: true
+	  In: header
+	*/
+	Cookie string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewRefreshParams() beforehand.
+func (o *RefreshParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if err := o.bindCookie(r.Header[http.CanonicalHeaderKey("Cookie")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindCookie binds and validates parameter Cookie from header.
+func (o *RefreshParams) bindCookie(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("Cookie", "header", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+
+	if err := validate.RequiredString("Cookie", "header", raw); err != nil {
+		return err
+	}
+	o.Cookie = raw
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/api/web/generated/models"
+)
+
+// RefreshOKCode is the HTTP code returned for type RefreshOK
+const RefreshOKCode int = 200
+
+/*RefreshOK OK
+
+swagger:response refreshOK
+*/
+type RefreshOK struct {
+	/*Session auth and refresh token, each in its own header.
+
+	 */
+	SetCookie string `json:"Set-Cookie"`
+}
+
+// NewRefreshOK creates RefreshOK with default headers values
+func NewRefreshOK() *RefreshOK {
+
+	return &RefreshOK{}
+}
+
+// WithSetCookie adds the setCookie to the refresh o k response
+func (o *RefreshOK) WithSetCookie(setCookie string) *RefreshOK {
+	o.SetCookie = setCookie
+	return o
+}
+
+// SetSetCookie sets the setCookie to the refresh o k response
+func (o *RefreshOK) SetSetCookie(setCookie string) {
+	o.SetCookie = setCookie
+}
+
+// WriteResponse to the client
+func (o *RefreshOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Set-Cookie
+
+	setCookie := o.SetCookie
+	if setCookie != "" {
+		rw.Header().Set("Set-Cookie", setCookie)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+func (o *RefreshOK) RefreshResponder() {}
+
+/*RefreshDefault Generic error response.
+
+swagger:response refreshDefault
+*/
+type RefreshDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRefreshDefault creates RefreshDefault with default headers values
+func NewRefreshDefault(code int) *RefreshDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RefreshDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the refresh default response
+func (o *RefreshDefault) WithStatusCode(code int) *RefreshDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the refresh default response
+func (o *RefreshDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the refresh default response
+func (o *RefreshDefault) WithPayload(payload *models.Error) *RefreshDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the refresh default response
+func (o *RefreshDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RefreshDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *RefreshDefault) RefreshResponder() {}
+
+type RefreshNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*RefreshNotImplementedResponder) RefreshResponder() {}
+
+func RefreshNotImplemented() RefreshResponder {
+	return &RefreshNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.Refresh has not yet been implemented",
+		),
+	}
+}
+
+type RefreshResponder interface {
+	middleware.Responder
+	RefreshResponder()
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/app"
+)
+
+// RemoveOtherSessionsHandlerFunc turns a function with the right signature into a remove other sessions handler
+type RemoveOtherSessionsHandlerFunc func(RemoveOtherSessionsParams, *app.Session) RemoveOtherSessionsResponder
+
+// Handle executing the request and returning a response
+func (fn RemoveOtherSessionsHandlerFunc) Handle(params RemoveOtherSessionsParams, principal *app.Session) RemoveOtherSessionsResponder {
+	return fn(params, principal)
+}
+
+// RemoveOtherSessionsHandler interface for that can handle valid remove other sessions params
+type RemoveOtherSessionsHandler interface {
+	Handle(RemoveOtherSessionsParams, *app.Session) RemoveOtherSessionsResponder
+}
+
+// NewRemoveOtherSessions creates a new http.Handler for the remove other sessions operation
+func NewRemoveOtherSessions(ctx *middleware.Context, handler RemoveOtherSessionsHandler) *RemoveOtherSessions {
+	return &RemoveOtherSessions{Context: ctx, Handler: handler}
+}
+
+/* RemoveOtherSessions swagger:route DELETE /sessions removeOtherSessions
+
+Sign out everywhere except current session, e.g. after password change.
+
+*/
+type RemoveOtherSessions struct {
+	Context *middleware.Context
+	Handler RemoveOtherSessionsHandler
+}
+
+func (o *RemoveOtherSessions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRemoveOtherSessionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *app.Session
+	if uprinc != nil {
+		principal = uprinc.(*app.Session) // this is really a app.Session, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
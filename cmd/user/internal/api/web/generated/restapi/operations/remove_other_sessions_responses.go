@@ -0,0 +1,120 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/Meat-Hook/back-template/cmd/user/internal/api/web/generated/models"
+)
+
+// RemoveOtherSessionsNoContentCode is the HTTP code returned for type RemoveOtherSessionsNoContent
+const RemoveOtherSessionsNoContentCode int = 204
+
+/*RemoveOtherSessionsNoContent The server successfully processed the request and is not returning any content.
+
+swagger:response removeOtherSessionsNoContent
+*/
+type RemoveOtherSessionsNoContent struct {
+}
+
+// NewRemoveOtherSessionsNoContent creates RemoveOtherSessionsNoContent with default headers values
+func NewRemoveOtherSessionsNoContent() *RemoveOtherSessionsNoContent {
+
+	return &RemoveOtherSessionsNoContent{}
+}
+
+// WriteResponse to the client
+func (o *RemoveOtherSessionsNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+func (o *RemoveOtherSessionsNoContent) RemoveOtherSessionsResponder() {}
+
+/*RemoveOtherSessionsDefault Generic error response.
+
+swagger:response removeOtherSessionsDefault
+*/
+type RemoveOtherSessionsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRemoveOtherSessionsDefault creates RemoveOtherSessionsDefault with default headers values
+func NewRemoveOtherSessionsDefault(code int) *RemoveOtherSessionsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RemoveOtherSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the remove other sessions default response
+func (o *RemoveOtherSessionsDefault) WithStatusCode(code int) *RemoveOtherSessionsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the remove other sessions default response
+func (o *RemoveOtherSessionsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the remove other sessions default response
+func (o *RemoveOtherSessionsDefault) WithPayload(payload *models.Error) *RemoveOtherSessionsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the remove other sessions default response
+func (o *RemoveOtherSessionsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RemoveOtherSessionsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+func (o *RemoveOtherSessionsDefault) RemoveOtherSessionsResponder() {}
+
+type RemoveOtherSessionsNotImplementedResponder struct {
+	middleware.Responder
+}
+
+func (*RemoveOtherSessionsNotImplementedResponder) RemoveOtherSessionsResponder() {}
+
+func RemoveOtherSessionsNotImplemented() RemoveOtherSessionsResponder {
+	return &RemoveOtherSessionsNotImplementedResponder{
+		middleware.NotImplemented(
+			"operation authentication.RemoveOtherSessions has not yet been implemented",
+		),
+	}
+}
+
+type RemoveOtherSessionsResponder interface {
+	middleware.Responder
+	RemoveOtherSessionsResponder()
+}
@@ -60,6 +60,12 @@ func NewUserServiceAPI(spec *loads.Document) *UserServiceAPI {
 		GetUsersHandler: GetUsersHandlerFunc(func(params GetUsersParams, principal *app.Session) GetUsersResponder {
 			return GetUsersNotImplemented()
 		}),
+		ListSecurityEventsHandler: ListSecurityEventsHandlerFunc(func(params ListSecurityEventsParams, principal *app.Session) ListSecurityEventsResponder {
+			return ListSecurityEventsNotImplemented()
+		}),
+		ListSessionsHandler: ListSessionsHandlerFunc(func(params ListSessionsParams, principal *app.Session) ListSessionsResponder {
+			return ListSessionsNotImplemented()
+		}),
 		LoginHandler: LoginHandlerFunc(func(params LoginParams) LoginResponder {
 			return LoginNotImplemented()
 		}),
@@ -69,6 +75,12 @@ func NewUserServiceAPI(spec *loads.Document) *UserServiceAPI {
 		NewAvatarHandler: NewAvatarHandlerFunc(func(params NewAvatarParams, principal *app.Session) NewAvatarResponder {
 			return NewAvatarNotImplemented()
 		}),
+		RefreshHandler: RefreshHandlerFunc(func(params RefreshParams) RefreshResponder {
+			return RefreshNotImplemented()
+		}),
+		RemoveOtherSessionsHandler: RemoveOtherSessionsHandlerFunc(func(params RemoveOtherSessionsParams, principal *app.Session) RemoveOtherSessionsResponder {
+			return RemoveOtherSessionsNotImplemented()
+		}),
 		UpdatePasswordHandler: UpdatePasswordHandlerFunc(func(params UpdatePasswordParams, principal *app.Session) UpdatePasswordResponder {
 			return UpdatePasswordNotImplemented()
 		}),
@@ -144,12 +156,20 @@ type UserServiceAPI struct {
 	GetUserHandler GetUserHandler
 	// GetUsersHandler sets the operation handler for the get users operation
 	GetUsersHandler GetUsersHandler
+	// ListSecurityEventsHandler sets the operation handler for the list security events operation
+	ListSecurityEventsHandler ListSecurityEventsHandler
+	// ListSessionsHandler sets the operation handler for the list sessions operation
+	ListSessionsHandler ListSessionsHandler
 	// LoginHandler sets the operation handler for the login operation
 	LoginHandler LoginHandler
 	// LogoutHandler sets the operation handler for the logout operation
 	LogoutHandler LogoutHandler
 	// NewAvatarHandler sets the operation handler for the new avatar operation
 	NewAvatarHandler NewAvatarHandler
+	// RefreshHandler sets the operation handler for the refresh operation
+	RefreshHandler RefreshHandler
+	// RemoveOtherSessionsHandler sets the operation handler for the remove other sessions operation
+	RemoveOtherSessionsHandler RemoveOtherSessionsHandler
 	// UpdatePasswordHandler sets the operation handler for the update password operation
 	UpdatePasswordHandler UpdatePasswordHandler
 	// UpdateUsernameHandler sets the operation handler for the update username operation
@@ -257,6 +277,12 @@ func (o *UserServiceAPI) Validate() error {
 	if o.GetUsersHandler == nil {
 		unregistered = append(unregistered, "GetUsersHandler")
 	}
+	if o.ListSecurityEventsHandler == nil {
+		unregistered = append(unregistered, "ListSecurityEventsHandler")
+	}
+	if o.ListSessionsHandler == nil {
+		unregistered = append(unregistered, "ListSessionsHandler")
+	}
 	if o.LoginHandler == nil {
 		unregistered = append(unregistered, "LoginHandler")
 	}
@@ -266,6 +292,12 @@ func (o *UserServiceAPI) Validate() error {
 	if o.NewAvatarHandler == nil {
 		unregistered = append(unregistered, "NewAvatarHandler")
 	}
+	if o.RefreshHandler == nil {
+		unregistered = append(unregistered, "RefreshHandler")
+	}
+	if o.RemoveOtherSessionsHandler == nil {
+		unregistered = append(unregistered, "RemoveOtherSessionsHandler")
+	}
 	if o.UpdatePasswordHandler == nil {
 		unregistered = append(unregistered, "UpdatePasswordHandler")
 	}
@@ -399,6 +431,14 @@ func (o *UserServiceAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/users"] = NewGetUsers(o.context, o.GetUsersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/security-events"] = NewListSecurityEvents(o.context, o.ListSecurityEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions"] = NewListSessions(o.context, o.ListSessionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -411,6 +451,14 @@ func (o *UserServiceAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/avatar"] = NewNewAvatar(o.context, o.NewAvatarHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/refresh"] = NewRefresh(o.context, o.RefreshHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/sessions"] = NewRemoveOtherSessions(o.context, o.RemoveOtherSessionsHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -169,11 +169,11 @@ func (s *service) login(params operations.LoginParams) operations.LoginResponder
 		UserAgent: params.HTTPRequest.Header.Get("User-Agent"),
 	}
 
-	token, err := s.app.Login(ctx, string(*params.Args.Email), string(*params.Args.Password), origin)
+	tokens, err := s.app.Login(ctx, string(*params.Args.Email), string(*params.Args.Password), origin)
 	defer logs(log, err)
 	switch {
 	case err == nil:
-		return operations.NewLoginOK().WithSetCookie(generateCookie(token.Value).String())
+		return s.withTokens(operations.NewLoginOK(), *tokens)
 	case errors.Is(err, app.ErrNotFound):
 		return operations.NewLoginDefault(http.StatusNotFound).WithPayload(apiError(app.ErrNotFound.Error()))
 	case errors.Is(err, app.ErrNotValidPassword):
@@ -184,6 +184,23 @@ func (s *service) login(params operations.LoginParams) operations.LoginResponder
 	}
 }
 
+func (s *service) refresh(params operations.RefreshParams) operations.RefreshResponder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, nil)
+
+	tokens, err := s.app.Refresh(ctx, parseCookie(params.Cookie, cookieRefreshName))
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return s.withTokens(operations.NewRefreshOK(), *tokens)
+	case errors.Is(err, app.ErrNotFound):
+		return operations.NewRefreshDefault(http.StatusUnauthorized).
+			WithPayload(apiError(http.StatusText(http.StatusUnauthorized)))
+	default:
+		return operations.NewRefreshDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
 func (s *service) logout(params operations.LogoutParams, session *app.Session) operations.LogoutResponder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, session)
 
@@ -198,6 +215,48 @@ func (s *service) logout(params operations.LogoutParams, session *app.Session) o
 	}
 }
 
+func (s *service) listSessions(params operations.ListSessionsParams, session *app.Session) operations.ListSessionsResponder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, session)
+
+	sessions, err := s.app.ListSessions(ctx, *session)
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return operations.NewListSessionsOK().WithPayload(Sessions(sessions))
+	default:
+		return operations.NewListSessionsDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
+func (s *service) listSecurityEvents(params operations.ListSecurityEventsParams, session *app.Session) operations.ListSecurityEventsResponder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, session)
+
+	events, err := s.app.ListSecurityEvents(ctx, *session, int(swag.Int32Value(params.Limit)))
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return operations.NewListSecurityEventsOK().WithPayload(SecurityEvents(events))
+	default:
+		return operations.NewListSecurityEventsDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
+func (s *service) removeOtherSessions(params operations.RemoveOtherSessionsParams, session *app.Session) operations.RemoveOtherSessionsResponder {
+	ctx, log, _ := fromRequest(params.HTTPRequest, session)
+
+	err := s.app.RemoveOtherSessions(ctx, *session)
+	defer logs(log, err)
+	switch {
+	case err == nil:
+		return operations.NewRemoveOtherSessionsNoContent()
+	default:
+		return operations.NewRemoveOtherSessionsDefault(http.StatusInternalServerError).
+			WithPayload(apiError(http.StatusText(http.StatusInternalServerError)))
+	}
+}
+
 func (s *service) uploadAvatar(params operations.NewAvatarParams, session *app.Session) operations.NewAvatarResponder {
 	ctx, log, _ := fromRequest(params.HTTPRequest, session)
 
@@ -206,6 +265,14 @@ func (s *service) uploadAvatar(params operations.NewAvatarParams, session *app.S
 	switch {
 	case errors.Is(err, app.ErrNotFound):
 		return operations.NewNewAvatarDefault(http.StatusNotFound).WithPayload(apiError(app.ErrNotFound.Error()))
+	case errors.Is(err, app.ErrNotAllowedType):
+		return operations.NewNewAvatarDefault(http.StatusBadRequest).WithPayload(apiError(app.ErrNotAllowedType.Error()))
+	case errors.Is(err, app.ErrFileTooLarge):
+		return operations.NewNewAvatarDefault(http.StatusRequestEntityTooLarge).WithPayload(apiError(app.ErrFileTooLarge.Error()))
+	case errors.Is(err, app.ErrQuotaExceeded):
+		return operations.NewNewAvatarDefault(http.StatusRequestEntityTooLarge).WithPayload(apiError(app.ErrQuotaExceeded.Error()))
+	case errors.Is(err, app.ErrFileInfected):
+		return operations.NewNewAvatarDefault(http.StatusUnprocessableEntity).WithPayload(apiError(app.ErrFileInfected.Error()))
 	case err == nil:
 		return operations.NewNewAvatarNoContent()
 	default:
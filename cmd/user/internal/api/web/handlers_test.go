@@ -2,7 +2,10 @@ package web_test
 
 import (
 	"bytes"
+	"context"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -156,7 +159,7 @@ func TestService_GetUser(t *testing.T) {
 			_, mockApp, client, assert, apiKeyAuth := start(t)
 
 			mockApp.EXPECT().UserByID(gomock.Any(), session, tc.arg).Return(tc.user, tc.appErr)
-			mockApp.EXPECT().Auth(gomock.Any(), token).Return(&session, nil)
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
 
 			uid := strfmt.UUID(tc.arg.String())
 
@@ -188,7 +191,7 @@ func TestService_DeleteUser(t *testing.T) {
 			_, mockApp, client, assert, apiKeyAuth := start(t)
 
 			mockApp.EXPECT().DeleteUser(gomock.Any(), session).Return(tc.appErr)
-			mockApp.EXPECT().Auth(gomock.Any(), token).Return(&session, nil)
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
 
 			params := operations.NewDeleteUserParams()
 			_, err := client.Operations.DeleteUser(params, apiKeyAuth)
@@ -219,7 +222,7 @@ func TestServiceUpdatePassword(t *testing.T) {
 			_, mockApp, client, assert, apiKeyAuth := start(t)
 
 			mockApp.EXPECT().UpdatePassword(gomock.Any(), session, tc.oldPass, tc.newPass).Return(tc.appErr)
-			mockApp.EXPECT().Auth(gomock.Any(), token).Return(&session, nil)
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
 
 			newPass := models.Password(tc.newPass)
 			lastPass := models.Password(tc.oldPass)
@@ -257,7 +260,7 @@ func TestServiceUpdateUsername(t *testing.T) {
 			_, mockApp, client, assert, apiKeyAuth := start(t)
 
 			mockApp.EXPECT().UpdateUsername(gomock.Any(), session, userName).Return(tc.appErr)
-			mockApp.EXPECT().Auth(gomock.Any(), token).Return(&session, nil)
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
 
 			userName := models.Username(userName)
 			params := operations.NewUpdateUsernameParams().
@@ -296,7 +299,7 @@ func TestServiceGetUsers(t *testing.T) {
 			mockApp.EXPECT().
 				ListUserByUsername(gomock.Any(), session, userName, app.SearchParams{Limit: 10}).
 				Return(tc.users, len(tc.users), tc.appErr)
-			mockApp.EXPECT().Auth(gomock.Any(), token).Return(&session, nil)
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
 
 			params := operations.NewGetUsersParams().
 				WithLimit(10).
@@ -313,20 +316,14 @@ func TestServiceGetUsers(t *testing.T) {
 func TestService_Login(t *testing.T) {
 	t.Parallel()
 
-	var (
-		token = app.Token{
-			Value: "token",
-		}
-	)
-
 	testCases := []struct {
 		name        string
 		email, pass string
-		token       *app.Token
+		tokens      *app.TokenPair
 		appErr      error
 		wantErr     *models.Error
 	}{
-		{"success", user.Email, "password", &token, nil, nil},
+		{"success", user.Email, "password", &tokens, nil, nil},
 		{"err_not_found", "notExist@email.com", "password", nil, app.ErrNotFound, APIError(app.ErrNotFound.Error())},
 		{"err_not_valid_password", user.Email, "notValidPass", nil, app.ErrNotValidPassword, APIError(app.ErrNotValidPassword.Error())},
 		{"err_any", "randomEmail@email.com", "notValidPass", nil, errAny, APIError("Internal Server Error")},
@@ -339,7 +336,7 @@ func TestService_Login(t *testing.T) {
 
 			_, mockApp, client, assert, _ := start(t)
 
-			mockApp.EXPECT().Login(gomock.Any(), tc.email, tc.pass, gomock.Any()).Return(tc.token, tc.appErr)
+			mockApp.EXPECT().Login(gomock.Any(), tc.email, tc.pass, gomock.Any()).Return(tc.tokens, tc.appErr)
 
 			email := models.Email(tc.email)
 			password := models.Password(tc.pass)
@@ -349,8 +346,59 @@ func TestService_Login(t *testing.T) {
 					Email:    &email,
 					Password: &password,
 				})
-			_, err := client.Operations.Login(params)
+			res, err := client.Operations.Login(params)
+			assert.Equal(tc.wantErr, errPayload(err))
+			if tc.tokens != nil {
+				assert.Contains(res.SetCookie, "authKey="+tc.tokens.Access.Value)
+			}
+		})
+	}
+}
+
+func TestService_Refresh(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		cookie  string
+		token   string
+		tokens  *app.TokenPair
+		appErr  error
+		wantErr *models.Error
+	}{
+		{"success", "authKey=token; refreshKey=refresh", "refresh", &tokens, nil, nil},
+		{"err_not_found", "refreshKey=reused", "reused", nil, app.ErrNotFound, APIError("Unauthorized")},
+		{"err_without_token", "authKey=token", "", nil, app.ErrNotFound, APIError("Unauthorized")},
+		{"err_any", "refreshKey=refresh", "refresh", nil, errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			url, mockApp, _, assert, _ := start(t)
+
+			mockApp.EXPECT().Refresh(gomock.Any(), tc.token).Return(tc.tokens, tc.appErr)
+
+			c, cookies := cookieClient(url)
+
+			params := operations.NewRefreshParams().WithCookie(tc.cookie)
+			_, err := c.Operations.Refresh(params)
 			assert.Equal(tc.wantErr, errPayload(err))
+			if tc.tokens == nil {
+				return
+			}
+
+			assert.Len(*cookies, 2)
+			access, refresh := (*cookies)[0], (*cookies)[1]
+			assert.Equal("authKey", access.Name)
+			assert.Equal(tc.tokens.Access.Value, access.Value)
+			assert.Equal("/", access.Path)
+			assert.Equal("refreshKey", refresh.Name)
+			assert.Equal(tc.tokens.Refresh.Value, refresh.Value)
+			assert.Equal("/user/api/v1/refresh", refresh.Path)
+			assert.True(refresh.HttpOnly)
 		})
 	}
 }
@@ -380,7 +428,7 @@ func TestService_Logout(t *testing.T) {
 			_, mockApp, client, assert, apiKeyAuth := start(t)
 
 			mockApp.EXPECT().Logout(gomock.Any(), session).Return(tc.appErr)
-			mockApp.EXPECT().Auth(gomock.Any(), token).Return(&session, nil)
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
 
 			params := operations.NewLogoutParams()
 			_, err := client.Operations.Logout(params, apiKeyAuth)
@@ -403,6 +451,10 @@ func TestService_UploadAvatar(t *testing.T) {
 		want   *models.Error
 	}{
 		{"success", nil, nil},
+		{"err_not_allowed_type", app.ErrNotAllowedType, APIError(app.ErrNotAllowedType.Error())},
+		{"err_file_too_large", app.ErrFileTooLarge, APIError(app.ErrFileTooLarge.Error())},
+		{"err_quota_exceeded", app.ErrQuotaExceeded, APIError(app.ErrQuotaExceeded.Error())},
+		{"err_infected", app.ErrFileInfected, APIError(app.ErrFileInfected.Error())},
 		{"err_any", errAny, APIError("Internal Server Error")},
 	}
 
@@ -414,7 +466,7 @@ func TestService_UploadAvatar(t *testing.T) {
 			file := bytes.NewBuffer(uuid.Must(uuid.NewV4()).Bytes())
 
 			_, mockApp, client, assert, apiKeyAuth := start(t)
-			mockApp.EXPECT().Auth(gomock.Any(), token).Return(&session, nil)
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
 
 			mockApp.EXPECT().UploadAvatar(gomock.Any(), session, gomock.Any()).Return(tc.appErr)
 
@@ -450,7 +502,7 @@ func TestService_DeleteAvatar(t *testing.T) {
 			t.Parallel()
 
 			_, mockApp, client, assert, apiKeyAuth := start(t)
-			mockApp.EXPECT().Auth(gomock.Any(), token).Return(&session, nil)
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
 
 			mockApp.EXPECT().DeleteAvatar(gomock.Any(), session, fileID).Return(tc.appErr)
 
@@ -460,3 +512,165 @@ func TestService_DeleteAvatar(t *testing.T) {
 		})
 	}
 }
+
+func TestService_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Date(2026, time.October, 1, 10, 0, 0, 0, time.UTC)
+		lastSeen  = time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+		sessions  = []app.SessionInfo{
+			{
+				ID:         session.ID,
+				IP:         net.ParseIP("192.100.10.4"),
+				UserAgent:  "Mozilla/5.0",
+				CreatedAt:  createdAt,
+				LastSeenAt: lastSeen,
+				Current:    true,
+			},
+			{
+				ID:         uuid.Must(uuid.NewV4()),
+				CreatedAt:  createdAt,
+				LastSeenAt: createdAt,
+			},
+		}
+	)
+
+	testCases := []struct {
+		name     string
+		sessions []app.SessionInfo
+		appErr   error
+		want     []*models.Session
+		wantErr  *models.Error
+	}{
+		{"success", sessions, nil, web.Sessions(sessions), nil},
+		{"err_any", nil, errAny, nil, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
+			mockApp.EXPECT().ListSessions(gomock.Any(), session).Return(tc.sessions, tc.appErr)
+
+			res, err := client.Operations.ListSessions(operations.NewListSessionsParams(), apiKeyAuth)
+			assert.Equal(tc.wantErr, errPayload(err))
+			if tc.wantErr == nil {
+				assert.Len(res.Payload, len(tc.want))
+				for i := range tc.want {
+					assert.Equal(tc.want[i].ID, res.Payload[i].ID)
+					assert.Equal(tc.want[i].IP, res.Payload[i].IP)
+					assert.Equal(tc.want[i].Current, res.Payload[i].Current)
+					assert.Equal(tc.want[i].LastSeenAt.String(), res.Payload[i].LastSeenAt.String())
+				}
+			}
+		})
+	}
+}
+
+func TestService_RemoveOtherSessions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		appErr error
+		want   *models.Error
+	}{
+		{"success", nil, nil},
+		{"err_any", errAny, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).Return(&session, nil)
+			mockApp.EXPECT().RemoveOtherSessions(gomock.Any(), session).Return(tc.appErr)
+
+			_, err := client.Operations.RemoveOtherSessions(operations.NewRemoveOtherSessionsParams(), apiKeyAuth)
+			assert.Equal(tc.want, errPayload(err))
+		})
+	}
+}
+
+func TestService_ListSecurityEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+		events    = []app.SecurityEvent{
+			{
+				ID:        uuid.Must(uuid.NewV4()),
+				Kind:      app.EventSuspicious,
+				SessionID: uuid.Must(uuid.NewV4()),
+				IP:        net.ParseIP("192.100.11.4"),
+				UserAgent: "Mozilla/5.0",
+				Country:   "FR",
+				Reasons:   []string{"subnet"},
+				CreatedAt: createdAt,
+			},
+			{
+				ID:        uuid.Must(uuid.NewV4()),
+				Kind:      app.EventNewSignIn,
+				SessionID: session.ID,
+				CreatedAt: createdAt,
+				Current:   true,
+			},
+		}
+	)
+
+	testCases := []struct {
+		name     string
+		limit    *int32
+		appLimit int
+		events   []app.SecurityEvent
+		appErr   error
+		want     []*models.SecurityEvent
+		wantErr  *models.Error
+	}{
+		{"success", swag.Int32(10), 10, events, nil, web.SecurityEvents(events), nil},
+		{"success_default_limit", nil, 20, nil, nil, []*models.SecurityEvent{}, nil},
+		{"err_any", nil, 20, nil, errAny, nil, APIError("Internal Server Error")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockApp, client, assert, apiKeyAuth := start(t)
+
+			auth := func(_ context.Context, _ string, origin app.Origin) (*app.Session, error) {
+				assert.True(origin.IP.IsLoopback())
+				assert.NotEmpty(origin.UserAgent)
+
+				return &session, nil
+			}
+			mockApp.EXPECT().Auth(gomock.Any(), token, gomock.Any()).DoAndReturn(auth)
+			mockApp.EXPECT().ListSecurityEvents(gomock.Any(), session, tc.appLimit).Return(tc.events, tc.appErr)
+
+			params := operations.NewListSecurityEventsParams()
+			params.Limit = tc.limit
+			res, err := client.Operations.ListSecurityEvents(params, apiKeyAuth)
+			assert.Equal(tc.wantErr, errPayload(err))
+			if tc.wantErr == nil {
+				assert.Len(res.Payload, len(tc.want))
+				for i := range tc.want {
+					assert.Equal(tc.want[i].ID, res.Payload[i].ID)
+					assert.Equal(tc.want[i].Kind, res.Payload[i].Kind)
+					assert.Equal(tc.want[i].IP, res.Payload[i].IP)
+					assert.Equal(tc.want[i].Country, res.Payload[i].Country)
+					assert.Equal(tc.want[i].Reasons, res.Payload[i].Reasons)
+					assert.Equal(tc.want[i].Current, res.Payload[i].Current)
+				}
+			}
+		})
+	}
+}
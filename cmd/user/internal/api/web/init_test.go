@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
@@ -43,6 +45,11 @@ var (
 		UserID: user.ID,
 	}
 
+	tokens = app.TokenPair{
+		Access:  app.Token{Value: token, ExpiresAt: time.Now().Add(time.Minute)},
+		Refresh: app.Token{Value: "refresh", ExpiresAt: time.Now().Add(time.Hour)},
+	}
+
 	reg = prometheus.NewPedanticRegistry()
 )
 
@@ -82,6 +89,28 @@ func start(t *testing.T) (string, *Mockapplication, *client.UserService, *requir
 	return url, mockApp, c, require.New(t), httptransport.APIKeyAuth("Cookie", "header", "authKey="+token)
 }
 
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+// cookieClient returns client which keeps cookies of last response,
+// because generated client keeps first Set-Cookie header only.
+func cookieClient(url string) (*client.UserService, *[]*http.Cookie) {
+	cookies := &[]*http.Cookie{}
+	transport := httptransport.New(url, client.DefaultBasePath, client.DefaultSchemes)
+	transport.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		res, err := http.DefaultTransport.RoundTrip(r)
+		if err == nil {
+			*cookies = res.Cookies()
+		}
+
+		return res, err
+	})
+
+	return client.New(transport, nil), cookies
+}
+
 // APIError returns model.Error with given msg.
 func APIError(msg string) *models.Error {
 	return &models.Error{
@@ -113,12 +142,20 @@ func errPayload(err interface{}) *models.Error {
 		return err.Payload
 	case *operations.LoginDefault:
 		return err.Payload
+	case *operations.RefreshDefault:
+		return err.Payload
 	case *operations.LogoutDefault:
 		return err.Payload
 	case *operations.NewAvatarDefault:
 		return err.Payload
 	case *operations.DeleteAvatarDefault:
 		return err.Payload
+	case *operations.ListSessionsDefault:
+		return err.Payload
+	case *operations.RemoveOtherSessionsDefault:
+		return err.Payload
+	case *operations.ListSecurityEventsDefault:
+		return err.Payload
 	default:
 		return nil
 	}
@@ -38,18 +38,18 @@ func (m *Mockapplication) EXPECT() *MockapplicationMockRecorder {
 }
 
 // Auth mocks base method.
-func (m *Mockapplication) Auth(ctx context.Context, token string) (*app.Session, error) {
+func (m *Mockapplication) Auth(ctx context.Context, token string, origin app.Origin) (*app.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Auth", ctx, token)
+	ret := m.ctrl.Call(m, "Auth", ctx, token, origin)
 	ret0, _ := ret[0].(*app.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Auth indicates an expected call of Auth.
-func (mr *MockapplicationMockRecorder) Auth(ctx, token interface{}) *gomock.Call {
+func (mr *MockapplicationMockRecorder) Auth(ctx, token, origin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*Mockapplication)(nil).Auth), ctx, token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*Mockapplication)(nil).Auth), ctx, token, origin)
 }
 
 // CreateUser mocks base method.
@@ -95,6 +95,36 @@ func (mr *MockapplicationMockRecorder) DeleteUser(ctx, session interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*Mockapplication)(nil).DeleteUser), ctx, session)
 }
 
+// ListSecurityEvents mocks base method.
+func (m *Mockapplication) ListSecurityEvents(ctx context.Context, session app.Session, limit int) ([]app.SecurityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecurityEvents", ctx, session, limit)
+	ret0, _ := ret[0].([]app.SecurityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecurityEvents indicates an expected call of ListSecurityEvents.
+func (mr *MockapplicationMockRecorder) ListSecurityEvents(ctx, session, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityEvents", reflect.TypeOf((*Mockapplication)(nil).ListSecurityEvents), ctx, session, limit)
+}
+
+// ListSessions mocks base method.
+func (m *Mockapplication) ListSessions(ctx context.Context, session app.Session) ([]app.SessionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, session)
+	ret0, _ := ret[0].([]app.SessionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockapplicationMockRecorder) ListSessions(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*Mockapplication)(nil).ListSessions), ctx, session)
+}
+
 // ListUserByUsername mocks base method.
 func (m *Mockapplication) ListUserByUsername(ctx context.Context, session app.Session, username string, page app.SearchParams) ([]app.User, int, error) {
 	m.ctrl.T.Helper()
@@ -112,10 +142,10 @@ func (mr *MockapplicationMockRecorder) ListUserByUsername(ctx, session, username
 }
 
 // Login mocks base method.
-func (m *Mockapplication) Login(ctx context.Context, email, password string, origin app.Origin) (*app.Token, error) {
+func (m *Mockapplication) Login(ctx context.Context, email, password string, origin app.Origin) (*app.TokenPair, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Login", ctx, email, password, origin)
-	ret0, _ := ret[0].(*app.Token)
+	ret0, _ := ret[0].(*app.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -140,6 +170,35 @@ func (mr *MockapplicationMockRecorder) Logout(ctx, session interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*Mockapplication)(nil).Logout), ctx, session)
 }
 
+// Refresh mocks base method.
+func (m *Mockapplication) Refresh(ctx context.Context, refreshToken string) (*app.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*app.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockapplicationMockRecorder) Refresh(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*Mockapplication)(nil).Refresh), ctx, refreshToken)
+}
+
+// RemoveOtherSessions mocks base method.
+func (m *Mockapplication) RemoveOtherSessions(ctx context.Context, session app.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOtherSessions", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOtherSessions indicates an expected call of RemoveOtherSessions.
+func (mr *MockapplicationMockRecorder) RemoveOtherSessions(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOtherSessions", reflect.TypeOf((*Mockapplication)(nil).RemoveOtherSessions), ctx, session)
+}
+
 // UpdatePassword mocks base method.
 func (m *Mockapplication) UpdatePassword(ctx context.Context, session app.Session, oldPass, newPass string) error {
 	m.ctrl.T.Helper()
@@ -44,24 +44,41 @@ type (
 
 	// AuthSvc module for manager user session.
 	AuthSvc interface {
-		// Session returns user session by his token.
+		// Session returns user session by his token used from origin,
+		// session usage from origin rejected by policy is treated as unknown session.
 		// Errors: ErrNotFound, unknown.
-		Session(ctx context.Context, token string) (*Session, error)
+		Session(ctx context.Context, token string, origin Origin) (*Session, error)
 		// NewSession generate new session for specific user.
 		// Errors: unknown.
-		NewSession(ctx context.Context, userID uuid.UUID, origin Origin) (*Token, error)
+		NewSession(ctx context.Context, userID uuid.UUID, origin Origin) (*TokenPair, error)
+		// Refresh exchanges refresh token for new pair of tokens.
+		// Reused refresh token revokes its session.
+		// Errors: ErrNotFound, unknown.
+		Refresh(ctx context.Context, refreshToken string) (*TokenPair, error)
 		// RemoveSession removes session by id.
 		// Errors: ErrNotFound, unknown.
 		RemoveSession(ctx context.Context, sessionID uuid.UUID) error
+		// ListSessions returns active user's sessions, most recently used first.
+		// Errors: unknown.
+		ListSessions(ctx context.Context, userID uuid.UUID) ([]SessionInfo, error)
+		// RemoveAllSessions removes all user's sessions except the specified one.
+		// Errors: unknown.
+		RemoveAllSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) error
+		// ListEvents returns up to limit last security events of user, most recent first.
+		// Errors: unknown.
+		ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]SecurityEvent, error)
 	}
 
 	// FileSvc module for manage files.
 	FileSvc interface {
-		// Upload file to database.
-		// Errors: unknown.
-		Upload(ctx context.Context, file io.Reader) (uuid.UUID, error)
-		// Delete remove file from database.
+		// Upload file to database, file is rejected if it does not satisfy the policy or is infected.
+		// Errors: ErrNotAllowedType, ErrFileTooLarge, ErrQuotaExceeded, ErrFileInfected, unknown.
+		Upload(ctx context.Context, file io.Reader, policy FilePolicy) (uuid.UUID, error)
+		// Claim adds reference to file, file without references is removed by file service.
+		// Errors: ErrNotFound, unknown.
+		Claim(ctx context.Context, fileID uuid.UUID, ref string) error
+		// Release removes reference to file added by Claim.
 		// Errors: ErrNotFound, unknown.
-		Delete(ctx context.Context, uuid uuid.UUID) error
+		Release(ctx context.Context, fileID uuid.UUID, ref string) error
 	}
 )
@@ -22,6 +22,37 @@ type (
 		UserID uuid.UUID
 	}
 
+	// SessionInfo contains info about where user is logged in.
+	SessionInfo struct {
+		ID         uuid.UUID
+		IP         net.IP
+		UserAgent  string
+		CreatedAt  time.Time
+		LastSeenAt time.Time
+		// Current is set for session of request.
+		Current bool
+	}
+
+	// SecurityEvent contains security event of user session, e.g. new sign-in,
+	// so user can notice sign-in by somebody else.
+	SecurityEvent struct {
+		ID        uuid.UUID
+		Kind      EventKind
+		SessionID uuid.UUID
+		IP        net.IP
+		UserAgent string
+		// Country contains ISO 3166-1 alpha-2 code of origin country, empty if unknown.
+		Country string
+		// Reasons contains failed origin checks of suspicious and rejected session usage.
+		Reasons   []string
+		CreatedAt time.Time
+		// Current is set for events of session of request.
+		Current bool
+	}
+
+	// EventKind contains kind of security event.
+	EventKind string
+
 	// User contains user information.
 	User struct {
 		ID        uuid.UUID
@@ -35,7 +66,14 @@ type (
 
 	// Token contains auth token.
 	Token struct {
-		Value string
+		Value     string
+		ExpiresAt time.Time
+	}
+
+	// TokenPair contains short-lived access token and long-lived refresh token.
+	TokenPair struct {
+		Access  Token
+		Refresh Token
 	}
 
 	// Subject contains info to be saved in token.
@@ -59,4 +97,31 @@ type (
 		// Metadata contains file meta info.
 		Metadata json.RawMessage
 	}
+
+	// FilePolicy contains restrictions for uploaded file.
+	FilePolicy struct {
+		// AllowedTypes contains allowed MIME types, e.g. "image/png" or "image/*".
+		AllowedTypes []string
+		// MaxSize contains max file size.
+		MaxSize int64
+		// Public allows anyone to read file, otherwise only user service can read it.
+		Public bool
+		// UserID contains user charged for file in storage quota,
+		// user service is charged if uuid.Nil.
+		UserID uuid.UUID
+	}
+)
+
+// Security event kinds.
+const (
+	EventNewSignIn  EventKind = "new_sign_in"
+	EventSuspicious EventKind = "suspicious"
+	EventRejected   EventKind = "rejected"
 )
+
+// AvatarPolicy restricts avatars to small images.
+var AvatarPolicy = FilePolicy{
+	AllowedTypes: []string{"image/png", "image/jpeg", "image/gif", "image/webp"},
+	MaxSize:      5 << 20,
+	Public:       true,
+}
@@ -11,4 +11,8 @@ var (
 	ErrNotFound         = errors.New("not found")
 	ErrNotDifferent     = errors.New("the values must be different")
 	ErrNotValidPassword = errors.New("not valid password")
+	ErrNotAllowedType   = errors.New("file type not allowed")
+	ErrFileTooLarge     = errors.New("file too large")
+	ErrQuotaExceeded    = errors.New("storage quota exceeded")
+	ErrFileInfected     = errors.New("file infected")
 )
@@ -66,8 +66,26 @@ func (m *Module) UserByID(ctx context.Context, _ Session, userID uuid.UUID) (*Us
 }
 
 // DeleteUser remove user from db.
+// Avatars are released before user is removed and claimed back if removing fails.
 func (m *Module) DeleteUser(ctx context.Context, session Session) error {
-	return m.user.Delete(ctx, session.UserID)
+	user, err := m.user.ByID(ctx, session.UserID)
+	if err != nil {
+		return fmt.Errorf("m.user.ByID: %w", err)
+	}
+
+	for i, fileID := range user.Avatars {
+		err = m.releaseAvatar(ctx, user.ID, fileID)
+		if err != nil {
+			return fmt.Errorf("m.releaseAvatar: %w, m.claimAvatars: %s", err, m.claimAvatars(ctx, user.ID, user.Avatars[:i]))
+		}
+	}
+
+	err = m.user.Delete(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("m.user.Delete: %w, m.claimAvatars: %s", err, m.claimAvatars(ctx, user.ID, user.Avatars))
+	}
+
+	return nil
 }
 
 // UpdateUsername update username.
@@ -114,13 +132,13 @@ func (m *Module) ListUserByUsername(ctx context.Context, _ Session, username str
 	return m.user.ListUserByUsername(ctx, username, p)
 }
 
-// Auth get user session by token.
-func (m *Module) Auth(ctx context.Context, token string) (*Session, error) {
-	return m.auth.Session(ctx, token)
+// Auth get user session by token used from origin.
+func (m *Module) Auth(ctx context.Context, token string, origin Origin) (*Session, error) {
+	return m.auth.Session(ctx, token, origin)
 }
 
-// Login make new session and returns auth token.
-func (m *Module) Login(ctx context.Context, email, password string, origin Origin) (*Token, error) {
+// Login make new session and returns auth tokens.
+func (m *Module) Login(ctx context.Context, email, password string, origin Origin) (*TokenPair, error) {
 	email = strings.ToLower(email)
 	user, err := m.user.ByEmail(ctx, email)
 	if err != nil {
@@ -134,11 +152,55 @@ func (m *Module) Login(ctx context.Context, email, password string, origin Origi
 	return m.auth.NewSession(ctx, user.ID, origin)
 }
 
+// Refresh returns new auth tokens by refresh token.
+func (m *Module) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	return m.auth.Refresh(ctx, refreshToken)
+}
+
 // Logout remove user session.
 func (m *Module) Logout(ctx context.Context, session Session) error {
 	return m.auth.RemoveSession(ctx, session.ID)
 }
 
+// ListSessions returns active sessions of user, current session is marked.
+func (m *Module) ListSessions(ctx context.Context, session Session) ([]SessionInfo, error) {
+	sessions, err := m.auth.ListSessions(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("m.auth.ListSessions: %w", err)
+	}
+
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == session.ID
+	}
+
+	return sessions, nil
+}
+
+// ListSecurityEvents returns up to limit last security events of user, e.g. new sign-ins,
+// most recent first, events of current session are marked.
+func (m *Module) ListSecurityEvents(ctx context.Context, session Session, limit int) ([]SecurityEvent, error) {
+	events, err := m.auth.ListEvents(ctx, session.UserID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("m.auth.ListEvents: %w", err)
+	}
+
+	for i := range events {
+		events[i].Current = events[i].SessionID == session.ID
+	}
+
+	return events, nil
+}
+
+// RemoveOtherSessions signs out user everywhere except current session.
+func (m *Module) RemoveOtherSessions(ctx context.Context, session Session) error {
+	err := m.auth.RemoveAllSessions(ctx, session.UserID, session.ID)
+	if err != nil {
+		return fmt.Errorf("m.auth.RemoveAllSessions: %w", err)
+	}
+
+	return nil
+}
+
 // UploadAvatar upload new avatar for user account.
 func (m *Module) UploadAvatar(ctx context.Context, session Session, file io.Reader) error {
 	user, err := m.user.ByID(ctx, session.UserID)
@@ -146,40 +208,99 @@ func (m *Module) UploadAvatar(ctx context.Context, session Session, file io.Read
 		return fmt.Errorf("m.user.ByID: %w", err)
 	}
 
-	fileID, err := m.file.Upload(ctx, file)
+	policy := AvatarPolicy
+	policy.UserID = user.ID
+
+	fileID, err := m.file.Upload(ctx, file, policy)
 	if err != nil {
 		return fmt.Errorf("m.file.Upload: %w", err)
 	}
 
+	// Avatar is claimed before it is saved, so it is never removed while user references it.
+	err = m.file.Claim(ctx, fileID, avatarRef(user.ID))
+	if err != nil {
+		return fmt.Errorf("m.file.Claim: %w", err)
+	}
+
 	user.Avatars = append(user.Avatars, fileID)
 
-	return m.user.Update(ctx, *user)
+	err = m.user.Update(ctx, *user)
+	if err != nil {
+		// Not saved avatar is removed by file service after release,
+		// if release fails it is kept until user is deleted.
+		return fmt.Errorf("m.user.Update: %w, m.file.Release: %s", err, m.file.Release(ctx, fileID, avatarRef(user.ID)))
+	}
+
+	return nil
 }
 
 // DeleteAvatar delete user's avatar from service.
+// Avatar is released before user is updated and claimed back if updating fails.
 func (m *Module) DeleteAvatar(ctx context.Context, session Session, fileID uuid.UUID) error {
 	user, err := m.user.ByID(ctx, session.UserID)
 	if err != nil {
 		return fmt.Errorf("m.user.ByID: %w", err)
 	}
 
-	fileIDIndex := 0
+	fileIDIndex := -1
 	for i := range user.Avatars {
 		if user.Avatars[i] == fileID {
 			fileIDIndex = i
 		}
 	}
 
-	if fileIDIndex == 0 {
+	if fileIDIndex == -1 {
 		return ErrNotFound
 	}
 
-	user.Avatars = append(user.Avatars[:fileIDIndex], user.Avatars[fileIDIndex+1:]...)
+	avatars := make([]uuid.UUID, 0, len(user.Avatars)-1)
+	avatars = append(avatars, user.Avatars[:fileIDIndex]...)
+	user.Avatars = append(avatars, user.Avatars[fileIDIndex+1:]...)
 
-	err = m.file.Delete(ctx, fileID)
+	err = m.releaseAvatar(ctx, user.ID, fileID)
 	if err != nil {
-		return fmt.Errorf("m.file.Delete: %w", err)
+		return fmt.Errorf("m.releaseAvatar: %w", err)
 	}
 
-	return m.user.Update(ctx, *user)
+	err = m.user.Update(ctx, *user)
+	if err != nil {
+		return fmt.Errorf("m.user.Update: %w, m.claimAvatars: %s", err, m.claimAvatars(ctx, user.ID, []uuid.UUID{fileID}))
+	}
+
+	return nil
+}
+
+// releaseAvatar removes references to user avatar, so file service removes it.
+// Avatars uploaded before claims were added are referenced by legacyRef.
+// Avatar which is already released or removed is skipped.
+func (m *Module) releaseAvatar(ctx context.Context, userID, fileID uuid.UUID) error {
+	for _, ref := range []string{avatarRef(userID), legacyRef} {
+		err := m.file.Release(ctx, fileID, ref)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("m.file.Release: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// claimAvatars claims back avatars released before failed update of user.
+func (m *Module) claimAvatars(ctx context.Context, userID uuid.UUID, fileIDs []uuid.UUID) error {
+	for _, fileID := range fileIDs {
+		err := m.file.Claim(ctx, fileID, avatarRef(userID))
+		if err != nil {
+			return fmt.Errorf("m.file.Claim: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// legacyRef is reference of files claimed by file service migration,
+// it is shared by all avatars uploaded before claims were added.
+const legacyRef = "legacy"
+
+// avatarRef returns reference of user avatars in file service.
+func avatarRef(userID uuid.UUID) string {
+	return "user:" + userID.String() + ":avatar"
 }
@@ -3,7 +3,9 @@ package app_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
+	"net"
 	"testing"
 	"time"
 
@@ -170,27 +172,55 @@ func TestModule_UserByID(t *testing.T) {
 func TestModule_DeleteUser(t *testing.T) {
 	t.Parallel()
 
-	module, mocks, assert := start(t)
-
-	session := &app.Session{
+	fileID := uuid.Must(uuid.NewV4())
+	fileID2 := uuid.Must(uuid.NewV4())
+	user := app.User{
+		ID:      uuid.Must(uuid.NewV4()),
+		Avatars: []uuid.UUID{fileID, fileID2},
+	}
+	ref := "user:" + user.ID.String() + ":avatar"
+	session := app.Session{
 		ID:     uuid.Must(uuid.NewV4()),
-		UserID: uuid.Must(uuid.NewV4()),
+		UserID: user.ID,
 	}
 
-	mocks.repo.EXPECT().Delete(ctx, session.UserID).Return(nil)
-
 	testCases := []struct {
-		name    string
-		session *app.Session
-		want    error
+		name       string
+		releaseErr error
+		deleteErr  error
+		want       error
 	}{
-		{"success", session, nil},
+		{"success", nil, nil, nil},
+		{"success_released", app.ErrNotFound, nil, nil},
+		{"err_release", errAny, nil, errAny},
+		{"err_delete", nil, errAny, errAny},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			err := module.DeleteUser(ctx, *tc.session)
+			t.Parallel()
+
+			module, mocks, assert := start(t)
+
+			u := user
+			mocks.repo.EXPECT().ByID(ctx, user.ID).Return(&u, nil)
+			mocks.file.EXPECT().Release(ctx, fileID, ref).Return(nil)
+			mocks.file.EXPECT().Release(ctx, fileID, legacyRef).Return(app.ErrNotFound)
+			mocks.file.EXPECT().Release(ctx, fileID2, ref).Return(tc.releaseErr)
+			if tc.releaseErr == errAny {
+				// Released avatars are claimed back.
+				mocks.file.EXPECT().Claim(ctx, fileID, ref).Return(nil)
+			} else {
+				mocks.file.EXPECT().Release(ctx, fileID2, legacyRef).Return(nil)
+				mocks.repo.EXPECT().Delete(ctx, user.ID).Return(tc.deleteErr)
+				if tc.deleteErr != nil {
+					mocks.file.EXPECT().Claim(ctx, fileID, ref).Return(nil)
+					mocks.file.EXPECT().Claim(ctx, fileID2, ref).Return(nil)
+				}
+			}
+
+			err := module.DeleteUser(ctx, session)
 			assert.ErrorIs(err, tc.want)
 		})
 	}
@@ -366,8 +396,9 @@ func TestModule_Auth(t *testing.T) {
 	}
 
 	const token = "token"
+	origin := app.Origin{IP: net.ParseIP("192.100.10.4"), UserAgent: "Mozilla/5.0"}
 
-	mocks.auth.EXPECT().Session(ctx, token).Return(session, nil)
+	mocks.auth.EXPECT().Session(ctx, token, origin).Return(session, nil)
 
 	testCases := []struct {
 		name    string
@@ -381,7 +412,7 @@ func TestModule_Auth(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			res, err := module.Auth(ctx, tc.token)
+			res, err := module.Auth(ctx, tc.token, origin)
 			assert.ErrorIs(err, tc.wantErr)
 			assert.Equal(tc.want, res)
 		})
@@ -402,8 +433,9 @@ func TestModule_Login(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	token := &app.Token{
-		Value: "auth-token",
+	token := &app.TokenPair{
+		Access:  app.Token{Value: "auth-token", ExpiresAt: time.Now().Add(time.Minute)},
+		Refresh: app.Token{Value: "refresh-token", ExpiresAt: time.Now().Add(time.Hour)},
 	}
 
 	const (
@@ -421,7 +453,7 @@ func TestModule_Login(t *testing.T) {
 		name    string
 		email   string
 		pass    string
-		want    *app.Token
+		want    *app.TokenPair
 		wantErr error
 	}{
 		{"success", user.Email, string(user.PassHash), token, nil},
@@ -439,6 +471,44 @@ func TestModule_Login(t *testing.T) {
 	}
 }
 
+func TestModule_Refresh(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := start(t)
+
+	tokens := &app.TokenPair{
+		Access:  app.Token{Value: "auth-token", ExpiresAt: time.Now().Add(time.Minute)},
+		Refresh: app.Token{Value: "new-refresh-token", ExpiresAt: time.Now().Add(time.Hour)},
+	}
+
+	const (
+		refreshToken = `refresh-token`
+		reusedToken  = `reused-token`
+	)
+
+	mocks.auth.EXPECT().Refresh(ctx, refreshToken).Return(tokens, nil)
+	mocks.auth.EXPECT().Refresh(ctx, reusedToken).Return(nil, app.ErrNotFound)
+
+	testCases := []struct {
+		name    string
+		token   string
+		want    *app.TokenPair
+		wantErr error
+	}{
+		{"success", refreshToken, tokens, nil},
+		{"err_not_found", reusedToken, nil, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.Refresh(ctx, tc.token)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
 func TestModule_Logout(t *testing.T) {
 	t.Parallel()
 
@@ -468,11 +538,87 @@ func TestModule_Logout(t *testing.T) {
 	}
 }
 
-func TestModule_UploadAvatar(t *testing.T) {
+func TestModule_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := start(t)
+
+	var (
+		session = app.Session{
+			ID:     uuid.Must(uuid.NewV4()),
+			UserID: uuid.Must(uuid.NewV4()),
+		}
+		other = app.SessionInfo{
+			ID:         uuid.Must(uuid.NewV4()),
+			UserAgent:  "other",
+			CreatedAt:  time.Now().Add(-time.Hour),
+			LastSeenAt: time.Now(),
+		}
+		current = app.SessionInfo{
+			ID:         session.ID,
+			UserAgent:  "current",
+			CreatedAt:  time.Now().Add(-time.Hour),
+			LastSeenAt: time.Now(),
+		}
+		currentMarked = current
+	)
+	currentMarked.Current = true
+
+	mocks.auth.EXPECT().ListSessions(ctx, session.UserID).Return([]app.SessionInfo{other, current}, nil)
+	mocks.auth.EXPECT().ListSessions(ctx, session.UserID).Return(nil, errAny)
+
+	testCases := []struct {
+		name    string
+		want    []app.SessionInfo
+		wantErr error
+	}{
+		{"success", []app.SessionInfo{other, currentMarked}, nil},
+		{"err_any", nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := module.ListSessions(ctx, session)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
+		})
+	}
+}
+
+func TestModule_RemoveOtherSessions(t *testing.T) {
 	t.Parallel()
 
 	module, mocks, assert := start(t)
 
+	session := app.Session{
+		ID:     uuid.Must(uuid.NewV4()),
+		UserID: uuid.Must(uuid.NewV4()),
+	}
+
+	mocks.auth.EXPECT().RemoveAllSessions(ctx, session.UserID, session.ID).Return(nil)
+	mocks.auth.EXPECT().RemoveAllSessions(ctx, session.UserID, session.ID).Return(errAny)
+
+	testCases := []struct {
+		name    string
+		wantErr error
+	}{
+		{"success", nil},
+		{"err_any", errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := module.RemoveOtherSessions(ctx, session)
+			assert.ErrorIs(err, tc.wantErr)
+		})
+	}
+}
+
+func TestModule_UploadAvatar(t *testing.T) {
+	t.Parallel()
+
 	fileID := uuid.Must(uuid.NewV4())
 	userNotFoundID := uuid.Must(uuid.NewV4())
 
@@ -486,45 +632,70 @@ func TestModule_UploadAvatar(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	userWithAvatar := app.User{
-		ID:        userWithoutAvatar.ID,
-		Email:     "email@mail.com",
-		Name:      "username",
-		PassHash:  []byte{12, 12, 34, 124, 19},
-		Avatars:   []uuid.UUID{fileID},
-		CreatedAt: userWithoutAvatar.CreatedAt,
-		UpdatedAt: userWithoutAvatar.UpdatedAt,
-	}
+	userWithAvatar := userWithoutAvatar
+	userWithAvatar.Avatars = []uuid.UUID{fileID}
+	ref := "user:" + userWithoutAvatar.ID.String() + ":avatar"
+	policy := app.AvatarPolicy
+	policy.UserID = userWithoutAvatar.ID
 
 	correctFile := bytes.NewBuffer(uuid.Must(uuid.NewV4()).Bytes())
 
+	errRelease := errors.New("release err")
+
 	session := &app.Session{
 		ID:     uuid.Must(uuid.NewV4()),
 		UserID: userWithoutAvatar.ID,
 	}
 
-	mocks.repo.EXPECT().ByID(ctx, userWithoutAvatar.ID).Return(&userWithoutAvatar, nil).Times(2)
-	mocks.repo.EXPECT().ByID(ctx, userNotFoundID).Return(nil, app.ErrNotFound)
-	mocks.file.EXPECT().Upload(ctx, correctFile).Return(fileID, nil)
-	mocks.file.EXPECT().Upload(ctx, nil).Return(uuid.Nil, errAny)
-	mocks.repo.EXPECT().Update(ctx, userWithAvatar).Return(nil)
-
 	testCases := []struct {
-		name    string
-		session *app.Session
-		file    io.Reader
-		wantErr error
+		name       string
+		session    *app.Session
+		file       io.Reader
+		uploadErr  error
+		claimErr   error
+		updateErr  error
+		releaseErr error
+		wantErr    error
 	}{
-		{"success", session, correctFile, nil},
-		{"err_upload_file", session, nil, errAny},
-		{"err_user_not_found", &app.Session{UserID: userNotFoundID}, nil, app.ErrNotFound},
+		{"success", session, correctFile, nil, nil, nil, nil, nil},
+		{"err_upload_file", session, nil, errAny, nil, nil, nil, errAny},
+		{"err_quota_exceeded", session, nil, app.ErrQuotaExceeded, nil, nil, nil, app.ErrQuotaExceeded},
+		{"err_claim_file", session, correctFile, nil, errAny, nil, nil, errAny},
+		{"err_update_user", session, correctFile, nil, nil, errAny, nil, errAny},
+		{"err_update_user_release", session, correctFile, nil, nil, errAny, errRelease, errAny},
+		{"err_user_not_found", &app.Session{UserID: userNotFoundID}, nil, nil, nil, nil, nil, app.ErrNotFound},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			module, mocks, assert := start(t)
+
+			if tc.session.UserID == userNotFoundID {
+				mocks.repo.EXPECT().ByID(ctx, userNotFoundID).Return(nil, app.ErrNotFound)
+			} else {
+				user := userWithoutAvatar
+				mocks.repo.EXPECT().ByID(ctx, user.ID).Return(&user, nil)
+				mocks.file.EXPECT().Upload(ctx, tc.file, policy).Return(fileID, tc.uploadErr)
+			}
+
+			if tc.uploadErr == nil && tc.file != nil {
+				mocks.file.EXPECT().Claim(ctx, fileID, ref).Return(tc.claimErr)
+				if tc.claimErr == nil {
+					mocks.repo.EXPECT().Update(ctx, userWithAvatar).Return(tc.updateErr)
+				}
+				if tc.updateErr != nil {
+					mocks.file.EXPECT().Release(ctx, fileID, ref).Return(tc.releaseErr)
+				}
+			}
+
 			err := module.UploadAvatar(ctx, *tc.session, tc.file)
 			assert.ErrorIs(err, tc.wantErr)
+			if tc.releaseErr != nil {
+				assert.Contains(err.Error(), tc.releaseErr.Error())
+			}
 		})
 	}
 }
@@ -532,8 +703,6 @@ func TestModule_UploadAvatar(t *testing.T) {
 func TestModule_DeleteAvatar(t *testing.T) {
 	t.Parallel()
 
-	module, mocks, assert := start(t)
-
 	fileID := uuid.Must(uuid.NewV4())
 	fileID2 := uuid.Must(uuid.NewV4())
 	fileID3 := uuid.Must(uuid.NewV4())
@@ -549,45 +718,115 @@ func TestModule_DeleteAvatar(t *testing.T) {
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
-
-	userWithoutSecondAvatar := app.User{
-		ID:        user.ID,
-		Email:     "email@mail.com",
-		Name:      "username",
-		PassHash:  []byte{12, 12, 34, 124, 19},
-		Avatars:   []uuid.UUID{fileID, fileID3},
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-	}
+	ref := "user:" + user.ID.String() + ":avatar"
 
 	session := &app.Session{
 		ID:     uuid.Must(uuid.NewV4()),
 		UserID: user.ID,
 	}
 
-	mocks.repo.EXPECT().ByID(ctx, user.ID).Return(&user, nil).Times(3)
-	mocks.repo.EXPECT().ByID(ctx, userNotFoundID).Return(nil, app.ErrNotFound)
-	mocks.file.EXPECT().Delete(ctx, fileID2).Return(nil)
-	mocks.file.EXPECT().Delete(ctx, fileID3).Return(errAny)
-	mocks.repo.EXPECT().Update(ctx, userWithoutSecondAvatar).Return(nil)
+	testCases := []struct {
+		name       string
+		session    *app.Session
+		fileID     uuid.UUID
+		want       []uuid.UUID
+		updateErr  error
+		releaseErr error
+		wantErr    error
+	}{
+		{"success", session, fileID2, []uuid.UUID{fileID, fileID3}, nil, nil, nil},
+		{"success_first", session, fileID, []uuid.UUID{fileID2, fileID3}, nil, nil, nil},
+		{"success_released", session, fileID3, []uuid.UUID{fileID, fileID2}, nil, app.ErrNotFound, nil},
+		{"err_update_user", session, fileID2, []uuid.UUID{fileID, fileID3}, errAny, nil, errAny},
+		{"err_release_file", session, fileID3, nil, nil, errAny, errAny},
+		{"err_file_not_found", session, uuid.Nil, nil, nil, nil, app.ErrNotFound},
+		{"err_user_not_found", &app.Session{UserID: userNotFoundID}, uuid.Nil, nil, nil, nil, app.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			module, mocks, assert := start(t)
+
+			if tc.session.UserID == userNotFoundID {
+				mocks.repo.EXPECT().ByID(ctx, userNotFoundID).Return(nil, app.ErrNotFound)
+			} else {
+				u := user
+				mocks.repo.EXPECT().ByID(ctx, user.ID).Return(&u, nil)
+			}
+
+			if tc.releaseErr != nil {
+				mocks.file.EXPECT().Release(ctx, tc.fileID, ref).Return(tc.releaseErr)
+			}
+
+			if tc.want != nil {
+				if tc.releaseErr == nil {
+					mocks.file.EXPECT().Release(ctx, tc.fileID, ref).Return(nil)
+				}
+				mocks.file.EXPECT().Release(ctx, tc.fileID, legacyRef).Return(nil)
+
+				updated := user
+				updated.Avatars = tc.want
+				mocks.repo.EXPECT().Update(ctx, updated).Return(tc.updateErr)
+				if tc.updateErr != nil {
+					// Released avatar is claimed back.
+					mocks.file.EXPECT().Claim(ctx, tc.fileID, ref).Return(nil)
+				}
+			}
+
+			err := module.DeleteAvatar(ctx, *tc.session, tc.fileID)
+			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal([]uuid.UUID{fileID, fileID2, fileID3}, user.Avatars)
+		})
+	}
+}
+
+func TestModule_ListSecurityEvents(t *testing.T) {
+	t.Parallel()
+
+	module, mocks, assert := start(t)
+
+	var (
+		session = app.Session{
+			ID:     uuid.Must(uuid.NewV4()),
+			UserID: uuid.Must(uuid.NewV4()),
+		}
+		other = app.SecurityEvent{
+			ID:        uuid.Must(uuid.NewV4()),
+			Kind:      app.EventNewSignIn,
+			SessionID: uuid.Must(uuid.NewV4()),
+			CreatedAt: time.Now(),
+		}
+		current = app.SecurityEvent{
+			ID:        uuid.Must(uuid.NewV4()),
+			Kind:      app.EventNewSignIn,
+			SessionID: session.ID,
+			CreatedAt: time.Now().Add(-time.Hour),
+		}
+		currentMarked = current
+	)
+	currentMarked.Current = true
+
+	mocks.auth.EXPECT().ListEvents(ctx, session.UserID, 20).Return([]app.SecurityEvent{other, current}, nil)
+	mocks.auth.EXPECT().ListEvents(ctx, session.UserID, 20).Return(nil, errAny)
 
 	testCases := []struct {
 		name    string
-		session *app.Session
-		fileID  uuid.UUID
+		want    []app.SecurityEvent
 		wantErr error
 	}{
-		{"success", session, fileID2, nil},
-		{"err_file_not_delete", session, fileID3, errAny},
-		{"err_file_not_found", session, uuid.Nil, app.ErrNotFound},
-		{"err_user_not_found", &app.Session{UserID: userNotFoundID}, uuid.Nil, app.ErrNotFound},
+		{"success", []app.SecurityEvent{other, currentMarked}, nil},
+		{"err_any", nil, errAny},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			err := module.DeleteAvatar(ctx, *tc.session, tc.fileID)
+			res, err := module.ListSecurityEvents(ctx, session, 20)
 			assert.ErrorIs(err, tc.wantErr)
+			assert.Equal(tc.want, res)
 		})
 	}
 }
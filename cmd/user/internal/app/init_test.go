@@ -21,6 +21,9 @@ var (
 	}
 )
 
+// legacyRef is reference of avatars claimed by file service migration.
+const legacyRef = "legacy"
+
 type mocks struct {
 	hasher *MockHasher
 	repo   *MockRepo
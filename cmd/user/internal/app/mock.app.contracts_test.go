@@ -216,11 +216,41 @@ func (m *MockAuthSvc) EXPECT() *MockAuthSvcMockRecorder {
 	return m.recorder
 }
 
+// ListEvents mocks base method.
+func (m *MockAuthSvc) ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]app.SecurityEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, userID, limit)
+	ret0, _ := ret[0].([]app.SecurityEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockAuthSvcMockRecorder) ListEvents(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockAuthSvc)(nil).ListEvents), ctx, userID, limit)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthSvc) ListSessions(ctx context.Context, userID uuid.UUID) ([]app.SessionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]app.SessionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthSvcMockRecorder) ListSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthSvc)(nil).ListSessions), ctx, userID)
+}
+
 // NewSession mocks base method.
-func (m *MockAuthSvc) NewSession(ctx context.Context, userID uuid.UUID, origin app.Origin) (*app.Token, error) {
+func (m *MockAuthSvc) NewSession(ctx context.Context, userID uuid.UUID, origin app.Origin) (*app.TokenPair, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewSession", ctx, userID, origin)
-	ret0, _ := ret[0].(*app.Token)
+	ret0, _ := ret[0].(*app.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -231,6 +261,35 @@ func (mr *MockAuthSvcMockRecorder) NewSession(ctx, userID, origin interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockAuthSvc)(nil).NewSession), ctx, userID, origin)
 }
 
+// Refresh mocks base method.
+func (m *MockAuthSvc) Refresh(ctx context.Context, refreshToken string) (*app.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*app.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthSvcMockRecorder) Refresh(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuthSvc)(nil).Refresh), ctx, refreshToken)
+}
+
+// RemoveAllSessions mocks base method.
+func (m *MockAuthSvc) RemoveAllSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAllSessions", ctx, userID, exceptSessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAllSessions indicates an expected call of RemoveAllSessions.
+func (mr *MockAuthSvcMockRecorder) RemoveAllSessions(ctx, userID, exceptSessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllSessions", reflect.TypeOf((*MockAuthSvc)(nil).RemoveAllSessions), ctx, userID, exceptSessionID)
+}
+
 // RemoveSession mocks base method.
 func (m *MockAuthSvc) RemoveSession(ctx context.Context, sessionID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -246,18 +305,18 @@ func (mr *MockAuthSvcMockRecorder) RemoveSession(ctx, sessionID interface{}) *go
 }
 
 // Session mocks base method.
-func (m *MockAuthSvc) Session(ctx context.Context, token string) (*app.Session, error) {
+func (m *MockAuthSvc) Session(ctx context.Context, token string, origin app.Origin) (*app.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Session", ctx, token)
+	ret := m.ctrl.Call(m, "Session", ctx, token, origin)
 	ret0, _ := ret[0].(*app.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Session indicates an expected call of Session.
-func (mr *MockAuthSvcMockRecorder) Session(ctx, token interface{}) *gomock.Call {
+func (mr *MockAuthSvcMockRecorder) Session(ctx, token, origin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*MockAuthSvc)(nil).Session), ctx, token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*MockAuthSvc)(nil).Session), ctx, token, origin)
 }
 
 // MockFileSvc is a mock of FileSvc interface.
@@ -283,31 +342,45 @@ func (m *MockFileSvc) EXPECT() *MockFileSvcMockRecorder {
 	return m.recorder
 }
 
-// Delete mocks base method.
-func (m *MockFileSvc) Delete(ctx context.Context, uuid uuid.UUID) error {
+// Claim mocks base method.
+func (m *MockFileSvc) Claim(ctx context.Context, fileID uuid.UUID, ref string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, uuid)
+	ret := m.ctrl.Call(m, "Claim", ctx, fileID, ref)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockFileSvcMockRecorder) Delete(ctx, uuid interface{}) *gomock.Call {
+// Claim indicates an expected call of Claim.
+func (mr *MockFileSvcMockRecorder) Claim(ctx, fileID, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockFileSvc)(nil).Claim), ctx, fileID, ref)
+}
+
+// Release mocks base method.
+func (m *MockFileSvc) Release(ctx context.Context, fileID uuid.UUID, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, fileID, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockFileSvcMockRecorder) Release(ctx, fileID, ref interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFileSvc)(nil).Delete), ctx, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockFileSvc)(nil).Release), ctx, fileID, ref)
 }
 
 // Upload mocks base method.
-func (m *MockFileSvc) Upload(ctx context.Context, file io.Reader) (uuid.UUID, error) {
+func (m *MockFileSvc) Upload(ctx context.Context, file io.Reader, policy app.FilePolicy) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upload", ctx, file)
+	ret := m.ctrl.Call(m, "Upload", ctx, file, policy)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Upload indicates an expected call of Upload.
-func (mr *MockFileSvcMockRecorder) Upload(ctx, file interface{}) *gomock.Call {
+func (mr *MockFileSvcMockRecorder) Upload(ctx, file, policy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockFileSvc)(nil).Upload), ctx, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockFileSvc)(nil).Upload), ctx, file, policy)
 }
@@ -3,11 +3,13 @@ package file
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
 	"github.com/gofrs/uuid"
 
+	"github.com/Meat-Hook/back-template/cmd/file/client"
 	"github.com/Meat-Hook/back-template/cmd/user/internal/app"
 )
 
@@ -15,8 +17,9 @@ var _ app.FileSvc = &Client{}
 
 // For easy testing.
 type fileSvc interface {
-	Upload(ctx context.Context, r io.Reader) (uuid.UUID, error)
-	Delete(ctx context.Context, fileID uuid.UUID) error
+	Upload(ctx context.Context, r io.Reader, policy client.Policy, access client.Access) (uuid.UUID, error)
+	Claim(ctx context.Context, fileID uuid.UUID, ref string) error
+	Release(ctx context.Context, fileID uuid.UUID, ref string) error
 }
 
 // Client wrapper for session microservice.
@@ -30,20 +33,58 @@ func New(svc fileSvc) *Client {
 }
 
 // Upload for implements app.AuthSvc.
-func (c *Client) Upload(ctx context.Context, file io.Reader) (uuid.UUID, error) {
-	res, err := c.file.Upload(ctx, file)
-	if err != nil {
+func (c *Client) Upload(ctx context.Context, file io.Reader, policy app.FilePolicy) (uuid.UUID, error) {
+	access := client.Access{Visibility: client.VisibilityPrivate}
+	if policy.Public {
+		access.Visibility = client.VisibilityPublic
+	}
+
+	filePolicy := client.Policy{
+		AllowedTypes: policy.AllowedTypes,
+		MaxSize:      policy.MaxSize,
+	}
+	if policy.UserID != uuid.Nil {
+		filePolicy.Account = client.UserPrincipal(policy.UserID)
+	}
+
+	res, err := c.file.Upload(ctx, file, filePolicy, access)
+	switch {
+	case errors.Is(err, client.ErrNotAllowedType):
+		return uuid.Nil, app.ErrNotAllowedType
+	case errors.Is(err, client.ErrTooLarge):
+		return uuid.Nil, app.ErrFileTooLarge
+	case errors.Is(err, client.ErrQuotaExceeded):
+		return uuid.Nil, app.ErrQuotaExceeded
+	case errors.Is(err, client.ErrInfected):
+		return uuid.Nil, app.ErrFileInfected
+	case err != nil:
 		return uuid.Nil, fmt.Errorf("c.file.Upload: %w", err)
 	}
 
 	return res, nil
 }
 
-// Delete for implements app.AuthSvc.
-func (c *Client) Delete(ctx context.Context, fileID uuid.UUID) error {
-	err := c.file.Delete(ctx, fileID)
-	if err != nil {
-		return fmt.Errorf("c.file.Delete: %w", err)
+// Claim for implements app.FileSvc.
+func (c *Client) Claim(ctx context.Context, fileID uuid.UUID, ref string) error {
+	err := c.file.Claim(ctx, fileID, ref)
+	switch {
+	case errors.Is(err, client.ErrNotFound):
+		return app.ErrNotFound
+	case err != nil:
+		return fmt.Errorf("c.file.Claim: %w", err)
+	}
+
+	return nil
+}
+
+// Release for implements app.FileSvc.
+func (c *Client) Release(ctx context.Context, fileID uuid.UUID, ref string) error {
+	err := c.file.Release(ctx, fileID, ref)
+	switch {
+	case errors.Is(err, client.ErrNotFound):
+		return app.ErrNotFound
+	case err != nil:
+		return fmt.Errorf("c.file.Release: %w", err)
 	}
 
 	return nil
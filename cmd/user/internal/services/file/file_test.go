@@ -5,20 +5,36 @@ import (
 	"testing"
 
 	"github.com/gofrs/uuid"
+
+	"github.com/Meat-Hook/back-template/cmd/file/client"
+	"github.com/Meat-Hook/back-template/cmd/user/internal/app"
 )
 
 func TestClient_Upload(t *testing.T) {
 	t.Parallel()
 
 	file := bytes.NewBuffer(uuid.Must(uuid.NewV4()).Bytes())
+	policy := client.Policy{
+		AllowedTypes: app.AvatarPolicy.AllowedTypes,
+		MaxSize:      app.AvatarPolicy.MaxSize,
+	}
+	public := client.Access{Visibility: client.VisibilityPublic}
+	userID := uuid.Must(uuid.NewV4())
 
 	testCases := []struct {
 		name    string
+		userID  uuid.UUID
 		want    uuid.UUID
+		fileErr error
 		wantErr error
 	}{
-		{"success", uuid.Must(uuid.NewV4()), nil},
-		{"err_any", uuid.Nil, errAny},
+		{"success", uuid.Nil, uuid.Must(uuid.NewV4()), nil, nil},
+		{"success_user", userID, uuid.Must(uuid.NewV4()), nil, nil},
+		{"err_not_allowed_type", uuid.Nil, uuid.Nil, client.ErrNotAllowedType, app.ErrNotAllowedType},
+		{"err_too_large", uuid.Nil, uuid.Nil, client.ErrTooLarge, app.ErrFileTooLarge},
+		{"err_quota_exceeded", userID, uuid.Nil, client.ErrQuotaExceeded, app.ErrQuotaExceeded},
+		{"err_infected", userID, uuid.Nil, client.ErrInfected, app.ErrFileInfected},
+		{"err_any", uuid.Nil, uuid.Nil, errAny, errAny},
 	}
 
 	for _, tc := range testCases {
@@ -28,26 +44,67 @@ func TestClient_Upload(t *testing.T) {
 
 			svc, mock, assert := start(t)
 
-			mock.EXPECT().Upload(ctx, file).Return(tc.want, tc.wantErr)
+			filePolicy := policy
+			avatarPolicy := app.AvatarPolicy
+			if tc.userID != uuid.Nil {
+				filePolicy.Account = client.UserPrincipal(tc.userID)
+				avatarPolicy.UserID = tc.userID
+			}
 
-			res, err := svc.Upload(ctx, file)
+			mock.EXPECT().Upload(ctx, file, filePolicy, public).Return(tc.want, tc.fileErr)
+
+			res, err := svc.Upload(ctx, file, avatarPolicy)
 			assert.Equal(tc.want, res)
 			assert.ErrorIs(err, tc.wantErr)
 		})
 	}
 }
 
-func TestClient_Delete(t *testing.T) {
+func TestClient_Claim(t *testing.T) {
+	t.Parallel()
+
+	const ref = "user:1:avatar"
+	fileID := uuid.Must(uuid.NewV4())
+
+	testCases := []struct {
+		name    string
+		fileErr error
+		want    error
+	}{
+		{"success", nil, nil},
+		{"err_not_found", client.ErrNotFound, app.ErrNotFound},
+		{"err_any", errAny, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, mock, assert := start(t)
+
+			mock.EXPECT().Claim(ctx, fileID, ref).Return(tc.fileErr)
+
+			err := svc.Claim(ctx, fileID, ref)
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestClient_Release(t *testing.T) {
 	t.Parallel()
 
+	const ref = "user:1:avatar"
 	fileID := uuid.Must(uuid.NewV4())
 
 	testCases := []struct {
-		name string
-		want error
+		name    string
+		fileErr error
+		want    error
 	}{
-		{"success", nil},
-		{"err_any", errAny},
+		{"success", nil, nil},
+		{"err_not_found", client.ErrNotFound, app.ErrNotFound},
+		{"err_any", errAny, errAny},
 	}
 
 	for _, tc := range testCases {
@@ -57,9 +114,9 @@ func TestClient_Delete(t *testing.T) {
 
 			svc, mock, assert := start(t)
 
-			mock.EXPECT().Delete(ctx, fileID).Return(tc.want)
+			mock.EXPECT().Release(ctx, fileID, ref).Return(tc.fileErr)
 
-			err := svc.Delete(ctx, fileID)
+			err := svc.Release(ctx, fileID, ref)
 			assert.ErrorIs(err, tc.want)
 		})
 	}
@@ -9,6 +9,7 @@ import (
 	io "io"
 	reflect "reflect"
 
+	client "github.com/Meat-Hook/back-template/cmd/file/client"
 	uuid "github.com/gofrs/uuid"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -36,31 +37,45 @@ func (m *MockfileSvc) EXPECT() *MockfileSvcMockRecorder {
 	return m.recorder
 }
 
-// Delete mocks base method.
-func (m *MockfileSvc) Delete(ctx context.Context, fileID uuid.UUID) error {
+// Claim mocks base method.
+func (m *MockfileSvc) Claim(ctx context.Context, fileID uuid.UUID, ref string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, fileID)
+	ret := m.ctrl.Call(m, "Claim", ctx, fileID, ref)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockfileSvcMockRecorder) Delete(ctx, fileID interface{}) *gomock.Call {
+// Claim indicates an expected call of Claim.
+func (mr *MockfileSvcMockRecorder) Claim(ctx, fileID, ref interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockfileSvc)(nil).Delete), ctx, fileID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockfileSvc)(nil).Claim), ctx, fileID, ref)
+}
+
+// Release mocks base method.
+func (m *MockfileSvc) Release(ctx context.Context, fileID uuid.UUID, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, fileID, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockfileSvcMockRecorder) Release(ctx, fileID, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockfileSvc)(nil).Release), ctx, fileID, ref)
 }
 
 // Upload mocks base method.
-func (m *MockfileSvc) Upload(ctx context.Context, r io.Reader) (uuid.UUID, error) {
+func (m *MockfileSvc) Upload(ctx context.Context, r io.Reader, policy client.Policy, access client.Access) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upload", ctx, r)
+	ret := m.ctrl.Call(m, "Upload", ctx, r, policy, access)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Upload indicates an expected call of Upload.
-func (mr *MockfileSvcMockRecorder) Upload(ctx, r interface{}) *gomock.Call {
+func (mr *MockfileSvcMockRecorder) Upload(ctx, r, policy, access interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockfileSvc)(nil).Upload), ctx, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockfileSvc)(nil).Upload), ctx, r, policy, access)
 }
@@ -37,11 +37,41 @@ func (m *MocksessionSvc) EXPECT() *MocksessionSvcMockRecorder {
 	return m.recorder
 }
 
+// ListEvents mocks base method.
+func (m *MocksessionSvc) ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]client.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, userID, limit)
+	ret0, _ := ret[0].([]client.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MocksessionSvcMockRecorder) ListEvents(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MocksessionSvc)(nil).ListEvents), ctx, userID, limit)
+}
+
+// ListSessions mocks base method.
+func (m *MocksessionSvc) ListSessions(ctx context.Context, userID uuid.UUID) ([]client.SessionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]client.SessionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MocksessionSvcMockRecorder) ListSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MocksessionSvc)(nil).ListSessions), ctx, userID)
+}
+
 // NewSession mocks base method.
-func (m *MocksessionSvc) NewSession(ctx context.Context, userID uuid.UUID, ip net.IP, userAgent string) (*client.Token, error) {
+func (m *MocksessionSvc) NewSession(ctx context.Context, userID uuid.UUID, ip net.IP, userAgent string) (*client.TokenPair, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewSession", ctx, userID, ip, userAgent)
-	ret0, _ := ret[0].(*client.Token)
+	ret0, _ := ret[0].(*client.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -52,6 +82,36 @@ func (mr *MocksessionSvcMockRecorder) NewSession(ctx, userID, ip, userAgent inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MocksessionSvc)(nil).NewSession), ctx, userID, ip, userAgent)
 }
 
+// Refresh mocks base method.
+func (m *MocksessionSvc) Refresh(ctx context.Context, refreshToken string) (*client.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*client.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MocksessionSvcMockRecorder) Refresh(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MocksessionSvc)(nil).Refresh), ctx, refreshToken)
+}
+
+// RemoveAllSessions mocks base method.
+func (m *MocksessionSvc) RemoveAllSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAllSessions", ctx, userID, exceptSessionID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAllSessions indicates an expected call of RemoveAllSessions.
+func (mr *MocksessionSvcMockRecorder) RemoveAllSessions(ctx, userID, exceptSessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllSessions", reflect.TypeOf((*MocksessionSvc)(nil).RemoveAllSessions), ctx, userID, exceptSessionID)
+}
+
 // RemoveSession mocks base method.
 func (m *MocksessionSvc) RemoveSession(ctx context.Context, sessionID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -67,16 +127,16 @@ func (mr *MocksessionSvcMockRecorder) RemoveSession(ctx, sessionID interface{})
 }
 
 // Session mocks base method.
-func (m *MocksessionSvc) Session(ctx context.Context, token string) (*client.Session, error) {
+func (m *MocksessionSvc) Session(ctx context.Context, token string, ip net.IP, userAgent string) (*client.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Session", ctx, token)
+	ret := m.ctrl.Call(m, "Session", ctx, token, ip, userAgent)
 	ret0, _ := ret[0].(*client.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Session indicates an expected call of Session.
-func (mr *MocksessionSvcMockRecorder) Session(ctx, token interface{}) *gomock.Call {
+func (mr *MocksessionSvcMockRecorder) Session(ctx, token, ip, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*MocksessionSvc)(nil).Session), ctx, token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*MocksessionSvc)(nil).Session), ctx, token, ip, userAgent)
 }
@@ -17,9 +17,13 @@ var _ app.AuthSvc = &Client{}
 
 // For easy testing.
 type sessionSvc interface {
-	Session(ctx context.Context, token string) (*session.Session, error)
+	Session(ctx context.Context, token string, ip net.IP, userAgent string) (*session.Session, error)
 	RemoveSession(ctx context.Context, sessionID uuid.UUID) error
-	NewSession(ctx context.Context, userID uuid.UUID, ip net.IP, userAgent string) (*session.Token, error)
+	NewSession(ctx context.Context, userID uuid.UUID, ip net.IP, userAgent string) (*session.TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (*session.TokenPair, error)
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]session.SessionInfo, error)
+	RemoveAllSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) (int, error)
+	ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]session.Event, error)
 }
 
 // Client wrapper for session microservice.
@@ -33,10 +37,11 @@ func New(svc sessionSvc) *Client {
 }
 
 // Session for implements app.AuthSvc.
-func (c *Client) Session(ctx context.Context, token string) (*app.Session, error) {
-	res, err := c.session.Session(ctx, token)
+func (c *Client) Session(ctx context.Context, token string, origin app.Origin) (*app.Session, error) {
+	res, err := c.session.Session(ctx, token, origin.IP, origin.UserAgent)
 	switch {
-	case errors.Is(err, session.ErrNotFound):
+	case errors.Is(err, session.ErrNotFound), errors.Is(err, session.ErrExpired),
+		errors.Is(err, session.ErrInvalidToken), errors.Is(err, session.ErrOriginMismatch):
 		return nil, app.ErrNotFound
 	case err != nil:
 		return nil, fmt.Errorf("c.session.Session: %w", err)
@@ -49,13 +54,89 @@ func (c *Client) Session(ctx context.Context, token string) (*app.Session, error
 }
 
 // NewSession for implements app.AuthSvc.
-func (c *Client) NewSession(ctx context.Context, userID uuid.UUID, origin app.Origin) (*app.Token, error) {
+func (c *Client) NewSession(ctx context.Context, userID uuid.UUID, origin app.Origin) (*app.TokenPair, error) {
 	res, err := c.session.NewSession(ctx, userID, origin.IP, origin.UserAgent)
 	if err != nil {
 		return nil, fmt.Errorf("c.session.NewSession: %w", err)
 	}
 
-	return &app.Token{Value: res.Value}, nil
+	return convertTokens(res), nil
+}
+
+// Refresh for implements app.AuthSvc.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (*app.TokenPair, error) {
+	res, err := c.session.Refresh(ctx, refreshToken)
+	switch {
+	case errors.Is(err, session.ErrNotFound), errors.Is(err, session.ErrExpired),
+		errors.Is(err, session.ErrReused), errors.Is(err, session.ErrInvalidToken):
+		return nil, fmt.Errorf("%w: %s", app.ErrNotFound, err)
+	case err != nil:
+		return nil, fmt.Errorf("c.session.Refresh: %w", err)
+	}
+
+	return convertTokens(res), nil
+}
+
+// ListSessions for implements app.AuthSvc.
+func (c *Client) ListSessions(ctx context.Context, userID uuid.UUID) ([]app.SessionInfo, error) {
+	res, err := c.session.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("c.session.ListSessions: %w", err)
+	}
+
+	sessions := make([]app.SessionInfo, len(res))
+	for i := range res {
+		sessions[i] = app.SessionInfo{
+			ID:         res[i].ID,
+			IP:         res[i].IP,
+			UserAgent:  res[i].UserAgent,
+			CreatedAt:  res[i].CreatedAt,
+			LastSeenAt: res[i].LastSeenAt,
+		}
+	}
+
+	return sessions, nil
+}
+
+// RemoveAllSessions for implements app.AuthSvc.
+func (c *Client) RemoveAllSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) error {
+	_, err := c.session.RemoveAllSessions(ctx, userID, exceptSessionID)
+	if err != nil {
+		return fmt.Errorf("c.session.RemoveAllSessions: %w", err)
+	}
+
+	return nil
+}
+
+// ListEvents for implements app.AuthSvc.
+func (c *Client) ListEvents(ctx context.Context, userID uuid.UUID, limit int) ([]app.SecurityEvent, error) {
+	res, err := c.session.ListEvents(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("c.session.ListEvents: %w", err)
+	}
+
+	events := make([]app.SecurityEvent, len(res))
+	for i := range res {
+		events[i] = app.SecurityEvent{
+			ID:        res[i].ID,
+			Kind:      app.EventKind(res[i].Kind),
+			SessionID: res[i].SessionID,
+			IP:        res[i].IP,
+			UserAgent: res[i].UserAgent,
+			Country:   res[i].Country,
+			Reasons:   res[i].Reasons,
+			CreatedAt: res[i].CreatedAt,
+		}
+	}
+
+	return events, nil
+}
+
+func convertTokens(tokens *session.TokenPair) *app.TokenPair {
+	return &app.TokenPair{
+		Access:  app.Token{Value: tokens.Access.Value, ExpiresAt: tokens.Access.ExpiresAt},
+		Refresh: app.Token{Value: tokens.Refresh.Value, ExpiresAt: tokens.Refresh.ExpiresAt},
+	}
 }
 
 // RemoveSession for implements app.AuthSvc.
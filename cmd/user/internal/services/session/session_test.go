@@ -2,6 +2,7 @@ package session_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 
@@ -20,12 +21,16 @@ func TestClient_Session(t *testing.T) {
 	testCases := []struct {
 		name    string
 		token   string
+		svcErr  error
 		want    *app.Session
 		wantErr error
 	}{
-		{"success", "validToken", sessionInfo, nil},
-		{"err_not_found", "notFoundToken", nil, app.ErrNotFound},
-		{"err_any", "notValidToken", nil, errAny},
+		{"success", "validToken", nil, sessionInfo, nil},
+		{"err_not_found", "notFoundToken", client.ErrNotFound, nil, app.ErrNotFound},
+		{"err_expired", "expiredToken", client.ErrExpired, nil, app.ErrNotFound},
+		{"err_invalid_token", "invalidToken", client.ErrInvalidToken, nil, app.ErrNotFound},
+		{"err_origin_mismatch", "stolenToken", client.ErrOriginMismatch, nil, app.ErrNotFound},
+		{"err_any", "notValidToken", errAny, nil, errAny},
 	}
 
 	for _, tc := range testCases {
@@ -42,9 +47,9 @@ func TestClient_Session(t *testing.T) {
 					UserID: tc.want.UserID,
 				}
 			}
-			mock.EXPECT().Session(ctx, tc.token).Return(wantReturn, tc.wantErr)
+			mock.EXPECT().Session(ctx, tc.token, origin.IP, origin.UserAgent).Return(wantReturn, tc.svcErr)
 
-			res, err := svc.Session(ctx, tc.token)
+			res, err := svc.Session(ctx, tc.token, origin)
 			assert.Equal(tc.want, res)
 			assert.ErrorIs(err, tc.wantErr)
 		})
@@ -55,8 +60,13 @@ func TestClient_NewSession(t *testing.T) {
 	t.Parallel()
 
 	var (
-		token = &app.Token{
-			Value: "token",
+		tokens = &client.TokenPair{
+			Access:  client.Token{Value: "token", ExpiresAt: time.Now().Add(time.Minute)},
+			Refresh: client.Token{Value: "refresh", ExpiresAt: time.Now().Add(time.Hour)},
+		}
+		want = &app.TokenPair{
+			Access:  app.Token{Value: tokens.Access.Value, ExpiresAt: tokens.Access.ExpiresAt},
+			Refresh: app.Token{Value: tokens.Refresh.Value, ExpiresAt: tokens.Refresh.ExpiresAt},
 		}
 
 		userID = uuid.Must(uuid.NewV4())
@@ -64,11 +74,12 @@ func TestClient_NewSession(t *testing.T) {
 
 	testCases := []struct {
 		name    string
-		want    *app.Token
+		svcRes  *client.TokenPair
+		want    *app.TokenPair
 		wantErr error
 	}{
-		{"success", token, nil},
-		{"err_any", nil, errAny},
+		{"success", tokens, want, nil},
+		{"err_any", nil, nil, errAny},
 	}
 
 	for _, tc := range testCases {
@@ -77,14 +88,8 @@ func TestClient_NewSession(t *testing.T) {
 			t.Parallel()
 
 			svc, mock, assert := start(t)
-			var wantReturn *client.Token
-			if tc.want != nil {
-				wantReturn = &client.Token{
-					Value: tc.want.Value,
-				}
-			}
 
-			mock.EXPECT().NewSession(ctx, userID, origin.IP, origin.UserAgent).Return(wantReturn, tc.wantErr)
+			mock.EXPECT().NewSession(ctx, userID, origin.IP, origin.UserAgent).Return(tc.svcRes, tc.wantErr)
 
 			res, err := svc.NewSession(ctx, userID, origin)
 			assert.Equal(tc.want, res)
@@ -93,6 +98,51 @@ func TestClient_NewSession(t *testing.T) {
 	}
 }
 
+func TestClient_Refresh(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tokens = &client.TokenPair{
+			Access:  client.Token{Value: "token", ExpiresAt: time.Now().Add(time.Minute)},
+			Refresh: client.Token{Value: "refresh", ExpiresAt: time.Now().Add(time.Hour)},
+		}
+		want = &app.TokenPair{
+			Access:  app.Token{Value: tokens.Access.Value, ExpiresAt: tokens.Access.ExpiresAt},
+			Refresh: app.Token{Value: tokens.Refresh.Value, ExpiresAt: tokens.Refresh.ExpiresAt},
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		svcRes  *client.TokenPair
+		svcErr  error
+		want    *app.TokenPair
+		wantErr error
+	}{
+		{"success", tokens, nil, want, nil},
+		{"err_not_found", nil, client.ErrNotFound, nil, app.ErrNotFound},
+		{"err_expired", nil, client.ErrExpired, nil, app.ErrNotFound},
+		{"err_reused", nil, client.ErrReused, nil, app.ErrNotFound},
+		{"err_invalid_token", nil, client.ErrInvalidToken, nil, app.ErrNotFound},
+		{"err_any", nil, errAny, nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, mock, assert := start(t)
+
+			mock.EXPECT().Refresh(ctx, "refreshToken").Return(tc.svcRes, tc.svcErr)
+
+			res, err := svc.Refresh(ctx, "refreshToken")
+			assert.Equal(tc.want, res)
+			assert.ErrorIs(err, tc.wantErr)
+		})
+	}
+}
+
 func TestClient_RemoveSession(t *testing.T) {
 	t.Parallel()
 
@@ -120,3 +170,134 @@ func TestClient_RemoveSession(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID  = uuid.Must(uuid.NewV4())
+		session = client.SessionInfo{
+			ID:         uuid.Must(uuid.NewV4()),
+			IP:         origin.IP,
+			UserAgent:  origin.UserAgent,
+			CreatedAt:  time.Now().Add(-time.Hour),
+			LastSeenAt: time.Now(),
+		}
+		want = app.SessionInfo{
+			ID:         session.ID,
+			IP:         session.IP,
+			UserAgent:  session.UserAgent,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		svcRes  []client.SessionInfo
+		want    []app.SessionInfo
+		wantErr error
+	}{
+		{"success", []client.SessionInfo{session}, []app.SessionInfo{want}, nil},
+		{"err_any", nil, nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, mock, assert := start(t)
+
+			mock.EXPECT().ListSessions(ctx, userID).Return(tc.svcRes, tc.wantErr)
+
+			res, err := svc.ListSessions(ctx, userID)
+			assert.Equal(tc.want, res)
+			assert.ErrorIs(err, tc.wantErr)
+		})
+	}
+}
+
+func TestClient_RemoveAllSessions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = uuid.Must(uuid.NewV4())
+		sessionID = uuid.Must(uuid.NewV4())
+	)
+
+	testCases := []struct {
+		name string
+		want error
+	}{
+		{"success", nil},
+		{"err_any", errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, mock, assert := start(t)
+
+			mock.EXPECT().RemoveAllSessions(ctx, userID, sessionID).Return(1, tc.want)
+
+			err := svc.RemoveAllSessions(ctx, userID, sessionID)
+			assert.ErrorIs(err, tc.want)
+		})
+	}
+}
+
+func TestClient_ListEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = uuid.Must(uuid.NewV4())
+		event  = client.Event{
+			ID:        uuid.Must(uuid.NewV4()),
+			Kind:      client.EventSuspicious,
+			SessionID: uuid.Must(uuid.NewV4()),
+			IP:        origin.IP,
+			UserAgent: origin.UserAgent,
+			Country:   "FR",
+			Reasons:   []string{"subnet"},
+			CreatedAt: time.Now(),
+		}
+		want = app.SecurityEvent{
+			ID:        event.ID,
+			Kind:      app.EventSuspicious,
+			SessionID: event.SessionID,
+			IP:        event.IP,
+			UserAgent: event.UserAgent,
+			Country:   event.Country,
+			Reasons:   event.Reasons,
+			CreatedAt: event.CreatedAt,
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		svcRes  []client.Event
+		want    []app.SecurityEvent
+		wantErr error
+	}{
+		{"success", []client.Event{event}, []app.SecurityEvent{want}, nil},
+		{"err_any", nil, nil, errAny},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, mock, assert := start(t)
+
+			mock.EXPECT().ListEvents(ctx, userID, 20).Return(tc.svcRes, tc.wantErr)
+
+			res, err := svc.ListEvents(ctx, userID, 20)
+			assert.Equal(tc.want, res)
+			assert.ErrorIs(err, tc.wantErr)
+		})
+	}
+}
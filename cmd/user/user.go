@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	_ "github.com/lib/pq"
 	"github.com/prometheus/client_golang/prometheus"
@@ -41,7 +42,15 @@ type config struct {
 	} `json:"server"`
 	Services struct {
 		SessionAddr string `json:"session_addr"`
-		FileAddr    string `json:"file_addr"`
+		// SessionKeysTTL enables local verification of public access tokens
+		// by session service keys cached for the duration.
+		SessionKeysTTL string `json:"session_keys_ttl"`
+		FileAddr       string `json:"file_addr"`
+		// FileKey authenticates service in file service.
+		FileKey string `json:"file_key"`
+		// SessionCheckInterval contains duration for which locally verified session
+		// isn't checked for revocation by session service, default is used if it's empty.
+		SessionCheckInterval string `json:"session_check_interval"`
 	} `json:"services"`
 }
 
@@ -93,8 +102,25 @@ func (s *Service) RunServe(ctx context.Context, reg *prometheus.Registry, namesp
 	}
 
 	// Build contracts.
-	sessionSvcClient := session.New(session_client.New(grpcConnSession))
-	fileSvcClient := file.New(file_client.New(grpcConnFile))
+	sessionClient := session_client.New(grpcConnSession)
+	sessionSvcClient := session.New(sessionClient)
+	if s.cfg.Services.SessionKeysTTL != "" {
+		keysTTL, err := time.ParseDuration(s.cfg.Services.SessionKeysTTL)
+		if err != nil {
+			return fmt.Errorf("time.ParseDuration: %w", err)
+		}
+
+		checkInterval := session_client.DefaultCheckInterval
+		if s.cfg.Services.SessionCheckInterval != "" {
+			checkInterval, err = time.ParseDuration(s.cfg.Services.SessionCheckInterval)
+			if err != nil {
+				return fmt.Errorf("time.ParseDuration: %w", err)
+			}
+		}
+
+		sessionSvcClient = session.New(session_client.NewVerifier(sessionClient, keysTTL, checkInterval))
+	}
+	fileSvcClient := file.New(file_client.New(grpcConnFile, s.Name(), s.cfg.Services.FileKey))
 	r := repo.New(pg)
 	hasher := hash.New()
 
@@ -4,6 +4,7 @@ go 1.16
 
 require (
 	github.com/Meat-Hook/migrate v0.9.1
+	github.com/alicebob/miniredis/v2 v2.14.5
 	github.com/felixge/httpsnoop v1.0.2
 	github.com/go-openapi/errors v0.20.0
 	github.com/go-openapi/loads v0.20.2
@@ -12,6 +13,7 @@ require (
 	github.com/go-openapi/strfmt v0.20.1
 	github.com/go-openapi/swag v0.19.15
 	github.com/go-openapi/validate v0.20.2
+	github.com/go-redis/redis/v8 v8.11.5
 	github.com/gofrs/uuid v4.0.0+incompatible
 	github.com/golang/mock v1.6.0
 	github.com/grpc-ecosystem/go-grpc-middleware v1.3.0
@@ -19,7 +21,9 @@ require (
 	github.com/jackc/pgtype v1.8.1
 	github.com/jessevdk/go-flags v1.5.0
 	github.com/jmoiron/sqlx v1.3.4
+	github.com/klauspost/compress v1.17.2
 	github.com/lib/pq v1.10.2
+	github.com/minio/minio-go/v7 v7.0.12
 	github.com/o1egl/paseto/v2 v2.1.1
 	github.com/ory/dockertest/v3 v3.7.0
 	github.com/prometheus/client_golang v1.11.0
@@ -29,6 +33,7 @@ require (
 	github.com/stretchr/testify v1.7.0
 	github.com/urfave/cli/v2 v2.3.0
 	golang.org/x/crypto v0.0.0-20210711020723-a769d52b0f97
+	golang.org/x/image v0.0.0-20210628002857-a66eb6448b8d
 	golang.org/x/net v0.0.0-20210805182204-aaa1db679c0d
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
 	google.golang.org/grpc v1.39.1
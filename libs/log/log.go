@@ -24,6 +24,7 @@ const (
 	Port        = `port`
 	Subsystem   = `subsystem`
 	DBMethod    = `db-method`
+	Interval    = `interval`
 )
 
 // WarnIfFail logs if callback finished with error.
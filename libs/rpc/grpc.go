@@ -23,6 +23,10 @@ const (
 	keepaliveMinTime = 30 * time.Second
 )
 
+// ServiceKey is metadata key of calling service key, it authenticates
+// service named in log.Service metadata.
+const ServiceKey = `service-key`
+
 var errInternal = status.Error(codes.Internal, "internal error")
 
 func newRPCLogger(ctx context.Context, logger zerolog.Logger, fullMethod string) zerolog.Logger {
@@ -0,0 +1,34 @@
+package serve
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/Meat-Hook/back-template/libs/log"
+)
+
+// Periodic calls f every interval, logged as service.
+// Errors returned by f are logged and do not stop service.
+// It runs until ctx.Done.
+func Periodic(logger zerolog.Logger, interval time.Duration, f func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		logger.Info().Stringer(log.Interval, interval).Msg("started")
+		defer logger.Info().Msg("shutdown")
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				err := f(logger.WithContext(ctx))
+				if err != nil {
+					logger.Error().Err(err).Send()
+				}
+			}
+		}
+	}
+}
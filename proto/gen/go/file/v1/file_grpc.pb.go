@@ -18,12 +18,39 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ServiceClient interface {
-	// Upload file to database.
+	// Upload file to database, infected file is rejected.
 	Upload(ctx context.Context, opts ...grpc.CallOption) (Service_UploadClient, error)
-	// Set file metadata.
+	// Set file metadata, only owner can set it.
 	SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*SetMetadataResponse, error)
-	// Delete file.
+	// Delete file, only owner can delete it.
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Set file visibility and principals it is shared with, only owner can set it.
+	SetAccess(ctx context.Context, in *SetAccessRequest, opts ...grpc.CallOption) (*SetAccessResponse, error)
+	// Download file from database, quarantined and infected files can't be downloaded.
+	Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (Service_DownloadClient, error)
+	// Create resumable upload session.
+	CreateUpload(ctx context.Context, in *CreateUploadRequest, opts ...grpc.CallOption) (*CreateUploadResponse, error)
+	// Get upload session with received parts.
+	GetUpload(ctx context.Context, in *GetUploadRequest, opts ...grpc.CallOption) (*GetUploadResponse, error)
+	// Upload part of upload session, first message must contain session id and part number.
+	UploadPart(ctx context.Context, opts ...grpc.CallOption) (Service_UploadPartClient, error)
+	// Complete upload session and build file from received parts.
+	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadResponse, error)
+	// Abort upload session and remove received parts.
+	AbortUpload(ctx context.Context, in *AbortUploadRequest, opts ...grpc.CallOption) (*AbortUploadResponse, error)
+	// List caller's or other owner's files with filters, sorting and cursor pagination.
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Sign expiring download URL of file readable by caller.
+	SignURL(ctx context.Context, in *SignURLRequest, opts ...grpc.CallOption) (*SignURLResponse, error)
+	// Add reference to file, only owner can claim it.
+	// Files uploaded by services are removed when they have no references.
+	Claim(ctx context.Context, in *ClaimRequest, opts ...grpc.CallOption) (*ClaimResponse, error)
+	// Remove reference to file, only owner can release it.
+	Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error)
+	// Get storage limits and usage of principal charged for files.
+	GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaResponse, error)
+	// Set storage limits of principal charged for files, usage isn't changed.
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*SetQuotaResponse, error)
 }
 
 type serviceClient struct {
@@ -86,16 +113,208 @@ func (c *serviceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) SetAccess(ctx context.Context, in *SetAccessRequest, opts ...grpc.CallOption) (*SetAccessResponse, error) {
+	out := new(SetAccessResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/SetAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (Service_DownloadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[1], "/file.v1.Service/Download", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceDownloadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_DownloadClient interface {
+	Recv() (*DownloadResponse, error)
+	grpc.ClientStream
+}
+
+type serviceDownloadClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceDownloadClient) Recv() (*DownloadResponse, error) {
+	m := new(DownloadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) CreateUpload(ctx context.Context, in *CreateUploadRequest, opts ...grpc.CallOption) (*CreateUploadResponse, error) {
+	out := new(CreateUploadResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/CreateUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetUpload(ctx context.Context, in *GetUploadRequest, opts ...grpc.CallOption) (*GetUploadResponse, error) {
+	out := new(GetUploadResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/GetUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UploadPart(ctx context.Context, opts ...grpc.CallOption) (Service_UploadPartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[2], "/file.v1.Service/UploadPart", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceUploadPartClient{stream}
+	return x, nil
+}
+
+type Service_UploadPartClient interface {
+	Send(*UploadPartRequest) error
+	CloseAndRecv() (*UploadPartResponse, error)
+	grpc.ClientStream
+}
+
+type serviceUploadPartClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceUploadPartClient) Send(m *UploadPartRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serviceUploadPartClient) CloseAndRecv() (*UploadPartResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadPartResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadResponse, error) {
+	out := new(CompleteUploadResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/CompleteUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AbortUpload(ctx context.Context, in *AbortUploadRequest, opts ...grpc.CallOption) (*AbortUploadResponse, error) {
+	out := new(AbortUploadResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/AbortUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SignURL(ctx context.Context, in *SignURLRequest, opts ...grpc.CallOption) (*SignURLResponse, error) {
+	out := new(SignURLResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/SignURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Claim(ctx context.Context, in *ClaimRequest, opts ...grpc.CallOption) (*ClaimResponse, error) {
+	out := new(ClaimResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error) {
+	out := new(ReleaseResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaResponse, error) {
+	out := new(GetQuotaResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/GetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*SetQuotaResponse, error) {
+	out := new(SetQuotaResponse)
+	err := c.cc.Invoke(ctx, "/file.v1.Service/SetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations should embed UnimplementedServiceServer
 // for forward compatibility
 type ServiceServer interface {
-	// Upload file to database.
+	// Upload file to database, infected file is rejected.
 	Upload(Service_UploadServer) error
-	// Set file metadata.
+	// Set file metadata, only owner can set it.
 	SetMetadata(context.Context, *SetMetadataRequest) (*SetMetadataResponse, error)
-	// Delete file.
+	// Delete file, only owner can delete it.
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Set file visibility and principals it is shared with, only owner can set it.
+	SetAccess(context.Context, *SetAccessRequest) (*SetAccessResponse, error)
+	// Download file from database, quarantined and infected files can't be downloaded.
+	Download(*DownloadRequest, Service_DownloadServer) error
+	// Create resumable upload session.
+	CreateUpload(context.Context, *CreateUploadRequest) (*CreateUploadResponse, error)
+	// Get upload session with received parts.
+	GetUpload(context.Context, *GetUploadRequest) (*GetUploadResponse, error)
+	// Upload part of upload session, first message must contain session id and part number.
+	UploadPart(Service_UploadPartServer) error
+	// Complete upload session and build file from received parts.
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadResponse, error)
+	// Abort upload session and remove received parts.
+	AbortUpload(context.Context, *AbortUploadRequest) (*AbortUploadResponse, error)
+	// List caller's or other owner's files with filters, sorting and cursor pagination.
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Sign expiring download URL of file readable by caller.
+	SignURL(context.Context, *SignURLRequest) (*SignURLResponse, error)
+	// Add reference to file, only owner can claim it.
+	// Files uploaded by services are removed when they have no references.
+	Claim(context.Context, *ClaimRequest) (*ClaimResponse, error)
+	// Remove reference to file, only owner can release it.
+	Release(context.Context, *ReleaseRequest) (*ReleaseResponse, error)
+	// Get storage limits and usage of principal charged for files.
+	GetQuota(context.Context, *GetQuotaRequest) (*GetQuotaResponse, error)
+	// Set storage limits of principal charged for files, usage isn't changed.
+	SetQuota(context.Context, *SetQuotaRequest) (*SetQuotaResponse, error)
 }
 
 // UnimplementedServiceServer should be embedded to have forward compatible implementations.
@@ -111,6 +330,45 @@ func (UnimplementedServiceServer) SetMetadata(context.Context, *SetMetadataReque
 func (UnimplementedServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedServiceServer) SetAccess(context.Context, *SetAccessRequest) (*SetAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccess not implemented")
+}
+func (UnimplementedServiceServer) Download(*DownloadRequest, Service_DownloadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Download not implemented")
+}
+func (UnimplementedServiceServer) CreateUpload(context.Context, *CreateUploadRequest) (*CreateUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUpload not implemented")
+}
+func (UnimplementedServiceServer) GetUpload(context.Context, *GetUploadRequest) (*GetUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpload not implemented")
+}
+func (UnimplementedServiceServer) UploadPart(Service_UploadPartServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadPart not implemented")
+}
+func (UnimplementedServiceServer) CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteUpload not implemented")
+}
+func (UnimplementedServiceServer) AbortUpload(context.Context, *AbortUploadRequest) (*AbortUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortUpload not implemented")
+}
+func (UnimplementedServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedServiceServer) SignURL(context.Context, *SignURLRequest) (*SignURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignURL not implemented")
+}
+func (UnimplementedServiceServer) Claim(context.Context, *ClaimRequest) (*ClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+func (UnimplementedServiceServer) Release(context.Context, *ReleaseRequest) (*ReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (UnimplementedServiceServer) GetQuota(context.Context, *GetQuotaRequest) (*GetQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedServiceServer) SetQuota(context.Context, *SetQuotaRequest) (*SetQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ServiceServer will
@@ -185,6 +443,251 @@ func _Service_Delete_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SetAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/SetAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetAccess(ctx, req.(*SetAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Download_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).Download(m, &serviceDownloadServer{stream})
+}
+
+type Service_DownloadServer interface {
+	Send(*DownloadResponse) error
+	grpc.ServerStream
+}
+
+type serviceDownloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceDownloadServer) Send(m *DownloadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_CreateUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/CreateUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateUpload(ctx, req.(*CreateUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/GetUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetUpload(ctx, req.(*GetUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UploadPart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServiceServer).UploadPart(&serviceUploadPartServer{stream})
+}
+
+type Service_UploadPartServer interface {
+	SendAndClose(*UploadPartResponse) error
+	Recv() (*UploadPartRequest, error)
+	grpc.ServerStream
+}
+
+type serviceUploadPartServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceUploadPartServer) SendAndClose(m *UploadPartResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serviceUploadPartServer) Recv() (*UploadPartRequest, error) {
+	m := new(UploadPartRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Service_CompleteUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CompleteUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/CompleteUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CompleteUpload(ctx, req.(*CompleteUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AbortUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AbortUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/AbortUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AbortUpload(ctx, req.(*AbortUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SignURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SignURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/SignURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SignURL(ctx, req.(*SignURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Claim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/Claim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Claim(ctx, req.(*ClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Release(ctx, req.(*ReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/GetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetQuota(ctx, req.(*GetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/file.v1.Service/SetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetQuota(ctx, req.(*SetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -200,6 +703,50 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Service_Delete_Handler,
 		},
+		{
+			MethodName: "SetAccess",
+			Handler:    _Service_SetAccess_Handler,
+		},
+		{
+			MethodName: "CreateUpload",
+			Handler:    _Service_CreateUpload_Handler,
+		},
+		{
+			MethodName: "GetUpload",
+			Handler:    _Service_GetUpload_Handler,
+		},
+		{
+			MethodName: "CompleteUpload",
+			Handler:    _Service_CompleteUpload_Handler,
+		},
+		{
+			MethodName: "AbortUpload",
+			Handler:    _Service_AbortUpload_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Service_List_Handler,
+		},
+		{
+			MethodName: "SignURL",
+			Handler:    _Service_SignURL_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Service_Claim_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _Service_Release_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _Service_GetQuota_Handler,
+		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _Service_SetQuota_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -207,6 +754,16 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Service_Upload_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Download",
+			Handler:       _Service_Download_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadPart",
+			Handler:       _Service_UploadPart_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "file/v1/file.proto",
 }
@@ -19,11 +19,23 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ServiceClient interface {
 	// Session get session info by token.
+	// Session used from other origin returns PERMISSION_DENIED if it's rejected by policy.
 	Session(ctx context.Context, in *SessionRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 	// Delete user's session
 	RemoveSession(ctx context.Context, in *RemoveSessionRequest, opts ...grpc.CallOption) (*RemoveSessionResponse, error)
 	// Make new session specific user.
 	NewSession(ctx context.Context, in *NewSessionRequest, opts ...grpc.CallOption) (*NewSessionResponse, error)
+	// Exchange refresh token for new pair of tokens, every refresh token can be used once.
+	// Reused refresh token revokes whole session and returns PERMISSION_DENIED.
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
+	// List active sessions of user, most recently used first.
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Delete all user's sessions except the specified one.
+	RemoveAllSessions(ctx context.Context, in *RemoveAllSessionsRequest, opts ...grpc.CallOption) (*RemoveAllSessionsResponse, error)
+	// List keys for verifying public tokens without calling service, empty if tokens are encrypted.
+	PublicKeys(ctx context.Context, in *PublicKeysRequest, opts ...grpc.CallOption) (*PublicKeysResponse, error)
+	// List security events of user, e.g. new sign-ins, most recent first.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 }
 
 type serviceClient struct {
@@ -61,16 +73,73 @@ func (c *serviceClient) NewSession(ctx context.Context, in *NewSessionRequest, o
 	return out, nil
 }
 
+func (c *serviceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error) {
+	out := new(RefreshResponse)
+	err := c.cc.Invoke(ctx, "/session.v1.Service/Refresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/session.v1.Service/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RemoveAllSessions(ctx context.Context, in *RemoveAllSessionsRequest, opts ...grpc.CallOption) (*RemoveAllSessionsResponse, error) {
+	out := new(RemoveAllSessionsResponse)
+	err := c.cc.Invoke(ctx, "/session.v1.Service/RemoveAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) PublicKeys(ctx context.Context, in *PublicKeysRequest, opts ...grpc.CallOption) (*PublicKeysResponse, error) {
+	out := new(PublicKeysResponse)
+	err := c.cc.Invoke(ctx, "/session.v1.Service/PublicKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/session.v1.Service/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations should embed UnimplementedServiceServer
 // for forward compatibility
 type ServiceServer interface {
 	// Session get session info by token.
+	// Session used from other origin returns PERMISSION_DENIED if it's rejected by policy.
 	Session(context.Context, *SessionRequest) (*SessionResponse, error)
 	// Delete user's session
 	RemoveSession(context.Context, *RemoveSessionRequest) (*RemoveSessionResponse, error)
 	// Make new session specific user.
 	NewSession(context.Context, *NewSessionRequest) (*NewSessionResponse, error)
+	// Exchange refresh token for new pair of tokens, every refresh token can be used once.
+	// Reused refresh token revokes whole session and returns PERMISSION_DENIED.
+	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
+	// List active sessions of user, most recently used first.
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// Delete all user's sessions except the specified one.
+	RemoveAllSessions(context.Context, *RemoveAllSessionsRequest) (*RemoveAllSessionsResponse, error)
+	// List keys for verifying public tokens without calling service, empty if tokens are encrypted.
+	PublicKeys(context.Context, *PublicKeysRequest) (*PublicKeysResponse, error)
+	// List security events of user, e.g. new sign-ins, most recent first.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 }
 
 // UnimplementedServiceServer should be embedded to have forward compatible implementations.
@@ -86,6 +155,21 @@ func (UnimplementedServiceServer) RemoveSession(context.Context, *RemoveSessionR
 func (UnimplementedServiceServer) NewSession(context.Context, *NewSessionRequest) (*NewSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewSession not implemented")
 }
+func (UnimplementedServiceServer) Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedServiceServer) RemoveAllSessions(context.Context, *RemoveAllSessionsRequest) (*RemoveAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllSessions not implemented")
+}
+func (UnimplementedServiceServer) PublicKeys(context.Context, *PublicKeysRequest) (*PublicKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublicKeys not implemented")
+}
+func (UnimplementedServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ServiceServer will
@@ -152,6 +236,96 @@ func _Service_NewSession_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.v1.Service/Refresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.v1.Service/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RemoveAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RemoveAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.v1.Service/RemoveAllSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RemoveAllSessions(ctx, req.(*RemoveAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PublicKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.v1.Service/PublicKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PublicKeys(ctx, req.(*PublicKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.v1.Service/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +345,26 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewSession",
 			Handler:    _Service_NewSession_Handler,
 		},
+		{
+			MethodName: "Refresh",
+			Handler:    _Service_Refresh_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Service_ListSessions_Handler,
+		},
+		{
+			MethodName: "RemoveAllSessions",
+			Handler:    _Service_RemoveAllSessions_Handler,
+		},
+		{
+			MethodName: "PublicKeys",
+			Handler:    _Service_PublicKeys_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _Service_ListEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "session/v1/session.proto",